@@ -1,9 +1,12 @@
 package config
 
 import (
+	"log"
+	"monitoring-service/helper"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds all configuration for our application
@@ -25,10 +28,31 @@ type Config struct {
 	FrontendBypassBrowsers    bool
 	FrontendCustomHeader      string
 	FrontendCustomHeaderValue string
+	LatePolicies              helper.LatePolicies
+	Reminder                  helper.ReminderConfig
+	UserCache                 helper.UserCacheConfig
+	Downstream                helper.DownstreamConfig
+	RegistrationSync          helper.RegistrationSyncConfig
+	ReportSchedule            helper.ReportScheduleConfig
+	ScheduleProvisioning      helper.ScheduleProvisioningConfig
+	Outbox                    helper.OutboxConfig
+	Notification              helper.NotificationConfig
+	AdvisorNotification       helper.AdvisorNotificationConfig
+	NotificationTemplate      helper.NotificationTemplateConfig
+	JWT                       helper.JWTConfig
 }
 
 // LoadConfig loads configuration from environment variables
 func LoadConfig() *Config {
+	rabbitMQURL := helper.AMQPURL(
+		getEnv("RABBITMQ_USER", "guest"),
+		getEnv("RABBITMQ_PASS", "guest"),
+		getEnv("RABBITMQ_HOST", "localhost"),
+		getEnv("RABBITMQ_PORT", "5672"),
+	)
+	// the longest activity window schedules are generated for, by request or from approval events
+	reportScheduleMaxWeeks := int(getEnvAsInt64("REPORT_SCHEDULE_MAX_WEEKS", 52))
+
 	return &Config{
 		AppPort:                   getEnv("GOLANG_PORT", "8006"),
 		DBHost:                    getEnv("DB_HOST", "localhost"),
@@ -47,6 +71,114 @@ func LoadConfig() *Config {
 		FrontendBypassBrowsers:    getEnvAsBool("FRONTEND_BYPASS_BROWSERS", false),
 		FrontendCustomHeader:      getEnv("FRONTEND_CUSTOM_HEADER", "X-Frontend-Request"),
 		FrontendCustomHeaderValue: getEnv("FRONTEND_CUSTOM_HEADER_VALUE", "true"),
+		LatePolicies: helper.LatePolicies{
+			"WEEKLY_REPORT": getEnvAsLatePolicy("REPORT_LATE_POLICY_WEEKLY_REPORT", "REPORT_LATE_GRACE_PERIOD_WEEKLY_REPORT"),
+			"FINAL_REPORT":  getEnvAsLatePolicy("REPORT_LATE_POLICY_FINAL_REPORT", "REPORT_LATE_GRACE_PERIOD_FINAL_REPORT"),
+		},
+		Reminder: helper.ReminderConfig{
+			Enabled:         getEnvAsBool("REMINDER_ENABLED", false),
+			Interval:        getEnvAsDuration("REMINDER_INTERVAL", 15*time.Minute),
+			DueSoonOffsets:  getEnvAsDurationSlice("REMINDER_DUE_SOON_OFFSETS", []time.Duration{72 * time.Hour, 24 * time.Hour}),
+			OverdueOffsets:  getEnvAsDurationSlice("REMINDER_OVERDUE_OFFSETS", []time.Duration{0}),
+			OverdueLookback: getEnvAsDuration("REMINDER_OVERDUE_LOOKBACK", 7*24*time.Hour),
+			ServiceToken:    getEnv("REMINDER_SERVICE_TOKEN", ""),
+		},
+		UserCache: helper.UserCacheConfig{
+			TTL: getEnvAsDuration("USER_CACHE_TTL", time.Minute),
+		},
+		Downstream: helper.DownstreamConfig{
+			Timeout:            getEnvAsDuration("DOWNSTREAM_TIMEOUT", 5*time.Second),
+			UploadTimeout:      getEnvAsDuration("DOWNSTREAM_UPLOAD_TIMEOUT", time.Minute),
+			MaxRetries:         int(getEnvAsInt64("DOWNSTREAM_MAX_RETRIES", 2)),
+			RetryBaseDelay:     getEnvAsDuration("DOWNSTREAM_RETRY_BASE_DELAY", 100*time.Millisecond),
+			RetryMaxDelay:      getEnvAsDuration("DOWNSTREAM_RETRY_MAX_DELAY", 2*time.Second),
+			BreakerFailures:    uint32(getEnvAsInt64("DOWNSTREAM_BREAKER_FAILURES", 5)),
+			BreakerOpenTimeout: getEnvAsDuration("DOWNSTREAM_BREAKER_OPEN_TIMEOUT", 30*time.Second),
+		},
+		RegistrationSync: helper.RegistrationSyncConfig{
+			Enabled: getEnvAsBool("REGISTRATION_SYNC_ENABLED", false),
+			Consumer: helper.AMQPConsumerConfig{
+				URL:                rabbitMQURL,
+				Exchange:           getEnv("REGISTRATION_EVENTS_EXCHANGE", "registration_events"),
+				Queue:              getEnv("REGISTRATION_EVENTS_QUEUE", "monitoring_registration_events"),
+				RoutingKey:         getEnv("REGISTRATION_EVENTS_ROUTING_KEY", "registration.#"),
+				Prefetch:           int(getEnvAsInt64("REGISTRATION_EVENTS_PREFETCH", 10)),
+				RetryDelay:         getEnvAsDuration("REGISTRATION_EVENTS_RETRY_DELAY", 5*time.Second),
+				ReconnectDelay:     getEnvAsDuration("RABBITMQ_RECONNECT_DELAY", 5*time.Second),
+				DeadLetterExchange: getEnv("REGISTRATION_EVENTS_DEAD_LETTER_EXCHANGE", "monitoring_registration_events.dlx"),
+				DeadLetterQueue:    getEnv("REGISTRATION_EVENTS_DEAD_LETTER_QUEUE", "monitoring_registration_events.dlq"),
+				DeliveryLimit:      int(getEnvAsInt64("REGISTRATION_EVENTS_DELIVERY_LIMIT", 5)),
+			},
+			// the registrations referenced before the consumer ran are fetched with this token
+			BackfillToken:     getEnv("REGISTRATION_SYNC_BACKFILL_TOKEN", getEnv("REMINDER_SERVICE_TOKEN", "")),
+			BackfillBatchSize: int(getEnvAsInt64("REGISTRATION_SYNC_BACKFILL_BATCH_SIZE", 100)),
+		},
+		ReportSchedule: helper.ReportScheduleConfig{
+			MaxWeeks: reportScheduleMaxWeeks,
+		},
+		ScheduleProvisioning: helper.ScheduleProvisioningConfig{
+			Enabled:            getEnvAsBool("SCHEDULE_PROVISIONING_ENABLED", false),
+			Cadence:            getEnv("SCHEDULE_PROVISIONING_CADENCE", helper.REPORT_CADENCE_WEEKLY),
+			IncludeFinalReport: getEnvAsBool("SCHEDULE_PROVISIONING_INCLUDE_FINAL_REPORT", true),
+			MaxWeeks:           reportScheduleMaxWeeks,
+			Consumer: helper.AMQPConsumerConfig{
+				URL:                rabbitMQURL,
+				Exchange:           getEnv("REGISTRATION_EVENTS_EXCHANGE", "registration_events"),
+				Queue:              getEnv("SCHEDULE_PROVISIONING_QUEUE", "monitoring_schedule_provisioning"),
+				RoutingKey:         getEnv("SCHEDULE_PROVISIONING_ROUTING_KEY", "registration.approved"),
+				Prefetch:           int(getEnvAsInt64("SCHEDULE_PROVISIONING_PREFETCH", 10)),
+				RetryDelay:         getEnvAsDuration("SCHEDULE_PROVISIONING_RETRY_DELAY", 5*time.Second),
+				ReconnectDelay:     getEnvAsDuration("RABBITMQ_RECONNECT_DELAY", 5*time.Second),
+				DeadLetterExchange: getEnv("SCHEDULE_PROVISIONING_DEAD_LETTER_EXCHANGE", "monitoring_schedule_provisioning.dlx"),
+				DeadLetterQueue:    getEnv("SCHEDULE_PROVISIONING_DEAD_LETTER_QUEUE", "monitoring_schedule_provisioning.dlq"),
+				DeliveryLimit:      int(getEnvAsInt64("SCHEDULE_PROVISIONING_DELIVERY_LIMIT", 5)),
+			},
+		},
+		Outbox: helper.OutboxConfig{
+			Enabled:   getEnvAsBool("OUTBOX_PUBLISHER_ENABLED", false),
+			URL:       rabbitMQURL,
+			Exchange:  getEnv("DOMAIN_EVENTS_EXCHANGE", "monitoring_events"),
+			Interval:  getEnvAsDuration("OUTBOX_PUBLISH_INTERVAL", 5*time.Second),
+			BatchSize: int(getEnvAsInt64("OUTBOX_PUBLISH_BATCH_SIZE", 100)),
+		},
+		Notification: helper.NotificationConfig{
+			Enabled:        getEnvAsBool("NOTIFICATION_DELIVERY_ENABLED", true),
+			Interval:       getEnvAsDuration("NOTIFICATION_DELIVERY_INTERVAL", 10*time.Second),
+			BatchSize:      int(getEnvAsInt64("NOTIFICATION_DELIVERY_BATCH_SIZE", 50)),
+			MaxAttempts:    int(getEnvAsInt64("NOTIFICATION_MAX_ATTEMPTS", 8)),
+			RetryBaseDelay: getEnvAsDuration("NOTIFICATION_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:  getEnvAsDuration("NOTIFICATION_RETRY_MAX_DELAY", time.Hour),
+			ClaimDuration:  getEnvAsDuration("NOTIFICATION_CLAIM_DURATION", 5*time.Minute),
+			// the worker has no user token, it falls back to the one the reminders use
+			ServiceToken: getEnv("NOTIFICATION_SERVICE_TOKEN", getEnv("REMINDER_SERVICE_TOKEN", "")),
+		},
+		AdvisorNotification: helper.AdvisorNotificationConfig{
+			DigestEnabled:      getEnvAsBool("ADVISOR_DIGEST_ENABLED", true),
+			DigestHour:         int(getEnvAsInt64("ADVISOR_DIGEST_HOUR", 7)),
+			DefaultDailyDigest: getEnvAsBool("ADVISOR_DIGEST_DEFAULT", false),
+		},
+		NotificationTemplate: helper.NotificationTemplateConfig{
+			DefaultLocale: getEnv("NOTIFICATION_DEFAULT_LOCALE", helper.LOCALE_ID),
+			LinkBaseURL:   getEnv("NOTIFICATION_LINK_BASE_URL", "http://localhost:3000"),
+		},
+		JWT: helper.JWTConfig{
+			PublicKey:              getEnv("JWT_PUBLIC_KEY", ""),
+			PublicKeyFile:          getEnv("JWT_PUBLIC_KEY_FILE", ""),
+			JWKSURL:                getEnv("JWT_JWKS_URL", ""),
+			JWKSRefreshInterval:    getEnvAsDuration("JWT_JWKS_REFRESH_INTERVAL", time.Hour),
+			JWKSMinRefreshInterval: getEnvAsDuration("JWT_JWKS_MIN_REFRESH_INTERVAL", time.Minute),
+			Issuer:                 getEnv("JWT_ISSUER", ""),
+			Audience:               getEnv("JWT_AUDIENCE", ""),
+			Leeway:                 getEnvAsDuration("JWT_LEEWAY", 30*time.Second),
+			RemoteFallback:         getEnvAsBool("JWT_REMOTE_FALLBACK", true),
+			Claims: helper.JWTClaimNames{
+				UserID: getEnv("JWT_CLAIM_USER_ID", "sub"),
+				Email:  getEnv("JWT_CLAIM_EMAIL", "email"),
+				NRP:    getEnv("JWT_CLAIM_NRP", "nrp"),
+				Role:   getEnv("JWT_CLAIM_ROLE", "role"),
+				Name:   getEnv("JWT_CLAIM_NAME", "name"),
+			},
+		},
 	}
 }
 
@@ -82,3 +214,55 @@ func getEnvAsSlice(key string, defaultValue []string) []string {
 	}
 	return defaultValue
 }
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsDurationSlice reads a comma separated list of durations, e.g. "72h,24h"
+func getEnvAsDurationSlice(key string, defaultValue []time.Duration) []time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists || value == "" {
+		return defaultValue
+	}
+
+	var durations []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		duration, err := time.ParseDuration(strings.TrimSpace(part))
+		if err != nil {
+			log.Printf("invalid %s %q: %v", key, part, err)
+			return defaultValue
+		}
+		durations = append(durations, duration)
+	}
+	return durations
+}
+
+// getEnvAsLatePolicy reads a late policy mode and its grace period (e.g. "48h"), falling back to the default policy
+func getEnvAsLatePolicy(modeKey, gracePeriodKey string) helper.LatePolicy {
+	policy := helper.DefaultLatePolicy
+
+	if mode := getEnv(modeKey, ""); mode != "" {
+		if helper.ValidateLatePolicyMode(mode) {
+			policy.Mode = mode
+		} else {
+			log.Printf("invalid %s %q, using %s", modeKey, mode, policy.Mode)
+		}
+	}
+
+	if value := getEnv(gracePeriodKey, ""); value != "" {
+		gracePeriod, err := time.ParseDuration(value)
+		if err != nil {
+			log.Printf("invalid %s %q: %v", gracePeriodKey, value, err)
+		} else {
+			policy.GracePeriod = gracePeriod
+		}
+	}
+
+	return policy
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"monitoring-service/entity"
+
+	"gorm.io/gorm"
+)
+
+// RunMigration creates or updates the tables owned by this service
+func RunMigration(db *gorm.DB) {
+	err := db.AutoMigrate(
+		&entity.ReportSchedule{},
+		&entity.Report{},
+		&entity.ReportRevision{},
+		&entity.ReportReminder{},
+		&entity.RegistrationDossier{},
+		&entity.RegistrationSnapshot{},
+		&entity.OutboxEvent{},
+		&entity.Notification{},
+		&entity.NotificationAttempt{},
+		&entity.AdvisorNotificationPreference{},
+		&entity.AdvisorDigestEntry{},
+		&entity.NotificationTemplate{},
+		&entity.UserLocalePreference{},
+		&entity.AuditEvent{},
+		&entity.AdvisorAssignment{},
+		&entity.AdvisorDelegation{},
+		&entity.MonitorAssignment{},
+		&entity.ReportEvaluation{},
+		&entity.MonitoringVisit{},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	backfillActivityNames(db)
+}
+
+// backfillActivityNames copies the activity of every synced registration onto its schedules
+func backfillActivityNames(db *gorm.DB) {
+	err := db.Exec(`
+		UPDATE report_schedules
+		SET activity_name = registration_snapshots.activity_name
+		FROM registration_snapshots
+		WHERE registration_snapshots.registration_id = report_schedules.registration_id
+		  AND registration_snapshots.activity_name <> ''
+		  AND report_schedules.activity_name IS DISTINCT FROM registration_snapshots.activity_name
+	`).Error
+	if err != nil {
+		panic(err)
+	}
+}
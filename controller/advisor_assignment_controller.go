@@ -0,0 +1,238 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AdvisorAssignmentController struct {
+	advisorAssignmentService service.AdvisorAssignmentService
+}
+
+func NewAdvisorAssignmentController(advisorAssignmentService service.AdvisorAssignmentService) *AdvisorAssignmentController {
+	return &AdvisorAssignmentController{
+		advisorAssignmentService: advisorAssignmentService,
+	}
+}
+
+func advisorAssignmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, helper.ErrAdvisorAssignmentNotFound), errors.Is(err, helper.ErrAdvisorDelegationNotFound), errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, helper.ErrAdvisorAssignmentExists):
+		return http.StatusConflict
+	case errors.Is(err, helper.ErrInvalidAdvisorAssignment), errors.Is(err, helper.ErrInvalidAdvisorDelegation):
+		return http.StatusBadRequest
+	case errors.Is(err, helper.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		log.Println("ERROR MANAGING ADVISOR ASSIGNMENTS: ", err)
+		return http.StatusInternalServerError
+	}
+}
+
+// advisorAssignmentToken reads the bearer token every advisor assignment endpoint acts with
+func advisorAssignmentToken(ctx *gin.Context) (string, bool) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return "", false
+	}
+
+	return token, true
+}
+
+// advisorAssignmentID validates the :id path parameter, name is used in the error message
+func advisorAssignmentID(ctx *gin.Context, name string) (string, bool) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid " + name + " ID format",
+		})
+		return "", false
+	}
+
+	return id, true
+}
+
+// Index handles GET /api/v1/advisor-assignments?registration_id=
+func (c *AdvisorAssignmentController) Index(ctx *gin.Context) {
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	registrationID := ctx.Query("registration_id")
+	if !helper.ValidateUUID(registrationID) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid registration ID format",
+		})
+		return
+	}
+
+	advisors, err := c.advisorAssignmentService.FindByRegistrationID(ctx, registrationID, token)
+	if err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    advisors,
+		Message: "Advisors fetched successfully",
+	})
+}
+
+// Create handles POST /api/v1/advisor-assignments
+func (c *AdvisorAssignmentController) Create(ctx *gin.Context) {
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.AdvisorAssignmentRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	assignment, err := c.advisorAssignmentService.Assign(ctx, request, token)
+	if err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    assignment,
+		Message: "Advisor assigned successfully",
+	})
+}
+
+// Destroy handles DELETE /api/v1/advisor-assignments/:id
+func (c *AdvisorAssignmentController) Destroy(ctx *gin.Context) {
+	id, ok := advisorAssignmentID(ctx, "advisor assignment")
+	if !ok {
+		return
+	}
+
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.advisorAssignmentService.Unassign(ctx, id, token); err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Message: "Advisor unassigned successfully",
+	})
+}
+
+// Delegations handles GET /api/v1/advisor-delegations
+func (c *AdvisorAssignmentController) Delegations(ctx *gin.Context) {
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	delegations, err := c.advisorAssignmentService.FindDelegations(ctx, token)
+	if err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    delegations,
+		Message: "Advisor delegations fetched successfully",
+	})
+}
+
+// Delegate handles POST /api/v1/advisor-delegations
+func (c *AdvisorAssignmentController) Delegate(ctx *gin.Context) {
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.AdvisorDelegationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	delegation, err := c.advisorAssignmentService.Delegate(ctx, request, token)
+	if err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    delegation,
+		Message: "Advisor delegation created successfully",
+	})
+}
+
+// Revoke handles DELETE /api/v1/advisor-delegations/:id
+func (c *AdvisorAssignmentController) Revoke(ctx *gin.Context) {
+	id, ok := advisorAssignmentID(ctx, "advisor delegation")
+	if !ok {
+		return
+	}
+
+	token, ok := advisorAssignmentToken(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.advisorAssignmentService.RevokeDelegation(ctx, id, token); err != nil {
+		ctx.JSON(advisorAssignmentErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Message: "Advisor delegation revoked successfully",
+	})
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AnalyticsController struct {
+	analyticsService service.AnalyticsService
+}
+
+func NewAnalyticsController(analyticsService service.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{
+		analyticsService: analyticsService,
+	}
+}
+
+// Summary handles GET /api/v1/analytics?start_date=&end_date=&activity_name=&advisor_email=&limit=
+func (c *AnalyticsController) Summary(ctx *gin.Context) {
+	_, limit, err := helper.ValidatePaginationParams(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	filter := dto.AnalyticsFilterRequest{
+		StartDate:    ctx.Query("start_date"),
+		EndDate:      ctx.Query("end_date"),
+		ActivityName: helper.SanitizeString(ctx.Query("activity_name")),
+		AdvisorEmail: helper.SanitizeString(ctx.Query("advisor_email")),
+		Limit:        limit,
+	}
+
+	analytics, err := c.analyticsService.Summary(ctx, filter)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, helper.ErrInvalidDateRange) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    analytics,
+		Message: "Analytics fetched successfully",
+	})
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditController struct {
+	auditService service.AuditService
+}
+
+func NewAuditController(auditService service.AuditService) *AuditController {
+	return &AuditController{
+		auditService: auditService,
+	}
+}
+
+// Index handles GET /api/v1/audit-events?resource_type=&resource_id=&actor_id=&actor_email=&action=&start_date=&end_date=&page=&limit=
+func (c *AuditController) Index(ctx *gin.Context) {
+	pagReq := helper.Pagination(ctx)
+
+	filter := dto.AuditEventFilterRequest{
+		ResourceType: helper.SanitizeString(ctx.Query("resource_type")),
+		ResourceID:   helper.SanitizeString(ctx.Query("resource_id")),
+		ActorID:      helper.SanitizeString(ctx.Query("actor_id")),
+		ActorEmail:   helper.SanitizeString(ctx.Query("actor_email")),
+		Action:       helper.SanitizeString(ctx.Query("action")),
+		StartDate:    ctx.Query("start_date"),
+		EndDate:      ctx.Query("end_date"),
+	}
+
+	auditEvents, metaData, err := c.auditService.FindAll(ctx, filter, pagReq)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, helper.ErrInvalidDateRange) {
+			statusCode = http.StatusBadRequest
+		} else {
+			log.Println("ERROR GETTING AUDIT EVENTS: ", err)
+		}
+
+		ctx.JSON(statusCode, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:             dto.STATUS_SUCCESS,
+		Data:               auditEvents,
+		Message:            "Audit events fetched successfully",
+		PaginationResponse: &metaData,
+	})
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type DossierController struct {
+	dossierService service.DossierService
+}
+
+func NewDossierController(dossierService service.DossierService) *DossierController {
+	return &DossierController{
+		dossierService: dossierService,
+	}
+}
+
+// registrationStatusCode maps errors of registration scoped endpoints to a status code
+func registrationStatusCode(err error) int {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound), errors.Is(err, service.ErrRegistrationNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, helper.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// registrationRequest validates the registration ID and token shared by every dossier endpoint
+func registrationRequest(ctx *gin.Context) (string, string, bool) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid ID format",
+		})
+		return "", "", false
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return "", "", false
+	}
+
+	return id, token, true
+}
+
+// Download handles GET /api/v1/registrations/:id/dossier
+func (c *DossierController) Download(ctx *gin.Context) {
+	id, token, ok := registrationRequest(ctx)
+	if !ok {
+		return
+	}
+
+	content, err := c.dossierService.Render(ctx, id, token)
+	if err != nil {
+		ctx.JSON(registrationStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "dossier-"+id+".pdf"))
+	ctx.Data(http.StatusOK, "application/pdf", content)
+}
+
+// Generate handles POST /api/v1/registrations/:id/dossier
+func (c *DossierController) Generate(ctx *gin.Context) {
+	id, token, ok := registrationRequest(ctx)
+	if !ok {
+		return
+	}
+
+	dossier, err := c.dossierService.Generate(ctx, id, token)
+	if err != nil {
+		ctx.JSON(registrationStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    dossier,
+		Message: "Dossier generated successfully",
+	})
+}
+
+// Show handles GET /api/v1/registrations/:id/dossier/file
+func (c *DossierController) Show(ctx *gin.Context) {
+	id, token, ok := registrationRequest(ctx)
+	if !ok {
+		return
+	}
+
+	dossier, err := c.dossierService.FindByRegistrationID(ctx, id, token)
+	if err != nil {
+		ctx.JSON(registrationStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    dossier,
+		Message: "Dossier fetched successfully",
+	})
+}
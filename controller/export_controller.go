@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportController struct {
+	exportService service.ExportService
+}
+
+func NewExportController(exportService service.ExportService) *ExportController {
+	return &ExportController{
+		exportService: exportService,
+	}
+}
+
+// exportResponseWriter sets the download headers on the first write, so an error raised
+// before any row is written can still be answered with a JSON response
+type exportResponseWriter struct {
+	ctx         *gin.Context
+	contentType string
+	filename    string
+}
+
+func (w *exportResponseWriter) Write(p []byte) (int, error) {
+	if !w.ctx.Writer.Written() {
+		w.ctx.Header("Content-Type", w.contentType)
+		w.ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", w.filename))
+		w.ctx.Status(http.StatusOK)
+	}
+
+	return w.ctx.Writer.Write(p)
+}
+
+type exportFunc func(ctx *gin.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error
+
+func (c *ExportController) export(ctx *gin.Context, name string, export exportFunc) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	filter := dto.ExportFilterRequest{
+		Format:           ctx.Query("format"),
+		UserNRP:          helper.SanitizeString(ctx.Query("user_nrp")),
+		ActivityName:     helper.SanitizeString(ctx.Query("activity_name")),
+		AdvisorEmail:     helper.SanitizeString(ctx.Query("advisor_email")),
+		SubmissionStatus: ctx.Query("submission_status"),
+	}
+
+	if filter.Format == "" {
+		filter.Format = helper.EXPORT_FORMAT_CSV
+	}
+
+	switch filter.SubmissionStatus {
+	case "", helper.SUBMISSION_STATUS_ON_TIME, helper.SUBMISSION_STATUS_LATE, helper.SUBMISSION_STATUS_MISSING:
+	default:
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid submission status",
+		})
+		return
+	}
+
+	out := &exportResponseWriter{
+		ctx:         ctx,
+		contentType: helper.ExportContentType(filter.Format),
+		filename:    fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102"), filter.Format),
+	}
+
+	writer, err := helper.NewTableWriter(filter.Format, out, name)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err = export(ctx, token, filter, writer)
+	if err != nil {
+		if ctx.Writer.Written() {
+			// the download has already started, all that is left is to cut it short
+			log.Println("ERROR EXPORTING "+name+": ", err)
+			ctx.Abort()
+			return
+		}
+
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, helper.ErrForbidden) {
+			statusCode = http.StatusForbidden
+		}
+
+		ctx.JSON(statusCode, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+}
+
+// ReportSchedules handles GET /api/v1/exports/report-schedules?format=csv|xlsx
+func (c *ExportController) ReportSchedules(ctx *gin.Context) {
+	c.export(ctx, "report-schedules", func(ctx *gin.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+		return c.exportService.ExportReportSchedules(ctx, token, filter, writer)
+	})
+}
+
+// Reports handles GET /api/v1/exports/reports?format=csv|xlsx
+func (c *ExportController) Reports(ctx *gin.Context) {
+	c.export(ctx, "reports", func(ctx *gin.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+		return c.exportService.ExportReports(ctx, token, filter, writer)
+	})
+}
+
+// Documents handles GET /api/v1/exports/documents?format=csv|xlsx
+func (c *ExportController) Documents(ctx *gin.Context) {
+	c.export(ctx, "documents", func(ctx *gin.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+		return c.exportService.ExportDocuments(ctx, token, filter, writer)
+	})
+}
@@ -0,0 +1,287 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type MonitoringController struct {
+	monitoringService service.MonitoringService
+}
+
+func NewMonitoringController(monitoringService service.MonitoringService) *MonitoringController {
+	return &MonitoringController{
+		monitoringService: monitoringService,
+	}
+}
+
+func monitoringErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, helper.ErrMonitorAssignmentNotFound), errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, helper.ErrMonitorAssignmentExists):
+		return http.StatusConflict
+	case errors.Is(err, helper.ErrInvalidMonitorAssignment), errors.Is(err, helper.ErrInvalidReportEvaluation), errors.Is(err, helper.ErrInvalidMonitoringVisit):
+		return http.StatusBadRequest
+	case errors.Is(err, helper.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		log.Println("ERROR MANAGING MONITORING: ", err)
+		return http.StatusInternalServerError
+	}
+}
+
+// monitoringToken reads the bearer token every monitoring endpoint acts with
+func monitoringToken(ctx *gin.Context) (string, bool) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return "", false
+	}
+
+	return token, true
+}
+
+// monitoringID validates the :id path parameter, name is used in the error message
+func monitoringID(ctx *gin.Context, name string) (string, bool) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid " + name + " ID format",
+		})
+		return "", false
+	}
+
+	return id, true
+}
+
+// Assignments handles GET /api/v1/monitor-assignments?registration_id=
+func (c *MonitoringController) Assignments(ctx *gin.Context) {
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	registrationID := ctx.Query("registration_id")
+	if !helper.ValidateUUID(registrationID) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid registration ID format",
+		})
+		return
+	}
+
+	assignments, err := c.monitoringService.FindAssignments(ctx, registrationID, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    assignments,
+		Message: "Monitors fetched successfully",
+	})
+}
+
+// Assign handles POST /api/v1/monitor-assignments
+func (c *MonitoringController) Assign(ctx *gin.Context) {
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.MonitorAssignmentRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	assignment, err := c.monitoringService.Assign(ctx, request, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    assignment,
+		Message: "Monitor assigned successfully",
+	})
+}
+
+// Unassign handles DELETE /api/v1/monitor-assignments/:id
+func (c *MonitoringController) Unassign(ctx *gin.Context) {
+	id, ok := monitoringID(ctx, "monitor assignment")
+	if !ok {
+		return
+	}
+
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	if err := c.monitoringService.Unassign(ctx, id, token); err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Message: "Monitor unassigned successfully",
+	})
+}
+
+// Evaluations handles GET /api/v1/reports/:id/evaluations
+func (c *MonitoringController) Evaluations(ctx *gin.Context) {
+	id, ok := monitoringID(ctx, "report")
+	if !ok {
+		return
+	}
+
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	evaluations, err := c.monitoringService.FindEvaluations(ctx, id, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    evaluations,
+		Message: "Report evaluations fetched successfully",
+	})
+}
+
+// Evaluate handles POST /api/v1/reports/:id/evaluations
+func (c *MonitoringController) Evaluate(ctx *gin.Context) {
+	id, ok := monitoringID(ctx, "report")
+	if !ok {
+		return
+	}
+
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.ReportEvaluationRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	evaluation, err := c.monitoringService.Evaluate(ctx, id, request, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    evaluation,
+		Message: "Report evaluated successfully",
+	})
+}
+
+// Visits handles GET /api/v1/registrations/:id/monitoring-visits
+func (c *MonitoringController) Visits(ctx *gin.Context) {
+	id, ok := monitoringID(ctx, "registration")
+	if !ok {
+		return
+	}
+
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	visits, err := c.monitoringService.FindVisits(ctx, id, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    visits,
+		Message: "Monitoring visits fetched successfully",
+	})
+}
+
+// RecordVisit handles POST /api/v1/registrations/:id/monitoring-visits
+func (c *MonitoringController) RecordVisit(ctx *gin.Context) {
+	id, ok := monitoringID(ctx, "registration")
+	if !ok {
+		return
+	}
+
+	token, ok := monitoringToken(ctx)
+	if !ok {
+		return
+	}
+
+	var request dto.MonitoringVisitRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	visit, err := c.monitoringService.RecordVisit(ctx, id, request, token)
+	if err != nil {
+		ctx.JSON(monitoringErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    visit,
+		Message: "Monitoring visit recorded successfully",
+	})
+}
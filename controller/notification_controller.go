@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController struct {
+	notificationService        service.NotificationService
+	advisorNotificationService service.AdvisorNotificationService
+}
+
+func NewNotificationController(notificationService service.NotificationService, advisorNotificationService service.AdvisorNotificationService) *NotificationController {
+	return &NotificationController{
+		notificationService:        notificationService,
+		advisorNotificationService: advisorNotificationService,
+	}
+}
+
+// Failed handles GET /api/v1/notifications/failed?page=&limit=
+func (c *NotificationController) Failed(ctx *gin.Context) {
+	pagReq := helper.Pagination(ctx)
+
+	notifications, metaData, err := c.notificationService.FindFailed(ctx, pagReq)
+	if err != nil {
+		log.Println("ERROR GETTING FAILED NOTIFICATIONS: ", err)
+		ctx.JSON(http.StatusInternalServerError, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:             dto.STATUS_SUCCESS,
+		Data:               notifications,
+		Message:            "Failed notifications fetched successfully",
+		PaginationResponse: &metaData,
+	})
+}
+
+// Resend handles POST /api/v1/notifications/:id/resend
+func (c *NotificationController) Resend(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid notification ID format",
+		})
+		return
+	}
+
+	notification, err := c.notificationService.Resend(ctx, id)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, helper.ErrNotificationNotFound):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, helper.ErrNotificationNotFailed):
+			statusCode = http.StatusConflict
+		}
+
+		ctx.JSON(statusCode, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    notification,
+		Message: "Notification queued for delivery",
+	})
+}
+
+// Preference handles GET /api/v1/notifications/preferences
+func (c *NotificationController) Preference(ctx *gin.Context) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	preference, err := c.advisorNotificationService.GetPreference(ctx, token)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    preference,
+		Message: "Notification preference fetched successfully",
+	})
+}
+
+// UpdatePreference handles PUT /api/v1/notifications/preferences
+func (c *NotificationController) UpdatePreference(ctx *gin.Context) {
+	var request dto.AdvisorNotificationPreferenceRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	preference, err := c.advisorNotificationService.UpdatePreference(ctx, token, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    preference,
+		Message: "Notification preference updated successfully",
+	})
+}
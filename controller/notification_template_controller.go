@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationTemplateController struct {
+	notificationTemplateService service.NotificationTemplateService
+}
+
+func NewNotificationTemplateController(notificationTemplateService service.NotificationTemplateService) *NotificationTemplateController {
+	return &NotificationTemplateController{
+		notificationTemplateService: notificationTemplateService,
+	}
+}
+
+// Index handles GET /api/v1/notifications/templates
+func (c *NotificationTemplateController) Index(ctx *gin.Context) {
+	notificationTemplates, err := c.notificationTemplateService.Index(ctx)
+	if err != nil {
+		log.Println("ERROR GETTING NOTIFICATION TEMPLATES: ", err)
+		ctx.JSON(http.StatusInternalServerError, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    notificationTemplates,
+		Message: "Notification templates fetched successfully",
+	})
+}
+
+// Create handles POST /api/v1/notifications/templates
+func (c *NotificationTemplateController) Create(ctx *gin.Context) {
+	var request dto.NotificationTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	notificationTemplate, err := c.notificationTemplateService.Create(ctx, request)
+	if err != nil {
+		ctx.JSON(notificationTemplateErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    notificationTemplate,
+		Message: "Notification template created successfully",
+	})
+}
+
+// Update handles PUT /api/v1/notifications/templates/:id
+func (c *NotificationTemplateController) Update(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid notification template ID format",
+		})
+		return
+	}
+
+	var request dto.NotificationTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	notificationTemplate, err := c.notificationTemplateService.Update(ctx, id, request)
+	if err != nil {
+		ctx.JSON(notificationTemplateErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    notificationTemplate,
+		Message: "Notification template updated successfully",
+	})
+}
+
+// Destroy handles DELETE /api/v1/notifications/templates/:id, the embedded default applies again
+func (c *NotificationTemplateController) Destroy(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid notification template ID format",
+		})
+		return
+	}
+
+	if err := c.notificationTemplateService.Destroy(ctx, id); err != nil {
+		ctx.JSON(notificationTemplateErrorStatus(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Message: "Notification template deleted successfully",
+	})
+}
+
+// Locale handles GET /api/v1/notifications/locale
+func (c *NotificationTemplateController) Locale(ctx *gin.Context) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	locale, err := c.notificationTemplateService.GetLocale(ctx, token)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    locale,
+		Message: "Notification locale fetched successfully",
+	})
+}
+
+// UpdateLocale handles PUT /api/v1/notifications/locale
+func (c *NotificationTemplateController) UpdateLocale(ctx *gin.Context) {
+	var request dto.UserLocaleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	locale, err := c.notificationTemplateService.UpdateLocale(ctx, token, request)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    locale,
+		Message: "Notification locale updated successfully",
+	})
+}
+
+func notificationTemplateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, helper.ErrNotificationTemplateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, helper.ErrNotificationTemplateExists):
+		return http.StatusConflict
+	case errors.Is(err, helper.ErrInvalidNotificationTemplate), errors.Is(err, helper.ErrUnsupportedLocale):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
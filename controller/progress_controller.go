@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProgressController struct {
+	progressService service.ProgressService
+}
+
+func NewProgressController(progressService service.ProgressService) *ProgressController {
+	return &ProgressController{
+		progressService: progressService,
+	}
+}
+
+// FindByRegistrationID handles GET /api/v1/registrations/:id/progress
+func (c *ProgressController) FindByRegistrationID(ctx *gin.Context) {
+	id, token, ok := registrationRequest(ctx)
+	if !ok {
+		return
+	}
+
+	progress, err := c.progressService.FindByRegistrationID(ctx, id, token)
+	if err != nil {
+		ctx.JSON(registrationStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    progress,
+		Message: "Registration progress fetched successfully",
+	})
+}
+
+// AdvisorDashboard handles GET /api/v1/dashboard/advisor?sort=most_pending
+func (c *ProgressController) AdvisorDashboard(ctx *gin.Context) {
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	_, _, err := helper.ValidatePaginationParams(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	sortBy := ctx.Query("sort")
+	if !helper.ValidateDashboardSort(sortBy) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: helper.ErrInvalidDashboardSort.Error(),
+		})
+		return
+	}
+
+	pagReq := helper.Pagination(ctx)
+
+	dashboard, metaData, err := c.progressService.FindAdvisorDashboard(ctx, token, pagReq, sortBy)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:             dto.STATUS_SUCCESS,
+		Data:               dashboard,
+		Message:            "Advisor dashboard fetched successfully",
+		PaginationResponse: &metaData,
+	})
+}
@@ -1,11 +1,13 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
 	"monitoring-service/dto"
 	"monitoring-service/helper"
 	"monitoring-service/service"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -62,6 +64,20 @@ func validateReportData(reportRequest dto.ReportRequest, ctx *gin.Context) {
 	}
 }
 
+// reportStatusCode maps report lifecycle errors to their HTTP status, falling back to 400
+func reportStatusCode(err error) int {
+	switch {
+	case errors.Is(err, helper.ErrInvalidReportStatus), errors.Is(err, helper.ErrReportDeadlinePassed):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, helper.ErrReportStatusConflict), errors.Is(err, helper.ErrReportLocked):
+		return http.StatusConflict
+	case errors.Is(err, helper.ErrForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
 func NewReportController(reportService service.ReportService) *ReportController {
 	return &ReportController{
 		reportService: reportService,
@@ -118,7 +134,7 @@ func (c *ReportController) Approval(ctx *gin.Context) {
 
 	err := c.reportService.Approval(ctx, token, reportApprovalRequest)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -218,7 +234,7 @@ func (c *ReportController) Create(ctx *gin.Context) {
 
 	report, err := c.reportService.Create(ctx, reportRequest, file, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -274,9 +290,18 @@ func (c *ReportController) Update(ctx *gin.Context) {
 		return
 	}
 
-	err := c.reportService.Update(ctx, id, reportRequest)
+	token := ctx.GetHeader("Authorization")
+	if token == "" {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Token is required",
+		})
+		return
+	}
+
+	err := c.reportService.Update(ctx, id, reportRequest, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -339,7 +364,7 @@ func (c *ReportController) Show(ctx *gin.Context) {
 
 	report, err := c.reportService.FindByID(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -364,9 +389,18 @@ func (c *ReportController) Destroy(ctx *gin.Context) {
 		return
 	}
 
-	err := c.reportService.Destroy(ctx, id)
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	err := c.reportService.Destroy(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -414,9 +448,9 @@ func (c *ReportController) FindByReportScheduleID(ctx *gin.Context) {
 		return
 	}
 
-	reports, err := c.reportService.FindByReportScheduleID(ctx, reportScheduleID)
+	reports, err := c.reportService.FindByReportScheduleID(ctx, reportScheduleID, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(reportStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -429,3 +463,101 @@ func (c *ReportController) FindByReportScheduleID(ctx *gin.Context) {
 		Message: "Reports fetched successfully",
 	})
 }
+
+// Revisions handles GET /api/v1/reports/:id/revisions
+func (c *ReportController) Revisions(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid ID format",
+		})
+		return
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	revisions, err := c.reportService.FindRevisions(ctx, id, token)
+	if err != nil {
+		ctx.JSON(reportStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    revisions,
+		Message: "Report revisions fetched successfully",
+	})
+}
+
+// DiffRevisions handles GET /api/v1/reports/:id/revisions/diff?from=1&to=2
+func (c *ReportController) DiffRevisions(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if !helper.ValidateUUID(id) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid ID format",
+		})
+		return
+	}
+
+	fromRevision, err := strconv.Atoi(ctx.Query("from"))
+	if err != nil || fromRevision < 1 {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid from revision",
+		})
+		return
+	}
+
+	toRevision, err := strconv.Atoi(ctx.Query("to"))
+	if err != nil || toRevision < 1 {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid to revision",
+		})
+		return
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	diff, err := c.reportService.DiffRevisions(ctx, id, fromRevision, toRevision, token)
+	if err != nil {
+		if err.Error() == "record not found" {
+			ctx.JSON(http.StatusNotFound, dto.Response{
+				Status:  dto.STATUS_ERROR,
+				Message: "Report revision not found",
+			})
+			return
+		}
+
+		ctx.JSON(reportStatusCode(err), dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    diff,
+		Message: "Report revisions compared successfully",
+	})
+}
@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"log"
 	"monitoring-service/dto"
 	"monitoring-service/helper"
@@ -14,6 +16,18 @@ type ReportScheduleController struct {
 	reportScheduleService service.ReportScheduleService
 }
 
+// reportScheduleStatusCode maps report schedule errors to their HTTP status, falling back to 500
+func reportScheduleStatusCode(err error) int {
+	switch {
+	case errors.Is(err, helper.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrRegistrationNotFound), err.Error() == "record not found":
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func NewReportScheduleController(reportScheduleService service.ReportScheduleService) *ReportScheduleController {
 	return &ReportScheduleController{
 		reportScheduleService: reportScheduleService,
@@ -21,6 +35,17 @@ func NewReportScheduleController(reportScheduleService service.ReportScheduleSer
 }
 
 func (c *ReportScheduleController) FindByAdvisorEmail(ctx *gin.Context) {
+	findGroupedByStudent(ctx, c.reportScheduleService.FindByAdvisorEmail)
+}
+
+// FindByMonitorEmail handles POST /api/v1/report-schedules/monitor
+func (c *ReportScheduleController) FindByMonitorEmail(ctx *gin.Context) {
+	findGroupedByStudent(ctx, c.reportScheduleService.FindByMonitorEmail)
+}
+
+// findGroupedByStudent serves the report schedule listings grouped by student, find being the
+// listing of the advisor or of the monitoring lecturer
+func findGroupedByStudent(ctx *gin.Context, find func(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error)) {
 	token := ctx.GetHeader("Authorization")
 	if token == "" {
 		ctx.JSON(http.StatusUnauthorized, dto.Response{
@@ -41,7 +66,17 @@ func (c *ReportScheduleController) FindByAdvisorEmail(ctx *gin.Context) {
 		return
 	}
 
-	reportSchedules, metaData, err := c.reportScheduleService.FindByAdvisorEmail(ctx, token, pagReq, reportScheduleRequest)
+	switch reportScheduleRequest.SubmissionStatus {
+	case "", helper.SUBMISSION_STATUS_ON_TIME, helper.SUBMISSION_STATUS_LATE, helper.SUBMISSION_STATUS_MISSING:
+	default:
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid submission status",
+		})
+		return
+	}
+
+	reportSchedules, metaData, err := find(ctx, token, pagReq, reportScheduleRequest)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, dto.Response{
 			Status:  dto.STATUS_ERROR,
@@ -168,7 +203,7 @@ func (c *ReportScheduleController) Create(ctx *gin.Context) {
 
 	reportSchedule, err := c.reportScheduleService.Create(ctx, reportScheduleRequest, token)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, dto.Response{
+		ctx.JSON(reportScheduleStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -182,6 +217,84 @@ func (c *ReportScheduleController) Create(ctx *gin.Context) {
 	})
 }
 
+// Generate handles POST /api/v1/report-schedules/registrations/:id/generate
+func (c *ReportScheduleController) Generate(ctx *gin.Context) {
+	registrationID := ctx.Param("id")
+	if !helper.ValidateUUID(registrationID) {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid Registration ID format",
+		})
+		return
+	}
+
+	token := ctx.GetHeader("Authorization")
+	if token == "" {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Token is required",
+		})
+		return
+	}
+
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid token format",
+		})
+		return
+	}
+
+	var generateRequest dto.ReportScheduleGenerateRequest
+	if err := ctx.ShouldBindJSON(&generateRequest); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	generateRequest.StartDate = helper.SanitizeString(generateRequest.StartDate)
+	generateRequest.EndDate = helper.SanitizeString(generateRequest.EndDate)
+	generateRequest.Cadence = helper.SanitizeString(generateRequest.Cadence)
+
+	if _, err := helper.ResolveCadenceDays(generateRequest.Cadence); err != nil {
+		ctx.JSON(http.StatusBadRequest, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Cadence must be WEEKLY or BIWEEKLY",
+		})
+		return
+	}
+
+	result, err := c.reportScheduleService.Generate(ctx, registrationID, generateRequest, token)
+	if err != nil {
+		if errors.Is(err, helper.ErrForbidden) {
+			ctx.JSON(http.StatusForbidden, dto.Response{
+				Status:  dto.STATUS_ERROR,
+				Message: "Access denied",
+			})
+			return
+		}
+
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, helper.ErrInvalidDateRange) {
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dto.Response{
+		Status:  dto.STATUS_SUCCESS,
+		Data:    result,
+		Message: "Report schedules generated successfully",
+	})
+}
+
 // Update handles PUT /api/v1/report-schedules/:id
 func (c *ReportScheduleController) Update(ctx *gin.Context) {
 	id := ctx.Param("id")
@@ -254,7 +367,7 @@ func (c *ReportScheduleController) Update(ctx *gin.Context) {
 
 	err := c.reportScheduleService.Update(ctx, id, reportScheduleRequest, token)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, dto.Response{
+		ctx.JSON(reportScheduleStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -289,7 +402,7 @@ func (c *ReportScheduleController) Show(ctx *gin.Context) {
 
 	reportSchedule, err := c.reportScheduleService.FindByID(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, dto.Response{
+		ctx.JSON(reportScheduleStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -350,7 +463,7 @@ func (c *ReportScheduleController) Destroy(ctx *gin.Context) {
 			return
 		}
 
-		if err.Error() == "user role not allowed" {
+		if errors.Is(err, helper.ErrForbidden) {
 			ctx.JSON(http.StatusForbidden, dto.Response{
 				Status:  dto.STATUS_ERROR,
 				Message: "Access denied",
@@ -384,9 +497,18 @@ func (c *ReportScheduleController) FindByRegistrationID(ctx *gin.Context) {
 		return
 	}
 
-	reportSchedules, err := c.reportScheduleService.FindByRegistrationID(ctx, registrationID)
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	reportSchedules, err := c.reportScheduleService.FindByRegistrationID(ctx, registrationID, token)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, dto.Response{
+		ctx.JSON(reportScheduleStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"errors"
 	"fmt"
 	"monitoring-service/dto"
 	"monitoring-service/helper"
@@ -14,6 +15,15 @@ type SyllabusController struct {
 	syllabusService service.SyllabusService
 }
 
+// documentStatusCode maps syllabus and transcript errors to their HTTP status, falling back to 400
+func documentStatusCode(err error) int {
+	if errors.Is(err, helper.ErrForbidden) {
+		return http.StatusForbidden
+	}
+
+	return http.StatusBadRequest
+}
+
 func NewSyllabusController(syllabusService service.SyllabusService) *SyllabusController {
 	return &SyllabusController{
 		syllabusService: syllabusService,
@@ -297,9 +307,9 @@ func (c *SyllabusController) Update(ctx *gin.Context) {
 		return
 	}
 
-	err := c.syllabusService.Update(ctx, id, syllabusRequest)
+	err := c.syllabusService.Update(ctx, id, syllabusRequest, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -351,7 +361,7 @@ func (c *SyllabusController) Show(ctx *gin.Context) {
 
 	syllabus, err := c.syllabusService.FindByID(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -398,9 +408,9 @@ func (c *SyllabusController) Destroy(ctx *gin.Context) {
 		return
 	}
 
-	err := c.syllabusService.Destroy(ctx, id)
+	err := c.syllabusService.Destroy(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -447,9 +457,9 @@ func (c *SyllabusController) FindByRegistrationID(ctx *gin.Context) {
 		return
 	}
 
-	syllabuses, err := c.syllabusService.FindByRegistrationID(ctx, registrationID)
+	syllabuses, err := c.syllabusService.FindByRegistrationID(ctx, registrationID, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -499,9 +509,9 @@ func (c *SyllabusController) FindAllByRegistrationID(ctx *gin.Context) {
 		return
 	}
 
-	syllabuses, err := c.syllabusService.FindAllByRegistrationID(ctx, registrationID)
+	syllabuses, err := c.syllabusService.FindAllByRegistrationID(ctx, registrationID, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
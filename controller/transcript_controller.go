@@ -283,9 +283,9 @@ func (c *TranscriptController) Update(ctx *gin.Context) {
 		return
 	}
 
-	err := c.transcriptService.Update(ctx, id, transcriptRequest)
+	err := c.transcriptService.Update(ctx, id, transcriptRequest, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -336,7 +336,7 @@ func (c *TranscriptController) Show(ctx *gin.Context) {
 
 	transcript, err := c.transcriptService.FindByID(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -386,9 +386,9 @@ func (c *TranscriptController) Destroy(ctx *gin.Context) {
 		return
 	}
 
-	err := c.transcriptService.Destroy(ctx, id)
+	err := c.transcriptService.Destroy(ctx, id, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -412,9 +412,18 @@ func (c *TranscriptController) FindByRegistrationID(ctx *gin.Context) {
 		return
 	}
 
-	transcripts, err := c.transcriptService.FindByRegistrationID(ctx, registrationID)
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	transcripts, err := c.transcriptService.FindByRegistrationID(ctx, registrationID, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
@@ -465,9 +474,18 @@ func (c *TranscriptController) FindAllByRegistrationID(ctx *gin.Context) {
 		return
 	}
 
-	transcripts, err := c.transcriptService.FindAllByRegistrationID(ctx, registrationID)
+	token := ctx.GetHeader("Authorization")
+	if !helper.IsValidTokenFormat(token) {
+		ctx.JSON(http.StatusUnauthorized, dto.Response{
+			Status:  dto.STATUS_ERROR,
+			Message: "Invalid authorization format",
+		})
+		return
+	}
+
+	transcripts, err := c.transcriptService.FindAllByRegistrationID(ctx, registrationID, token)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, dto.Response{
+		ctx.JSON(documentStatusCode(err), dto.Response{
 			Status:  dto.STATUS_ERROR,
 			Message: err.Error(),
 		})
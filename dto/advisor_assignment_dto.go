@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+type (
+	// AdvisorAssignmentRequest adds a co-advisor to a registration, Role defaults to SECONDARY
+	AdvisorAssignmentRequest struct {
+		RegistrationID string `json:"registration_id" binding:"required"`
+		AdvisorID      string `json:"advisor_id"`
+		AdvisorEmail   string `json:"advisor_email" binding:"required"`
+		Role           string `json:"role"`
+	}
+
+	AdvisorAssignmentResponse struct {
+		ID             string     `json:"id"`
+		RegistrationID string     `json:"registration_id"`
+		AdvisorID      string     `json:"advisor_id"`
+		AdvisorEmail   string     `json:"advisor_email"`
+		Role           string     `json:"role"`
+		AssignedBy     string     `json:"assigned_by"`
+		CreatedAt      *time.Time `json:"created_at"`
+	}
+
+	// AdvisorsResponse lists everyone who may act as advisor of a registration right now
+	AdvisorsResponse struct {
+		RegistrationID string                      `json:"registration_id"`
+		Assignments    []AdvisorAssignmentResponse `json:"assignments"`
+		Delegations    []AdvisorDelegationResponse `json:"delegations"`
+	}
+
+	// AdvisorDelegationRequest hands the reviews of DelegatorEmail to DelegateEmail from StartDate
+	// until EndDate, both included. Dates are RFC 3339 timestamps or YYYY-MM-DD days, and an advisor
+	// may leave DelegatorEmail empty to delegate their own reviews.
+	AdvisorDelegationRequest struct {
+		DelegatorEmail string `json:"delegator_email"`
+		DelegateEmail  string `json:"delegate_email" binding:"required"`
+		StartDate      string `json:"start_date" binding:"required"`
+		EndDate        string `json:"end_date" binding:"required"`
+		Reason         string `json:"reason"`
+	}
+
+	AdvisorDelegationResponse struct {
+		ID             string     `json:"id"`
+		DelegatorEmail string     `json:"delegator_email"`
+		DelegateEmail  string     `json:"delegate_email"`
+		StartsAt       *time.Time `json:"starts_at"`
+		EndsAt         *time.Time `json:"ends_at"`
+		Reason         string     `json:"reason"`
+		CreatedBy      string     `json:"created_by"`
+		Active         bool       `json:"active"`
+	}
+)
@@ -0,0 +1,50 @@
+package dto
+
+type (
+	AnalyticsFilterRequest struct {
+		StartDate    string `form:"start_date"`
+		EndDate      string `form:"end_date"`
+		ActivityName string `form:"activity_name"`
+		AdvisorEmail string `form:"advisor_email"`
+		Limit        int    `form:"limit"`
+	}
+
+	WeeklySubmissionRateResponse struct {
+		Week           int     `json:"week"`
+		Due            int64   `json:"due"`
+		Submitted      int64   `json:"submitted"`
+		OnTime         int64   `json:"on_time"`
+		Late           int64   `json:"late"`
+		SubmissionRate float64 `json:"submission_rate"`
+	}
+
+	ApprovalLatencyResponse struct {
+		Reviewed       int64   `json:"reviewed"`
+		AverageSeconds float64 `json:"average_seconds"`
+		MedianSeconds  float64 `json:"median_seconds"`
+		P90Seconds     float64 `json:"p90_seconds"`
+		MaxSeconds     float64 `json:"max_seconds"`
+	}
+
+	AdvisorBacklogResponse struct {
+		AcademicAdvisorEmail string `json:"academic_advisor_email"`
+		PendingReviews       int64  `json:"pending_reviews"`
+		OldestPendingAt      string `json:"oldest_pending_at"`
+	}
+
+	ActivityComplianceResponse struct {
+		ActivityName   string  `json:"activity_name"`
+		Due            int64   `json:"due"`
+		OnTime         int64   `json:"on_time"`
+		Late           int64   `json:"late"`
+		Missing        int64   `json:"missing"`
+		ComplianceRate float64 `json:"compliance_rate"`
+	}
+
+	AnalyticsResponse struct {
+		SubmissionRateByWeek []WeeklySubmissionRateResponse `json:"submission_rate_by_week"`
+		ApprovalLatency      ApprovalLatencyResponse        `json:"approval_latency"`
+		AdvisorBacklog       []AdvisorBacklogResponse       `json:"advisor_backlog"`
+		LowestCompliance     []ActivityComplianceResponse   `json:"lowest_compliance"`
+	}
+)
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	// AuditEventFilterRequest narrows the audit trail, dates are RFC 3339 timestamps or YYYY-MM-DD days
+	AuditEventFilterRequest struct {
+		ResourceType string `form:"resource_type"`
+		ResourceID   string `form:"resource_id"`
+		ActorID      string `form:"actor_id"`
+		ActorEmail   string `form:"actor_email"`
+		Action       string `form:"action"`
+		StartDate    string `form:"start_date"`
+		EndDate      string `form:"end_date"`
+	}
+
+	// AuditEventResponse is one recorded change, Before is null for creations and After for deletions
+	AuditEventResponse struct {
+		ID           string          `json:"id"`
+		ActorID      string          `json:"actor_id"`
+		ActorRole    string          `json:"actor_role"`
+		ActorEmail   string          `json:"actor_email"`
+		Action       string          `json:"action"`
+		ResourceType string          `json:"resource_type"`
+		ResourceID   string          `json:"resource_id"`
+		Before       json.RawMessage `json:"before"`
+		After        json.RawMessage `json:"after"`
+		RequestID    string          `json:"request_id"`
+		IPAddress    string          `json:"ip_address"`
+		OccurredAt   *time.Time      `json:"occurred_at"`
+	}
+)
@@ -0,0 +1,68 @@
+package dto
+
+import "time"
+
+// DOMAIN_EVENT_SCHEMA_VERSION is bumped whenever the data of an event changes incompatibly
+const DOMAIN_EVENT_SCHEMA_VERSION = 1
+
+const DOMAIN_EVENT_SOURCE = "monitoring-service"
+
+const (
+	DOMAIN_EVENT_REPORT_SUBMITTED        = "report.submitted"
+	DOMAIN_EVENT_REPORT_APPROVED         = "report.approved"
+	DOMAIN_EVENT_REPORT_REJECTED         = "report.rejected"
+	DOMAIN_EVENT_SYLLABUS_UPLOADED       = "syllabus.uploaded"
+	DOMAIN_EVENT_TRANSCRIPT_UPLOADED     = "transcript.uploaded"
+	DOMAIN_EVENT_REPORT_SCHEDULE_CREATED = "report_schedule.created"
+)
+
+type (
+	// DomainEvent is the envelope every published event shares. Type is also the routing key,
+	// and consumers must check Version before reading Data.
+	DomainEvent struct {
+		ID         string      `json:"id"`
+		Type       string      `json:"type"`
+		Version    int         `json:"version"`
+		Source     string      `json:"source"`
+		OccurredAt time.Time   `json:"occurred_at"`
+		Data       interface{} `json:"data"`
+	}
+
+	ReportEventData struct {
+		ReportID             string     `json:"report_id"`
+		ReportScheduleID     string     `json:"report_schedule_id"`
+		RegistrationID       string     `json:"registration_id"`
+		UserID               string     `json:"user_id"`
+		UserNRP              string     `json:"user_nrp"`
+		AcademicAdvisorEmail string     `json:"academic_advisor_email"`
+		ReportType           string     `json:"report_type"`
+		Week                 int        `json:"week"`
+		Status               string     `json:"status"`
+		Feedback             string     `json:"feedback,omitempty"`
+		IsLate               bool       `json:"is_late"`
+		SubmittedAt          *time.Time `json:"submitted_at,omitempty"`
+		ReviewedAt           *time.Time `json:"reviewed_at,omitempty"`
+	}
+
+	DocumentEventData struct {
+		ID                   string `json:"id"`
+		RegistrationID       string `json:"registration_id"`
+		UserID               string `json:"user_id"`
+		UserNRP              string `json:"user_nrp"`
+		AcademicAdvisorEmail string `json:"academic_advisor_email"`
+		Title                string `json:"title"`
+		FileStorageID        string `json:"file_storage_id"`
+	}
+
+	ReportScheduleEventData struct {
+		ReportScheduleID     string    `json:"report_schedule_id"`
+		RegistrationID       string    `json:"registration_id"`
+		UserID               string    `json:"user_id"`
+		UserNRP              string    `json:"user_nrp"`
+		AcademicAdvisorEmail string    `json:"academic_advisor_email"`
+		ReportType           string    `json:"report_type"`
+		Week                 int       `json:"week"`
+		StartDate            time.Time `json:"start_date"`
+		EndDate              time.Time `json:"end_date"`
+	}
+)
@@ -0,0 +1,12 @@
+package dto
+
+type (
+	RegistrationDossierResponse struct {
+		ID               string `json:"id"`
+		RegistrationID   string `json:"registration_id"`
+		FileStorageID    string `json:"file_storage_id"`
+		GeneratedByID    string `json:"generated_by_id"`
+		GeneratedByEmail string `json:"generated_by_email"`
+		GeneratedAt      string `json:"generated_at"`
+	}
+)
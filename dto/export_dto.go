@@ -0,0 +1,11 @@
+package dto
+
+type (
+	ExportFilterRequest struct {
+		Format           string `form:"format"`
+		UserNRP          string `form:"user_nrp"`
+		ActivityName     string `form:"activity_name"`
+		AdvisorEmail     string `form:"advisor_email"`
+		SubmissionStatus string `form:"submission_status"`
+	}
+)
@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+type (
+	// MonitorAssignmentRequest makes a monitoring and evaluation lecturer a monitor of a registration
+	MonitorAssignmentRequest struct {
+		RegistrationID string `json:"registration_id" binding:"required"`
+		MonitorID      string `json:"monitor_id"`
+		MonitorEmail   string `json:"monitor_email" binding:"required"`
+	}
+
+	MonitorAssignmentResponse struct {
+		ID             string     `json:"id"`
+		RegistrationID string     `json:"registration_id"`
+		MonitorID      string     `json:"monitor_id"`
+		MonitorEmail   string     `json:"monitor_email"`
+		AssignedBy     string     `json:"assigned_by"`
+		CreatedAt      *time.Time `json:"created_at"`
+	}
+
+	ReportEvaluationRequest struct {
+		Note string `json:"note" binding:"required"`
+	}
+
+	ReportEvaluationResponse struct {
+		ID             string     `json:"id"`
+		ReportID       string     `json:"report_id"`
+		RegistrationID string     `json:"registration_id"`
+		MonitorID      string     `json:"monitor_id"`
+		MonitorEmail   string     `json:"monitor_email"`
+		Note           string     `json:"note"`
+		CreatedAt      *time.Time `json:"created_at"`
+	}
+
+	// MonitoringVisitRequest assesses a monitoring visit. VisitDate is an RFC 3339 timestamp or a
+	// YYYY-MM-DD day, Score goes from 0 to 100.
+	MonitoringVisitRequest struct {
+		VisitDate string `json:"visit_date" binding:"required"`
+		Score     *int   `json:"score" binding:"required"`
+		Notes     string `json:"notes"`
+	}
+
+	MonitoringVisitResponse struct {
+		ID             string     `json:"id"`
+		RegistrationID string     `json:"registration_id"`
+		MonitorID      string     `json:"monitor_id"`
+		MonitorEmail   string     `json:"monitor_email"`
+		VisitedAt      *time.Time `json:"visited_at"`
+		Score          int        `json:"score"`
+		Notes          string     `json:"notes"`
+		CreatedAt      *time.Time `json:"created_at"`
+	}
+)
@@ -0,0 +1,103 @@
+package dto
+
+import "time"
+
+type (
+	// NotificationRequest is a notification handed to the broker service
+	NotificationRequest struct {
+		SenderName    string `json:"sender_name"`
+		SenderEmail   string `json:"sender_email"`
+		ReceiverEmail string `json:"receiver_email"`
+		Type          string `json:"type"`
+		Subject       string `json:"subject"`
+		Message       string `json:"message"`
+	}
+
+	// NotificationResult is the broker service answer to a notification
+	NotificationResult struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+)
+
+type (
+	// NotificationResponse is a queued notification with the outcome of its delivery attempts
+	NotificationResponse struct {
+		ID            string                        `json:"id"`
+		SenderName    string                        `json:"sender_name"`
+		SenderEmail   string                        `json:"sender_email"`
+		ReceiverEmail string                        `json:"receiver_email"`
+		Type          string                        `json:"type"`
+		Subject       string                        `json:"subject"`
+		Message       string                        `json:"message"`
+		Status        string                        `json:"status"`
+		Attempts      int                           `json:"attempts"`
+		NextAttemptAt *time.Time                    `json:"next_attempt_at"`
+		LastError     string                        `json:"last_error"`
+		SentAt        *time.Time                    `json:"sent_at"`
+		CreatedAt     *time.Time                    `json:"created_at"`
+		AttemptLog    []NotificationAttemptResponse `json:"attempt_log"`
+	}
+
+	NotificationAttemptResponse struct {
+		Attempt     int        `json:"attempt"`
+		Succeeded   bool       `json:"succeeded"`
+		Error       string     `json:"error"`
+		AttemptedAt *time.Time `json:"attempted_at"`
+	}
+)
+
+type (
+	AdvisorNotificationPreferenceRequest struct {
+		DailyDigest *bool `json:"daily_digest" binding:"required"`
+	}
+
+	AdvisorNotificationPreferenceResponse struct {
+		AdvisorEmail string `json:"advisor_email"`
+		DailyDigest  bool   `json:"daily_digest"`
+	}
+)
+
+type (
+	// NotificationTemplateData is what notification templates can refer to
+	NotificationTemplateData struct {
+		StudentName  string
+		StudentNRP   string
+		AdvisorName  string
+		ActivityName string
+		ReportType   string
+		Week         int
+		Status       string
+		Feedback     string
+		Title        string
+		Deadline     string
+		Link         string
+		Items        []string
+	}
+
+	NotificationTemplateRequest struct {
+		Type    string `json:"type" binding:"required"`
+		Locale  string `json:"locale" binding:"required"`
+		Subject string `json:"subject"`
+		Body    string `json:"body" binding:"required"`
+	}
+
+	// NotificationTemplateResponse is a stored template, or an embedded default when ID is empty
+	NotificationTemplateResponse struct {
+		ID      string `json:"id,omitempty"`
+		Type    string `json:"type"`
+		Locale  string `json:"locale"`
+		Subject string `json:"subject"`
+		Body    string `json:"body"`
+		Default bool   `json:"default"`
+	}
+
+	UserLocaleRequest struct {
+		Locale string `json:"locale" binding:"required"`
+	}
+
+	UserLocaleResponse struct {
+		UserEmail string `json:"user_email"`
+		Locale    string `json:"locale"`
+	}
+)
@@ -0,0 +1,42 @@
+package dto
+
+type (
+	RegistrationProgressResponse struct {
+		RegistrationID       string  `json:"registration_id"`
+		UserID               string  `json:"user_id"`
+		UserNRP              string  `json:"user_nrp"`
+		AcademicAdvisorEmail string  `json:"academic_advisor_email"`
+		TotalWeeks           int64   `json:"total_weeks"`
+		ApprovedWeeks        int64   `json:"approved_weeks"`
+		PendingWeeks         int64   `json:"pending_weeks"`
+		RevisionWeeks        int64   `json:"revision_requested_weeks"`
+		RejectedWeeks        int64   `json:"rejected_weeks"`
+		MissingWeeks         int64   `json:"missing_weeks"`
+		LateWeeks            int64   `json:"late_weeks"`
+		CompletionPercentage float64 `json:"completion_percentage"`
+		NextDueWeek          *int    `json:"next_due_week"`
+		NextDueDate          string  `json:"next_due_date"`
+		SyllabusUploaded     bool    `json:"syllabus_uploaded"`
+		TranscriptUploaded   bool    `json:"transcript_uploaded"`
+	}
+
+	AdvisorDashboardStudentResponse struct {
+		UserID          string `json:"user_id"`
+		UserNRP         string `json:"user_nrp"`
+		Registrations   int64  `json:"registrations"`
+		PendingReviews  int64  `json:"pending_reviews"`
+		OverdueWeeks    int64  `json:"overdue_weeks"`
+		LastSubmittedAt string `json:"last_submitted_at"`
+	}
+
+	AdvisorDashboardTotalsResponse struct {
+		Students       int64 `json:"students"`
+		PendingReviews int64 `json:"pending_reviews"`
+		OverdueWeeks   int64 `json:"overdue_weeks"`
+	}
+
+	AdvisorDashboardResponse struct {
+		Totals   AdvisorDashboardTotalsResponse    `json:"totals"`
+		Students []AdvisorDashboardStudentResponse `json:"students"`
+	}
+)
@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+const (
+	REGISTRATION_EVENT_CREATED  = "registration.created"
+	REGISTRATION_EVENT_UPDATED  = "registration.updated"
+	REGISTRATION_EVENT_DELETED  = "registration.deleted"
+	REGISTRATION_EVENT_APPROVED = "registration.approved"
+)
+
+type (
+	// Registration is an activity registration as returned by the registration management service
+	Registration struct {
+		ID                   string `json:"id"`
+		UserID               string `json:"user_id"`
+		UserNRP              string `json:"user_nrp"`
+		UserName             string `json:"user_name"`
+		AcademicAdvisor      string `json:"academic_advisor"`
+		AcademicAdvisorEmail string `json:"academic_advisor_email"`
+		ActivityName         string `json:"activity_name"`
+		ApprovalStatus       bool   `json:"approval_status"`
+	}
+
+	// RegistrationEvent is published by the registration management service whenever a registration changes
+	RegistrationEvent struct {
+		Event      string       `json:"event"`
+		OccurredAt time.Time    `json:"occurred_at"`
+		Data       Registration `json:"data"`
+	}
+
+	// RegistrationApprovedEvent is published once a registration is approved, with the activity window to monitor
+	RegistrationApprovedEvent struct {
+		Event      string               `json:"event"`
+		OccurredAt time.Time            `json:"occurred_at"`
+		Data       RegistrationApproval `json:"data"`
+	}
+
+	RegistrationApproval struct {
+		Registration
+		ActivityStartDate time.Time `json:"activity_start_date"`
+		ActivityEndDate   time.Time `json:"activity_end_date"`
+	}
+)
@@ -6,6 +6,7 @@ type (
 		Title            string `form:"title" validate:"required"`
 		Content          string `form:"content"`
 		ReportType       string `form:"report_type" validate:"oneof=WEEKLY_REPORT FINAL_REPORT"`
+		Status           string `form:"status" validate:"omitempty,oneof=DRAFT PENDING"`
 	}
 
 	ReportUpdateRequest struct {
@@ -14,8 +15,9 @@ type (
 		ReportType string `form:"report_type" validate:"required,oneof=WEEKLY_REPORT FINAL_REPORT"`
 	}
 
+	// ReportApprovalRequest reviews reports, Status is REVISION_REQUESTED, APPROVED or REJECTED
 	ReportApprovalRequest struct {
-		Status   string   `json:"status" validate:"required"`
+		Status   string   `json:"status" binding:"required"`
 		Feedback string   `json:"feedback"`
 		IDs      []string `json:"ids"`
 	}
@@ -29,5 +31,10 @@ type (
 		ReportType            string `json:"report_type"`
 		Feedback              string `json:"feedback"`
 		AcademicAdvisorStatus string `json:"academic_advisor_status"`
+		SubmittedAt           string `json:"submitted_at"`
+		IsLate                bool   `json:"is_late"`
+		LateSeconds           int64  `json:"late_seconds"`
+		LateFlagged           bool   `json:"late_flagged"`
+		ReviewedAt            string `json:"reviewed_at"`
 	}
 )
@@ -0,0 +1,38 @@
+package dto
+
+type (
+	ReportRevisionResponse struct {
+		ID                    string `json:"id"`
+		ReportID              string `json:"report_id"`
+		RevisionNumber        int    `json:"revision_number"`
+		Title                 string `json:"title"`
+		Content               string `json:"content"`
+		ReportType            string `json:"report_type"`
+		FileStorageID         string `json:"file_storage_id"`
+		Feedback              string `json:"feedback"`
+		AcademicAdvisorStatus string `json:"academic_advisor_status"`
+		ActorID               string `json:"actor_id"`
+		ActorEmail            string `json:"actor_email"`
+		ActorRole             string `json:"actor_role"`
+		CreatedAt             string `json:"created_at"`
+	}
+
+	DiffLine struct {
+		Operation string `json:"operation"`
+		Text      string `json:"text"`
+	}
+
+	ReportRevisionFieldChange struct {
+		Field string     `json:"field"`
+		From  string     `json:"from"`
+		To    string     `json:"to"`
+		Lines []DiffLine `json:"lines,omitempty"`
+	}
+
+	ReportRevisionDiffResponse struct {
+		ReportID string                      `json:"report_id"`
+		From     ReportRevisionResponse      `json:"from"`
+		To       ReportRevisionResponse      `json:"to"`
+		Changes  []ReportRevisionFieldChange `json:"changes"`
+	}
+)
@@ -25,18 +25,34 @@ type (
 		Week                 int             `json:"week"`
 		StartDate            string          `json:"start_date"`
 		EndDate              string          `json:"end_date"`
+		SubmissionStatus     string          `json:"submission_status"`
 		Report               *ReportResponse `json:"report"`
 	}
 
 	ReportScheduleByAdvisorResponse struct {
-		Reports map[string][]ReportScheduleResponse `json:"reports"`
+		Reports  map[string][]ReportScheduleResponse `json:"reports"`
+		Warnings []string                            `json:"warnings,omitempty"`
 	}
 
 	ReportScheduleByStudentResponse struct {
-		Reports map[string][]ReportScheduleResponse `json:"reports"`
+		Reports  map[string][]ReportScheduleResponse `json:"reports"`
+		Warnings []string                            `json:"warnings,omitempty"`
 	}
 
 	ReportScheduleAdvisorRequest struct {
-		UserNRP string `json:"user_nrp"`
+		UserNRP          string `json:"user_nrp"`
+		SubmissionStatus string `json:"submission_status" validate:"omitempty,oneof=ON_TIME LATE MISSING"`
+	}
+
+	ReportScheduleGenerateRequest struct {
+		StartDate          string `json:"start_date" validate:"required"`
+		EndDate            string `json:"end_date" validate:"required"`
+		Cadence            string `json:"cadence" validate:"required,oneof=WEEKLY BIWEEKLY"`
+		IncludeFinalReport bool   `json:"include_final_report"`
+	}
+
+	ReportScheduleGenerateResponse struct {
+		Created []ReportScheduleResponse `json:"created"`
+		Skipped []ReportScheduleResponse `json:"skipped"`
 	}
 )
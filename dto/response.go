@@ -7,6 +7,8 @@ const (
 	STATUS_ERROR         = "error"
 	MESSAGE_UNAUTHORIZED = "Unauthorized"
 	MESSAGE_FORBIDDEN    = "Forbidden"
+	// MESSAGE_SERVICE_UNAVAILABLE is returned while a downstream service cannot be reached
+	MESSAGE_SERVICE_UNAVAILABLE = "Service Unavailable"
 )
 
 type Response struct {
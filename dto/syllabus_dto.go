@@ -24,9 +24,11 @@ type (
 
 	SyllabusAdvisorResponse struct {
 		Syllabuses map[string][]SyllabusResponse `json:"syllabuses"`
+		Warnings   []string                      `json:"warnings,omitempty"`
 	}
 
 	SyllabusByStudentResponse struct {
 		Syllabuses map[string][]SyllabusResponse `json:"syllabuses"`
+		Warnings   []string                      `json:"warnings,omitempty"`
 	}
 )
@@ -24,9 +24,11 @@ type (
 
 	TranscriptAdvisorResponse struct {
 		Transcripts map[string][]TranscriptResponse `json:"transcripts"`
+		Warnings    []string                        `json:"warnings,omitempty"`
 	}
 
 	TranscriptByStudentResponse struct {
 		Transcripts map[string][]TranscriptResponse `json:"transcripts"`
+		Warnings    []string                        `json:"warnings,omitempty"`
 	}
 )
@@ -0,0 +1,51 @@
+package dto
+
+type (
+	// User is a user as returned by the user management service
+	User struct {
+		ID    string `json:"auth_user_id"`
+		NRP   string `json:"nrp"`
+		Name  string `json:"name"`
+		Role  string `json:"role"`
+		Email string `json:"email"`
+	}
+
+	// Principal is the caller identified by a locally verified access token
+	Principal struct {
+		UserID string `json:"user_id"`
+		Email  string `json:"email"`
+		NRP    string `json:"nrp"`
+		Role   string `json:"role"`
+		Name   string `json:"name"`
+	}
+
+	// Dosen is a lecturer as returned by the user management service
+	Dosen struct {
+		ID    string `json:"auth_user_id"`
+		NIP   string `json:"nrp"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+)
+
+// User returns the principal as the user the services read from the request context
+func (p Principal) User() User {
+	return User{
+		ID:    p.UserID,
+		NRP:   p.NRP,
+		Name:  p.Name,
+		Role:  p.Role,
+		Email: p.Email,
+	}
+}
+
+// PrincipalOf returns the principal of a user looked up in user management
+func PrincipalOf(user User) Principal {
+	return Principal{
+		UserID: user.ID,
+		Email:  user.Email,
+		NRP:    user.NRP,
+		Role:   user.Role,
+		Name:   user.Name,
+	}
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// AdvisorAssignment makes a lecturer an advisor of a registration. The primary assignment follows
+	// the advisor the registration names, secondary ones add co-advisors.
+	AdvisorAssignment struct {
+		ID             uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+		RegistrationID string    `json:"registration_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_advisor_assignment_registration_advisor"`
+		AdvisorID      string    `json:"advisor_id" gorm:"type:varchar(255)"`
+		AdvisorEmail   string    `json:"advisor_email" gorm:"type:varchar(255);not null;uniqueIndex:idx_advisor_assignment_registration_advisor;index"`
+		Role           string    `json:"role" gorm:"type:varchar(20);not null"`
+		AssignedBy     string    `json:"assigned_by" gorm:"type:varchar(255)"`
+		BaseModel
+	}
+
+	// AdvisorDelegation lets a lecturer act as advisor for every registration of another advisor from
+	// StartsAt until EndsAt, while that advisor is on leave
+	AdvisorDelegation struct {
+		ID             uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		DelegatorEmail string     `json:"delegator_email" gorm:"type:varchar(255);not null;index"`
+		DelegateEmail  string     `json:"delegate_email" gorm:"type:varchar(255);not null;index"`
+		StartsAt       *time.Time `json:"starts_at" gorm:"not null"`
+		EndsAt         *time.Time `json:"ends_at" gorm:"not null"`
+		Reason         string     `json:"reason" gorm:"type:text"`
+		CreatedBy      string     `json:"created_by" gorm:"type:varchar(255)"`
+		BaseModel
+	}
+)
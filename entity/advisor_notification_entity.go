@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// AdvisorNotificationPreference is how an advisor wants to hear about new submissions
+	AdvisorNotificationPreference struct {
+		AdvisorEmail string `json:"advisor_email" gorm:"type:varchar(255);primaryKey"`
+		DailyDigest  bool   `json:"daily_digest" gorm:"not null;default:false"`
+		BaseModel
+	}
+
+	// AdvisorDigestEntry is a submission held back for the next daily digest of its advisor
+	AdvisorDigestEntry struct {
+		ID           uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		AdvisorEmail string     `json:"advisor_email" gorm:"type:varchar(255);not null;index"`
+		SenderName   string     `json:"sender_name" gorm:"type:varchar(255)"`
+		SenderEmail  string     `json:"sender_email" gorm:"type:varchar(255)"`
+		Type         string     `json:"type" gorm:"type:varchar(100);not null"`
+		Message      string     `json:"message" gorm:"type:text"`
+		DigestedAt   *time.Time `json:"digested_at" gorm:"index"`
+		BaseModel
+	}
+)
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// AuditEvent records who changed a resource, how, and what it looked like before and after
+	AuditEvent struct {
+		ID           uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		ActorID      string     `json:"actor_id" gorm:"type:varchar(255);index"`
+		ActorRole    string     `json:"actor_role" gorm:"type:varchar(100)"`
+		ActorEmail   string     `json:"actor_email" gorm:"type:varchar(255);index"`
+		Action       string     `json:"action" gorm:"type:varchar(50);not null"`
+		ResourceType string     `json:"resource_type" gorm:"type:varchar(100);not null;index:idx_audit_event_resource"`
+		ResourceID   string     `json:"resource_id" gorm:"type:varchar(255);not null;index:idx_audit_event_resource"`
+		Before       *string    `json:"before" gorm:"type:jsonb"`
+		After        *string    `json:"after" gorm:"type:jsonb"`
+		RequestID    string     `json:"request_id" gorm:"type:varchar(255)"`
+		IPAddress    string     `json:"ip_address" gorm:"type:varchar(64)"`
+		OccurredAt   *time.Time `json:"occurred_at" gorm:"not null;index"`
+		BaseModel
+	}
+)
@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// MonitorAssignment makes a monitoring and evaluation lecturer (dosen pemonev) a monitor of a registration
+	MonitorAssignment struct {
+		ID             uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+		RegistrationID string    `json:"registration_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_monitor_assignment_registration_monitor"`
+		MonitorID      string    `json:"monitor_id" gorm:"type:varchar(255)"`
+		MonitorEmail   string    `json:"monitor_email" gorm:"type:varchar(255);not null;uniqueIndex:idx_monitor_assignment_registration_monitor;index"`
+		AssignedBy     string    `json:"assigned_by" gorm:"type:varchar(255)"`
+		BaseModel
+	}
+
+	// ReportEvaluation is a note a monitoring lecturer leaves on a report, kept apart from the advisor feedback
+	ReportEvaluation struct {
+		ID             uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+		ReportID       string    `json:"report_id" gorm:"type:varchar(255);not null;index"`
+		RegistrationID string    `json:"registration_id" gorm:"type:varchar(255);not null;index"`
+		MonitorID      string    `json:"monitor_id" gorm:"type:varchar(255)"`
+		MonitorEmail   string    `json:"monitor_email" gorm:"type:varchar(255);not null"`
+		Note           string    `json:"note" gorm:"type:text;not null"`
+		BaseModel
+	}
+
+	// MonitoringVisit is the assessment a monitoring lecturer gives after visiting the activity of a registration
+	MonitoringVisit struct {
+		ID             uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		RegistrationID string     `json:"registration_id" gorm:"type:varchar(255);not null;index"`
+		MonitorID      string     `json:"monitor_id" gorm:"type:varchar(255)"`
+		MonitorEmail   string     `json:"monitor_email" gorm:"type:varchar(255);not null"`
+		VisitedAt      *time.Time `json:"visited_at" gorm:"not null"`
+		Score          int        `json:"score" gorm:"not null"`
+		Notes          string     `json:"notes" gorm:"type:text"`
+		BaseModel
+	}
+)
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Notification is a message queued for the broker service, delivered by a background worker
+	Notification struct {
+		ID            uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		SenderName    string     `json:"sender_name" gorm:"type:varchar(255)"`
+		SenderEmail   string     `json:"sender_email" gorm:"type:varchar(255)"`
+		ReceiverEmail string     `json:"receiver_email" gorm:"type:varchar(255);not null"`
+		Type          string     `json:"type" gorm:"type:varchar(100);not null"`
+		Subject       string     `json:"subject" gorm:"type:text"`
+		Message       string     `json:"message" gorm:"type:text"`
+		Status        string     `json:"status" gorm:"type:varchar(20);not null;index:idx_notification_due"`
+		Attempts      int        `json:"attempts" gorm:"not null;default:0"`
+		NextAttemptAt *time.Time `json:"next_attempt_at" gorm:"index:idx_notification_due"`
+		LastError     string     `json:"last_error" gorm:"type:text"`
+		SentAt        *time.Time `json:"sent_at"`
+		BaseModel
+	}
+
+	// NotificationAttempt records the outcome of one delivery attempt
+	NotificationAttempt struct {
+		ID             uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		NotificationID string     `json:"notification_id" gorm:"type:varchar(255);not null;index"`
+		Attempt        int        `json:"attempt" gorm:"not null"`
+		Succeeded      bool       `json:"succeeded" gorm:"not null"`
+		Error          string     `json:"error" gorm:"type:text"`
+		AttemptedAt    *time.Time `json:"attempted_at"`
+		BaseModel
+	}
+)
@@ -0,0 +1,22 @@
+package entity
+
+import "github.com/google/uuid"
+
+type (
+	// NotificationTemplate overrides the embedded template of a notification type in one locale
+	NotificationTemplate struct {
+		ID      uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+		Type    string    `json:"type" gorm:"type:varchar(100);not null;uniqueIndex:idx_notification_template_type_locale"`
+		Locale  string    `json:"locale" gorm:"type:varchar(10);not null;uniqueIndex:idx_notification_template_type_locale"`
+		Subject string    `json:"subject" gorm:"type:text"`
+		Body    string    `json:"body" gorm:"type:text;not null"`
+		BaseModel
+	}
+
+	// UserLocalePreference is the locale a user wants notifications in
+	UserLocalePreference struct {
+		UserEmail string `json:"user_email" gorm:"type:varchar(255);primaryKey"`
+		Locale    string `json:"locale" gorm:"type:varchar(10);not null"`
+		BaseModel
+	}
+)
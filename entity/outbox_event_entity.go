@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// OutboxEvent is a domain event written in the same transaction as the change it describes and
+	// published to the message broker afterwards
+	OutboxEvent struct {
+		ID            uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		EventType     string     `json:"event_type" gorm:"type:varchar(255);index"`
+		SchemaVersion int        `json:"schema_version"`
+		AggregateType string     `json:"aggregate_type" gorm:"type:varchar(255)"`
+		AggregateID   string     `json:"aggregate_id" gorm:"type:varchar(255);index"`
+		Payload       string     `json:"payload" gorm:"type:jsonb;not null"`
+		OccurredAt    *time.Time `json:"occurred_at" gorm:"not null;index"`
+		PublishedAt   *time.Time `json:"published_at" gorm:"index"`
+		Attempts      int        `json:"attempts" gorm:"default:0"`
+		LastError     string     `json:"last_error"`
+		BaseModel
+	}
+)
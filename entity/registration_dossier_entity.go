@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	RegistrationDossier struct {
+		ID               uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		RegistrationID   string     `json:"registration_id" gorm:"type:varchar(255);not null;uniqueIndex"`
+		FileStorageID    string     `json:"file_storage_id" gorm:"type:varchar(255);not null"`
+		GeneratedByID    string     `json:"generated_by_id" gorm:"type:varchar(255)"`
+		GeneratedByEmail string     `json:"generated_by_email" gorm:"type:varchar(255)"`
+		GeneratedAt      *time.Time `json:"generated_at"`
+		BaseModel
+	}
+)
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type (
+	// RegistrationSnapshot is the local copy of a registration, kept in sync from registration events
+	RegistrationSnapshot struct {
+		RegistrationID       string     `json:"registration_id" gorm:"type:varchar(255);primaryKey"`
+		UserID               string     `json:"user_id" gorm:"type:varchar(255)"`
+		UserNRP              string     `json:"user_nrp" gorm:"type:varchar(255);index"`
+		UserName             string     `json:"user_name" gorm:"type:varchar(255)"`
+		AcademicAdvisorID    string     `json:"academic_advisor_id" gorm:"type:varchar(255)"`
+		AcademicAdvisorEmail string     `json:"academic_advisor_email" gorm:"type:varchar(255);index"`
+		ActivityName         string     `json:"activity_name" gorm:"type:varchar(255)"`
+		ApprovalStatus       bool       `json:"approval_status"`
+		SyncedAt             *time.Time `json:"synced_at" gorm:"not null"`
+		BaseModel
+	}
+
+	// ListedRegistration is what listings show of the registration of a row, joined in from its snapshot
+	// by the repositories. RegistrationSynced is false while the registration has no snapshot.
+	ListedRegistration struct {
+		RegistrationSynced       bool   `json:"-" gorm:"->;-:migration"`
+		RegistrationActivityName string `json:"-" gorm:"->;-:migration"`
+		RegistrationApproved     bool   `json:"-" gorm:"->;-:migration"`
+	}
+)
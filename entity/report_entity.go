@@ -1,17 +1,26 @@
 package entity
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type (
 	Report struct {
-		ID                    uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
-		ReportScheduleID      string    `json:"report_schedule_id"`
-		Title                 string    `json:"title"`
-		Content               string    `json:"content"`
-		ReportType            string    `json:"report_type"`
-		FileStorageID         string    `json:"file_storage_id"`
-		Feedback              string    `json:"feedback"`
-		AcademicAdvisorStatus string    `json:"academic_advisor_status"`
+		ID                    uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		ReportScheduleID      string     `json:"report_schedule_id" gorm:"index"`
+		Title                 string     `json:"title"`
+		Content               string     `json:"content"`
+		ReportType            string     `json:"report_type"`
+		FileStorageID         string     `json:"file_storage_id"`
+		Feedback              string     `json:"feedback"`
+		AcademicAdvisorStatus string     `json:"academic_advisor_status"`
+		SubmittedAt           *time.Time `json:"submitted_at"`
+		IsLate                bool       `json:"is_late" gorm:"default:false"`
+		LateSeconds           int64      `json:"late_seconds" gorm:"default:0"`
+		LateFlagged           bool       `json:"late_flagged" gorm:"default:false"`
+		ReviewedAt            *time.Time `json:"reviewed_at"`
 		BaseModel
 	}
 )
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	ReportReminder struct {
+		ID               uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		ReportScheduleID string     `json:"report_schedule_id" gorm:"type:varchar(255);not null;uniqueIndex:idx_report_reminder_kind"`
+		Kind             string     `json:"kind" gorm:"type:varchar(100);not null;uniqueIndex:idx_report_reminder_kind"`
+		Type             string     `json:"type" gorm:"type:varchar(50);not null"`
+		ReceiverEmail    string     `json:"receiver_email" gorm:"type:varchar(255)"`
+		SentAt           *time.Time `json:"sent_at"`
+		BaseModel
+	}
+)
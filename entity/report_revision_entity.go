@@ -0,0 +1,21 @@
+package entity
+
+import "github.com/google/uuid"
+
+type (
+	ReportRevision struct {
+		ID                    uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
+		ReportID              string    `json:"report_id" gorm:"type:varchar(255);not null;index;uniqueIndex:idx_report_revision_number"`
+		RevisionNumber        int       `json:"revision_number" gorm:"not null;uniqueIndex:idx_report_revision_number"`
+		Title                 string    `json:"title"`
+		Content               string    `json:"content"`
+		ReportType            string    `json:"report_type"`
+		FileStorageID         string    `json:"file_storage_id"`
+		Feedback              string    `json:"feedback"`
+		AcademicAdvisorStatus string    `json:"academic_advisor_status"`
+		ActorID               string    `json:"actor_id" gorm:"type:varchar(255)"`
+		ActorEmail            string    `json:"actor_email" gorm:"type:varchar(255)"`
+		ActorRole             string    `json:"actor_role" gorm:"type:varchar(255)"`
+		BaseModel
+	}
+)
@@ -8,18 +8,19 @@ import (
 
 type (
 	ReportSchedule struct {
-		ID             uuid.UUID `json:"id" gorm:"type:uuid;primaryKey"`
-		UserID         string    `json:"user_id"`
-		UserNRP        string    `json:"user_nrp"`
-		RegistrationID string    `json:"registration_id"`
-		// ActivityName         string     `json:"activity_name"`
+		ID                   uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey"`
+		UserID               string     `json:"user_id"`
+		UserNRP              string     `json:"user_nrp"`
+		RegistrationID       string     `json:"registration_id" gorm:"index"`
+		ActivityName         string     `json:"activity_name" gorm:"index"`
 		AcademicAdvisorID    string     `json:"academic_advisor_id"`
-		AcademicAdvisorEmail string     `json:"academic_advisor_email"`
+		AcademicAdvisorEmail string     `json:"academic_advisor_email" gorm:"index"`
 		ReportType           string     `json:"report_type"`
 		Week                 int        `json:"week"`
 		StartDate            *time.Time `json:"start_date"`
-		EndDate              *time.Time `json:"end_date"`
+		EndDate              *time.Time `json:"end_date" gorm:"index"`
 		Report               []Report   `json:"report" gorm:"foreignKey:ReportScheduleID"`
 		BaseModel
+		ListedRegistration
 	}
 )
@@ -12,4 +12,5 @@ type Syllabus struct {
 	Title                string    `json:"title" gorm:"type:varchar(255);not null"`
 	FileStorageID        string    `json:"file_storage_id" gorm:"type:varchar(255);not null"`
 	BaseModel
+	ListedRegistration
 }
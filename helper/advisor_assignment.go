@@ -0,0 +1,36 @@
+package helper
+
+import (
+	"errors"
+	"time"
+)
+
+// Roles an advisor can have on a registration. The primary advisor is the one the registration
+// names, secondary advisors are co-advisors assigned on top of it.
+const (
+	ADVISOR_ROLE_PRIMARY   = "PRIMARY"
+	ADVISOR_ROLE_SECONDARY = "SECONDARY"
+)
+
+var (
+	// ErrInvalidAdvisorAssignment is returned for an assignment missing its registration or advisor, or with an unknown role
+	ErrInvalidAdvisorAssignment = errors.New("invalid advisor assignment")
+	// ErrAdvisorAssignmentExists is returned when the advisor is already assigned to the registration
+	ErrAdvisorAssignmentExists = errors.New("advisor already assigned to registration")
+	// ErrAdvisorAssignmentNotFound is returned for an unknown assignment
+	ErrAdvisorAssignmentNotFound = errors.New("advisor assignment not found")
+	// ErrInvalidAdvisorDelegation is returned for a delegation without a delegate or a valid period
+	ErrInvalidAdvisorDelegation = errors.New("invalid advisor delegation")
+	// ErrAdvisorDelegationNotFound is returned for an unknown or revoked delegation
+	ErrAdvisorDelegationNotFound = errors.New("advisor delegation not found")
+)
+
+// ValidateAdvisorRole reports whether role is an advisor role
+func ValidateAdvisorRole(role string) bool {
+	return role == ADVISOR_ROLE_PRIMARY || role == ADVISOR_ROLE_SECONDARY
+}
+
+// DelegationActive reports whether a delegation running from startsAt until endsAt covers at
+func DelegationActive(startsAt time.Time, endsAt time.Time, at time.Time) bool {
+	return !at.Before(startsAt) && at.Before(endsAt)
+}
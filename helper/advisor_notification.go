@@ -0,0 +1,29 @@
+package helper
+
+import "time"
+
+const (
+	ADVISOR_NOTIFICATION_REPORT_SUBMITTED    = "REPORT SUBMITTED"
+	ADVISOR_NOTIFICATION_REPORT_RESUBMITTED  = "REPORT RESUBMITTED"
+	ADVISOR_NOTIFICATION_SYLLABUS_UPLOADED   = "SYLLABUS UPLOADED"
+	ADVISOR_NOTIFICATION_TRANSCRIPT_UPLOADED = "TRANSCRIPT UPLOADED"
+	ADVISOR_NOTIFICATION_SUBMISSION_DIGEST   = "SUBMISSION DIGEST"
+)
+
+// AdvisorNotificationConfig controls how advisors hear about new submissions. Advisors without a
+// preference of their own get DefaultDailyDigest; digests are sent every day at DigestHour.
+type AdvisorNotificationConfig struct {
+	DigestEnabled      bool
+	DigestHour         int
+	DefaultDailyDigest bool
+}
+
+// NextDigestRun returns the first time at hour o'clock after now, in the location of now
+func NextDigestRun(now time.Time, hour int) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
@@ -0,0 +1,287 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// ErrMalformedMessage marks a message that can never be processed, so it is rejected instead of redelivered
+var ErrMalformedMessage = errors.New("malformed message")
+
+// AMQPConsumerConfig describes a durable queue bound to a topic exchange and how it is consumed.
+// With a DeadLetterExchange, rejected messages are routed to DeadLetterQueue, and with a DeliveryLimit
+// the queue is a quorum queue that also dead-letters messages after that many failed deliveries.
+type AMQPConsumerConfig struct {
+	URL                string
+	Exchange           string
+	Queue              string
+	RoutingKey         string
+	Prefetch           int
+	RetryDelay         time.Duration
+	ReconnectDelay     time.Duration
+	DeadLetterExchange string
+	DeadLetterQueue    string
+	DeliveryLimit      int
+}
+
+// RegistrationSyncConfig controls the consumer that keeps registration snapshots in sync with registration events.
+// With a BackfillToken the registrations no event has synced yet are fetched once when the consumer starts.
+type RegistrationSyncConfig struct {
+	Enabled           bool
+	Consumer          AMQPConsumerConfig
+	BackfillToken     string
+	BackfillBatchSize int
+}
+
+// ScheduleProvisioningConfig controls the consumer that creates report schedules for approved registrations
+type ScheduleProvisioningConfig struct {
+	Enabled            bool
+	Cadence            string
+	IncludeFinalReport bool
+	MaxWeeks           int
+	Consumer           AMQPConsumerConfig
+}
+
+// OutboxConfig controls the background publisher of the domain event outbox
+type OutboxConfig struct {
+	Enabled   bool
+	URL       string
+	Exchange  string
+	Interval  time.Duration
+	BatchSize int
+}
+
+// AMQPURL builds the broker URL, escaping the credentials
+func AMQPURL(user string, password string, host string, port string) string {
+	return (&url.URL{
+		Scheme: "amqp",
+		User:   url.UserPassword(user, password),
+		Host:   net.JoinHostPort(host, port),
+		Path:   "/",
+	}).String()
+}
+
+// Consume hands every message of the configured queue to handler until ctx is cancelled,
+// reconnecting after ReconnectDelay whenever the broker cannot be reached or drops the connection
+func Consume(ctx context.Context, config AMQPConsumerConfig, handler func(ctx context.Context, body []byte) error) {
+	for {
+		err := consumeOnce(ctx, config, handler)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("ERROR CONSUMING %s: %v", config.Queue, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(config.ReconnectDelay):
+		}
+	}
+}
+
+func consumeOnce(ctx context.Context, config AMQPConsumerConfig, handler func(ctx context.Context, body []byte) error) error {
+	conn, err := amqp.Dial(config.URL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return err
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(config.Exchange, amqp.ExchangeTopic, true, false, false, false, nil); err != nil {
+		return err
+	}
+	args, err := declareDeadLetterQueue(ch, config)
+	if err != nil {
+		return err
+	}
+	if _, err := ch.QueueDeclare(config.Queue, true, false, false, false, args); err != nil {
+		return err
+	}
+	if err := ch.QueueBind(config.Queue, config.RoutingKey, config.Exchange, false, nil); err != nil {
+		return err
+	}
+	if err := ch.Qos(config.Prefetch, 0, false); err != nil {
+		return err
+	}
+
+	deliveries, err := ch.Consume(config.Queue, "", false, false, false, false, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("consuming %s", config.Queue)
+
+	closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case amqpErr := <-closed:
+			return fmt.Errorf("connection closed: %v", amqpErr)
+		case delivery, ok := <-deliveries:
+			if !ok {
+				return errors.New("delivery channel closed")
+			}
+			if err := HandleDelivery(ctx, delivery, config.RetryDelay, handler); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// declareDeadLetterQueue declares where the queue dead-letters messages and returns the queue arguments to use
+func declareDeadLetterQueue(ch *amqp.Channel, config AMQPConsumerConfig) (amqp.Table, error) {
+	if config.DeadLetterExchange == "" {
+		return nil, nil
+	}
+
+	if err := ch.ExchangeDeclare(config.DeadLetterExchange, amqp.ExchangeFanout, true, false, false, false, nil); err != nil {
+		return nil, err
+	}
+	if _, err := ch.QueueDeclare(config.DeadLetterQueue, true, false, false, false, nil); err != nil {
+		return nil, err
+	}
+	if err := ch.QueueBind(config.DeadLetterQueue, "", config.DeadLetterExchange, false, nil); err != nil {
+		return nil, err
+	}
+
+	args := amqp.Table{"x-dead-letter-exchange": config.DeadLetterExchange}
+	if config.DeliveryLimit > 0 {
+		args["x-queue-type"] = amqp.QueueTypeQuorum
+		args["x-delivery-limit"] = config.DeliveryLimit
+	}
+
+	return args, nil
+}
+
+// HandleDelivery acks a delivery once handler succeeds and rejects a malformed message without
+// requeueing it, which dead-letters it when the queue has a dead-letter exchange. Any other failure
+// is requeued after retryDelay so a dependency that is down is not hammered with redeliveries.
+// The returned error is only about acknowledging the delivery.
+func HandleDelivery(ctx context.Context, delivery amqp.Delivery, retryDelay time.Duration, handler func(ctx context.Context, body []byte) error) error {
+	err := handler(ctx, delivery.Body)
+	if err == nil {
+		return delivery.Ack(false)
+	}
+
+	if errors.Is(err, ErrMalformedMessage) {
+		log.Printf("ERROR REJECTING MESSAGE %s: %v", delivery.MessageId, err)
+		return delivery.Reject(false)
+	}
+
+	log.Printf("ERROR HANDLING MESSAGE %s, requeueing: %v", delivery.MessageId, err)
+	select {
+	case <-ctx.Done():
+	case <-time.After(retryDelay):
+	}
+
+	return delivery.Nack(false, true)
+}
+
+// AMQPPublisher publishes persistent messages to a durable topic exchange and waits for the broker
+// to confirm each one. The connection is opened on first use and reopened after it drops.
+type AMQPPublisher struct {
+	url      string
+	exchange string
+
+	mu   sync.Mutex
+	conn *amqp.Connection
+	ch   *amqp.Channel
+}
+
+func NewAMQPPublisher(url string, exchange string) *AMQPPublisher {
+	return &AMQPPublisher{
+		url:      url,
+		exchange: exchange,
+	}
+}
+
+// Publish sends a JSON message and returns once the broker has confirmed it
+func (p *AMQPPublisher) Publish(ctx context.Context, routingKey string, messageID string, body []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch, err := p.channel()
+	if err != nil {
+		return err
+	}
+
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, p.exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    messageID,
+		Type:         routingKey,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+	if err != nil {
+		p.close()
+		return err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !acked {
+		return fmt.Errorf("message %s was not confirmed by the broker", messageID)
+	}
+
+	return nil
+}
+
+// Close closes the connection, a later Publish opens a new one
+func (p *AMQPPublisher) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.close()
+}
+
+func (p *AMQPPublisher) channel() (*amqp.Channel, error) {
+	if p.ch != nil && !p.ch.IsClosed() && !p.conn.IsClosed() {
+		return p.ch, nil
+	}
+	p.close()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err == nil {
+		err = ch.Confirm(false)
+	}
+	if err == nil {
+		err = ch.ExchangeDeclare(p.exchange, amqp.ExchangeTopic, true, false, false, false, nil)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	p.conn = conn
+	p.ch = ch
+	return ch, nil
+}
+
+func (p *AMQPPublisher) close() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn = nil
+	p.ch = nil
+}
@@ -0,0 +1,53 @@
+package helper
+
+import "time"
+
+// ParseDateRange parses optional RFC3339 or YYYY-MM-DD bounds into [from, to).
+// A date-only end bound includes the whole day.
+func ParseDateRange(startDate string, endDate string) (*time.Time, *time.Time, error) {
+	from, err := parseDateBound(startDate, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	to, err := parseDateBound(endDate, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if from != nil && to != nil && !to.After(*from) {
+		return nil, nil, ErrInvalidDateRange
+	}
+
+	return from, to, nil
+}
+
+// ParseDate parses a required RFC3339 or YYYY-MM-DD date, the latter at the start of the day
+func ParseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, ErrInvalidDateRange
+	}
+
+	return parseDateBound(value, false)
+}
+
+func parseDateBound(value string, end bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if bound, err := time.Parse(time.RFC3339, value); err == nil {
+		return &bound, nil
+	}
+
+	bound, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, ErrInvalidDateRange
+	}
+
+	if end {
+		bound = bound.AddDate(0, 0, 1)
+	}
+
+	return &bound, nil
+}
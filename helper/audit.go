@@ -0,0 +1,18 @@
+package helper
+
+// Actions recorded in the audit trail
+const (
+	AUDIT_ACTION_CREATE = "CREATE"
+	AUDIT_ACTION_UPDATE = "UPDATE"
+	AUDIT_ACTION_DELETE = "DELETE"
+	AUDIT_ACTION_REVIEW = "REVIEW"
+)
+
+const (
+	// REQUEST_ID_HEADER carries the request ID set by the gateway, or the one generated for the request
+	REQUEST_ID_HEADER = "X-Request-ID"
+	// REQUEST_ID_CONTEXT_KEY is the gin context key holding the request ID
+	REQUEST_ID_CONTEXT_KEY = "requestID"
+	// CLIENT_IP_CONTEXT_KEY is the gin context key holding the IP address of the caller
+	CLIENT_IP_CONTEXT_KEY = "clientIP"
+)
@@ -0,0 +1,144 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// UserCacheConfig configures the cache in front of the user management service.
+// A TTL of zero disables caching but keeps deduplication of concurrent lookups.
+type UserCacheConfig struct {
+	TTL time.Duration
+}
+
+type ttlCacheEntry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// TTLCache is a concurrency safe map whose entries expire after a fixed TTL.
+// Concurrent Fetch calls for the same missing key share a single lookup.
+type TTLCache[V any] struct {
+	ttl       time.Duration
+	mu        sync.RWMutex
+	entries   map[string]ttlCacheEntry[V]
+	group     singleflight.Group
+	lastSweep time.Time
+}
+
+func NewTTLCache[V any](ttl time.Duration) *TTLCache[V] {
+	return &TTLCache[V]{
+		ttl:       ttl,
+		entries:   make(map[string]ttlCacheEntry[V]),
+		lastSweep: time.Now(),
+	}
+}
+
+// Get returns the cached value of key if it has not expired
+func (c *TTLCache[V]) Get(key string) (V, bool) {
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || time.Now().After(entry.expiresAt) {
+		var zero V
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores value under key for the cache TTL, dropping expired entries now and then
+func (c *TTLCache[V]) Set(key string, value V) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastSweep) > c.ttl {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+
+	c.entries[key] = ttlCacheEntry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+// Fetch returns the cached value of key or loads it with fetch. Failed lookups are not
+// cached, so they are retried on the next call.
+func (c *TTLCache[V]) Fetch(key string, fetch func() (V, error)) (V, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+
+	value, err, _ := c.group.Do(key, func() (interface{}, error) {
+		value, err := fetch()
+		if err == nil {
+			c.Set(key, value)
+		}
+		return value, err
+	})
+
+	return value.(V), err
+}
+
+// Delete removes key from the cache
+func (c *TTLCache[V]) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// DeletePrefix removes every key starting with prefix
+func (c *TTLCache[V]) DeletePrefix(prefix string) {
+	c.DeleteFunc(func(key string, _ V) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+// DeleteFunc removes every entry for which match returns true
+func (c *TTLCache[V]) DeleteFunc(match func(key string, value V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if match(key, entry.value) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Purge removes every entry
+func (c *TTLCache[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]ttlCacheEntry[V])
+}
+
+// Len returns the number of entries, including expired ones not swept yet
+func (c *TTLCache[V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.entries)
+}
+
+// HashToken returns a hex SHA-256 of a bearer token so raw tokens are never kept as cache keys
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,111 @@
+package helper
+
+import (
+	"monitoring-service/dto"
+	"strings"
+)
+
+const (
+	DIFF_EQUAL  = "EQUAL"
+	DIFF_INSERT = "INSERT"
+	DIFF_DELETE = "DELETE"
+)
+
+// diffMaxCells bounds the LCS table of DiffLines, about 8 MB. Texts whose changed parts would need a
+// larger table are diffed as all of the old lines deleted and all of the new lines inserted.
+const diffMaxCells = 1 << 20
+
+// DiffLines returns a line based diff of two texts using the longest common subsequence of the lines
+// between their common prefix and suffix
+func DiffLines(from string, to string) []dto.DiffLine {
+	fromLines := splitLines(from)
+	toLines := splitLines(to)
+
+	prefix := 0
+	for prefix < len(fromLines) && prefix < len(toLines) && fromLines[prefix] == toLines[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(fromLines)-prefix && suffix < len(toLines)-prefix &&
+		fromLines[len(fromLines)-1-suffix] == toLines[len(toLines)-1-suffix] {
+		suffix++
+	}
+
+	var lines []dto.DiffLine
+	for _, line := range fromLines[:prefix] {
+		lines = append(lines, dto.DiffLine{Operation: DIFF_EQUAL, Text: line})
+	}
+
+	lines = append(lines, diffChangedLines(fromLines[prefix:len(fromLines)-suffix], toLines[prefix:len(toLines)-suffix])...)
+
+	for _, line := range fromLines[len(fromLines)-suffix:] {
+		lines = append(lines, dto.DiffLine{Operation: DIFF_EQUAL, Text: line})
+	}
+
+	return lines
+}
+
+// diffChangedLines diffs the lines between the common prefix and suffix of two texts
+func diffChangedLines(fromLines []string, toLines []string) []dto.DiffLine {
+	var lines []dto.DiffLine
+
+	if (len(fromLines)+1)*(len(toLines)+1) > diffMaxCells {
+		for _, line := range fromLines {
+			lines = append(lines, dto.DiffLine{Operation: DIFF_DELETE, Text: line})
+		}
+		for _, line := range toLines {
+			lines = append(lines, dto.DiffLine{Operation: DIFF_INSERT, Text: line})
+		}
+		return lines
+	}
+
+	// lcs[i][j] holds the LCS length of fromLines[i:] and toLines[j:]
+	lcs := make([][]int, len(fromLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(toLines)+1)
+	}
+	for i := len(fromLines) - 1; i >= 0; i-- {
+		for j := len(toLines) - 1; j >= 0; j-- {
+			if fromLines[i] == toLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(fromLines) && j < len(toLines) {
+		if fromLines[i] == toLines[j] {
+			lines = append(lines, dto.DiffLine{Operation: DIFF_EQUAL, Text: fromLines[i]})
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			lines = append(lines, dto.DiffLine{Operation: DIFF_DELETE, Text: fromLines[i]})
+			i++
+		} else {
+			lines = append(lines, dto.DiffLine{Operation: DIFF_INSERT, Text: toLines[j]})
+			j++
+		}
+	}
+	for ; i < len(fromLines); i++ {
+		lines = append(lines, dto.DiffLine{Operation: DIFF_DELETE, Text: fromLines[i]})
+	}
+	for ; j < len(toLines); j++ {
+		lines = append(lines, dto.DiffLine{Operation: DIFF_INSERT, Text: toLines[j]})
+	}
+
+	return lines
+}
+
+// splitLines treats an empty text as having no lines rather than one empty line
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(text, "\n")
+}
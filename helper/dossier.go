@@ -0,0 +1,204 @@
+package helper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"time"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// DossierDocument is everything printed in the monitoring dossier of one registration
+type DossierDocument struct {
+	RegistrationID string
+	StudentName    string
+	StudentNRP     string
+	ActivityName   string
+	AdvisorEmail   string
+	GeneratedAt    time.Time
+	Reports        []DossierReport
+	Syllabus       *DossierAttachment
+	Transcript     *DossierAttachment
+}
+
+// DossierReport is one report schedule with its latest submitted report, if any
+type DossierReport struct {
+	ReportType  string
+	Week        int
+	StartDate   *time.Time
+	EndDate     *time.Time
+	Submitted   bool
+	Title       string
+	Content     string
+	Status      string
+	Feedback    string
+	SubmittedAt *time.Time
+	ReviewedAt  *time.Time
+	IsLate      bool
+}
+
+// DossierAttachment references an uploaded syllabus or transcript
+type DossierAttachment struct {
+	Title         string
+	FileStorageID string
+	UploadedAt    *time.Time
+}
+
+const dossierDateLayout = "02 Jan 2006 15:04"
+
+// RenderDossierPDF writes the dossier as an A4 PDF into w
+func RenderDossierPDF(document DossierDocument, w io.Writer) error {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont("Helvetica", "I", 8)
+		pdf.CellFormat(0, 6, fmt.Sprintf("Page %d/{nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	// core fonts are cp1252, translate names and report text coming in as UTF-8
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	pdf.AddPage()
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, "MBKM Monitoring Dossier", "", 1, "C", false, 0, "")
+	pdf.Ln(2)
+
+	info := [][2]string{
+		{"Student", fmt.Sprintf("%s (%s)", document.StudentName, document.StudentNRP)},
+		{"Activity", document.ActivityName},
+		{"Academic Advisor", document.AdvisorEmail},
+		{"Registration ID", document.RegistrationID},
+		{"Generated At", document.GeneratedAt.Format(dossierDateLayout)},
+	}
+	for _, line := range info {
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 6, line[0], "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.CellFormat(0, 6, tr(line[1]), "", 1, "L", false, 0, "")
+	}
+
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, "Grade Conversion Documents", "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, attachment := range []struct {
+		label      string
+		attachment *DossierAttachment
+	}{
+		{"Syllabus", document.Syllabus},
+		{"Transcript", document.Transcript},
+	} {
+		text := "not uploaded"
+		if attachment.attachment != nil {
+			text = fmt.Sprintf("%s (file %s, uploaded %s)", attachment.attachment.Title, attachment.attachment.FileStorageID, formatDossierTime(attachment.attachment.UploadedAt))
+		}
+		pdf.SetFont("Helvetica", "B", 10)
+		pdf.CellFormat(40, 6, attachment.label, "", 0, "L", false, 0, "")
+		pdf.SetFont("Helvetica", "", 10)
+		pdf.MultiCell(0, 6, tr(text), "", "L", false)
+	}
+
+	for _, report := range document.Reports {
+		pdf.Ln(4)
+		pdf.SetFont("Helvetica", "B", 12)
+		heading := fmt.Sprintf("Week %d", report.Week)
+		if report.ReportType == "FINAL_REPORT" {
+			heading = "Final Report"
+		}
+		pdf.CellFormat(0, 8, fmt.Sprintf("%s (%s - %s)", heading, formatDossierDate(report.StartDate), formatDossierDate(report.EndDate)), "B", 1, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", 10)
+		if !report.Submitted {
+			pdf.CellFormat(0, 6, "No report submitted.", "", 1, "L", false, 0, "")
+			continue
+		}
+
+		late := ""
+		if report.IsLate {
+			late = " (late)"
+		}
+		details := [][2]string{
+			{"Title", report.Title},
+			{"Status", report.Status},
+			{"Submitted At", formatDossierTime(report.SubmittedAt) + late},
+			{"Reviewed At", formatDossierTime(report.ReviewedAt)},
+		}
+		for _, line := range details {
+			pdf.SetFont("Helvetica", "B", 10)
+			pdf.CellFormat(40, 6, line[0], "", 0, "L", false, 0, "")
+			pdf.SetFont("Helvetica", "", 10)
+			pdf.MultiCell(0, 6, tr(line[1]), "", "L", false)
+		}
+
+		pdf.Ln(1)
+		pdf.MultiCell(0, 5, tr(report.Content), "", "L", false)
+
+		if report.Feedback != "" {
+			pdf.Ln(1)
+			pdf.SetFont("Helvetica", "I", 10)
+			pdf.MultiCell(0, 5, tr("Advisor feedback: "+report.Feedback), "", "L", false)
+		}
+	}
+
+	return pdf.Output(w)
+}
+
+// NewMultipartFileHeader wraps generated content in a multipart file header so it can be
+// uploaded through the same storage client as user uploads
+func NewMultipartFileHeader(filename string, contentType string, content []byte) (*multipart.FileHeader, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, filename))
+	header.Set("Content-Type", contentType)
+
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = part.Write(content)
+	if err != nil {
+		return nil, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(int64(len(content)) + 1024)
+	if err != nil {
+		return nil, err
+	}
+
+	files := form.File["file"]
+	if len(files) == 0 {
+		return nil, fmt.Errorf("file %s missing from multipart form", filename)
+	}
+
+	return files[0], nil
+}
+
+func formatDossierTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format(dossierDateLayout)
+}
+
+func formatDossierDate(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Format("02 Jan 2006")
+}
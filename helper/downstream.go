@@ -0,0 +1,95 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// DownstreamConfig tunes how outbound calls to other services are bounded, retried and circuit broken
+type DownstreamConfig struct {
+	Timeout            time.Duration
+	UploadTimeout      time.Duration
+	MaxRetries         int
+	RetryBaseDelay     time.Duration
+	RetryMaxDelay      time.Duration
+	BreakerFailures    uint32
+	BreakerOpenTimeout time.Duration
+}
+
+// Backoff returns the delay before the given retry attempt (starting at 1) using exponential
+// backoff with full jitter, capped at maxDelay
+func Backoff(attempt int, baseDelay time.Duration, maxDelay time.Duration) time.Duration {
+	if attempt < 1 || baseDelay <= 0 {
+		return 0
+	}
+
+	delay := baseDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(delay) + 1))
+}
+
+// Retry calls fn until it succeeds, returns an error retryable does not accept, or MaxRetries
+// retries have been made. Each attempt gets its own Timeout, and waiting stops when ctx is done.
+func Retry(ctx context.Context, config DownstreamConfig, retryable func(error) bool, fn func(ctx context.Context) error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(Backoff(attempt, config.RetryBaseDelay, config.RetryMaxDelay))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+
+		err = callWithTimeout(ctx, config.Timeout, fn)
+		if err == nil || attempt >= config.MaxRetries || ctx.Err() != nil || !retryable(err) {
+			return err
+		}
+	}
+}
+
+func callWithTimeout(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return fn(ctx)
+}
+
+// Warnings collects the distinct warnings of a response that was served without some downstream data
+type Warnings struct {
+	messages []string
+	seen     map[string]bool
+}
+
+// Add records a warning once, however many items it applies to
+func (w *Warnings) Add(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if w.seen == nil {
+		w.seen = make(map[string]bool)
+	}
+	if w.seen[message] {
+		return
+	}
+
+	w.seen[message] = true
+	w.messages = append(w.messages, message)
+}
+
+// List returns the warnings in the order they were added, or nil when there are none
+func (w *Warnings) List() []string {
+	return w.messages
+}
@@ -0,0 +1,115 @@
+package helper
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	EXPORT_FORMAT_CSV  = "csv"
+	EXPORT_FORMAT_XLSX = "xlsx"
+)
+
+// ErrInvalidExportFormat is returned for an export format other than csv or xlsx
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+// TableWriter writes an export one row at a time. Close must be called to flush the output.
+type TableWriter interface {
+	WriteRow(row []string) error
+	Close() error
+}
+
+// ExportContentType returns the MIME type of an export format
+func ExportContentType(format string) string {
+	if format == EXPORT_FORMAT_XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv; charset=utf-8"
+}
+
+// NewTableWriter returns a TableWriter for the given format writing into w
+func NewTableWriter(format string, w io.Writer, sheet string) (TableWriter, error) {
+	switch format {
+	case "", EXPORT_FORMAT_CSV:
+		return &csvTableWriter{writer: csv.NewWriter(w)}, nil
+	case EXPORT_FORMAT_XLSX:
+		return newXLSXTableWriter(w, sheet)
+	default:
+		return nil, ErrInvalidExportFormat
+	}
+}
+
+type csvTableWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvTableWriter) WriteRow(row []string) error {
+	return w.writer.Write(row)
+}
+
+func (w *csvTableWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// xlsxTableWriter uses the excelize stream writer, which spills rows to a temporary file
+// instead of keeping the whole sheet in memory
+type xlsxTableWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func newXLSXTableWriter(w io.Writer, sheet string) (TableWriter, error) {
+	file := excelize.NewFile()
+
+	defaultSheet := file.GetSheetName(0)
+	if sheet != "" && sheet != defaultSheet {
+		err := file.SetSheetName(defaultSheet, sheet)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		defaultSheet = sheet
+	}
+
+	stream, err := file.NewStreamWriter(defaultSheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxTableWriter{out: w, file: file, stream: stream}, nil
+}
+
+func (w *xlsxTableWriter) WriteRow(row []string) error {
+	w.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(row))
+	for i, value := range row {
+		values[i] = value
+	}
+
+	return w.stream.SetRow(cell, values)
+}
+
+func (w *xlsxTableWriter) Close() error {
+	defer w.file.Close()
+
+	err := w.stream.Flush()
+	if err != nil {
+		return err
+	}
+
+	return w.file.Write(w.out)
+}
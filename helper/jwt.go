@@ -0,0 +1,359 @@
+package helper
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidToken means the token is a JWT that must be rejected: bad signature, expired, wrong issuer...
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrUnknownSigningKey means no configured key matches the key ID of the token
+	ErrUnknownSigningKey = errors.New("unknown token signing key")
+	// ErrTokenClaimsMissing means a validly signed token lacks the claims a principal needs
+	ErrTokenClaimsMissing = errors.New("token claims missing")
+	// ErrNotJWT means the bearer token is opaque, it can only be resolved by user management
+	ErrNotJWT = errors.New("token is not a JWT")
+	// ErrRemoteLookupRequired means the token cannot be resolved locally and the remote lookup is allowed
+	ErrRemoteLookupRequired = errors.New("token requires a remote lookup")
+)
+
+// JWTClaimNames names the claims the principal is read from
+type JWTClaimNames struct {
+	UserID string
+	Email  string
+	NRP    string
+	Role   string
+	Name   string
+}
+
+// JWTConfig controls local verification of bearer tokens. Tokens are verified against PublicKey
+// (PEM) or the key in PublicKeyFile when set, else against the JWKS document at JWKSURL. Without
+// any of them every token is looked up in user management. RemoteFallback lets tokens that are
+// opaque or lack claims through to that lookup instead of rejecting them.
+type JWTConfig struct {
+	PublicKey              string
+	PublicKeyFile          string
+	JWKSURL                string
+	JWKSRefreshInterval    time.Duration
+	JWKSMinRefreshInterval time.Duration
+	Issuer                 string
+	Audience               string
+	Leeway                 time.Duration
+	RemoteFallback         bool
+	Claims                 JWTClaimNames
+}
+
+// JWTHeader is the part of the JOSE header verification needs
+type JWTHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// JWTClaims are the decoded claims of a verified token
+type JWTClaims map[string]interface{}
+
+// String returns a string claim, numbers are formatted without exponent
+func (c JWTClaims) String(name string) string {
+	switch value := c[name].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	case float64:
+		return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%f", value), "0"), ".")
+	}
+
+	return ""
+}
+
+// ParseJWTHeader decodes the header of a compact JWT without verifying anything
+func ParseJWTHeader(token string) (JWTHeader, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return JWTHeader{}, ErrNotJWT
+	}
+
+	var header JWTHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return JWTHeader{}, ErrNotJWT
+	}
+
+	return header, nil
+}
+
+// VerifyJWT checks the signature of token with key and validates its time, issuer and audience claims
+func VerifyJWT(token string, key crypto.PublicKey, config JWTConfig, now time.Time) (JWTClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrNotJWT
+	}
+
+	header, err := ParseJWTHeader(token)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+
+	if err := verifyJWTSignature(header.Algorithm, key, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims JWTClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ErrInvalidToken)
+	}
+
+	if err := validateJWTClaims(claims, config, now); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+func decodeJWTSegment(segment string, out interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	return decoder.Decode(out)
+}
+
+func verifyJWTSignature(algorithm string, key crypto.PublicKey, signingInput string, signature []byte) error {
+	var hash crypto.Hash
+	switch algorithm {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		// "none" and the HMAC algorithms are refused, a public key must never be usable as a shared secret
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidToken, algorithm)
+	}
+
+	digest := jwtDigest(hash, signingInput)
+
+	switch publicKey := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "RS") {
+			return fmt.Errorf("%w: %s does not match an RSA key", ErrInvalidToken, algorithm)
+		}
+		if err := rsa.VerifyPKCS1v15(publicKey, hash, digest, signature); err != nil {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "ES") {
+			return fmt.Errorf("%w: %s does not match an EC key", ErrInvalidToken, algorithm)
+		}
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(publicKey, digest, r, s) {
+			return fmt.Errorf("%w: signature mismatch", ErrInvalidToken)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%w: unsupported key type %T", ErrInvalidToken, key)
+}
+
+func jwtDigest(hash crypto.Hash, signingInput string) []byte {
+	switch hash {
+	case crypto.SHA384:
+		sum := sha512.Sum384([]byte(signingInput))
+		return sum[:]
+	case crypto.SHA512:
+		sum := sha512.Sum512([]byte(signingInput))
+		return sum[:]
+	}
+
+	sum := sha256.Sum256([]byte(signingInput))
+	return sum[:]
+}
+
+func validateJWTClaims(claims JWTClaims, config JWTConfig, now time.Time) error {
+	// a token without an expiry would be accepted forever, so exp is required
+	expiresAt, ok := numericDate(claims["exp"])
+	if !ok {
+		return fmt.Errorf("%w: token has no expiry", ErrInvalidToken)
+	}
+	if now.After(expiresAt.Add(config.Leeway)) {
+		return fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+
+	if notBefore, ok := numericDate(claims["nbf"]); ok && now.Add(config.Leeway).Before(notBefore) {
+		return fmt.Errorf("%w: token not valid yet", ErrInvalidToken)
+	}
+
+	if config.Issuer != "" && claims.String("iss") != config.Issuer {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidToken)
+	}
+
+	if config.Audience != "" && !hasAudience(claims["aud"], config.Audience) {
+		return fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+
+	return nil
+}
+
+func numericDate(value interface{}) (time.Time, bool) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	seconds, err := number.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(0, int64(seconds*float64(time.Second))), true
+}
+
+func hasAudience(value interface{}, audience string) bool {
+	switch aud := value.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, item := range aud {
+			if item == audience {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ParsePublicKeyPEM reads an RSA or EC public key from a PKIX or PKCS#1 PEM block
+func ParsePublicKeyPEM(data string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported public key type %T", key)
+}
+
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	Curve   string `json:"crv"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+// ParseJWKS reads the signing keys of a JWKS document by key ID. Keys of an unsupported type or
+// meant for encryption are skipped rather than failing the whole document.
+func ParseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.KeyID] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no usable signing keys in JWKS")
+	}
+
+	return keys, nil
+}
+
+func (jwk jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", jwk.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unsupported key type %q", jwk.KeyType)
+}
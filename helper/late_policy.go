@@ -0,0 +1,94 @@
+package helper
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	LATE_POLICY_ACCEPT           = "ACCEPT"
+	LATE_POLICY_ACCEPT_WITH_FLAG = "ACCEPT_WITH_FLAG"
+	LATE_POLICY_REJECT           = "REJECT"
+)
+
+const (
+	SUBMISSION_STATUS_ON_TIME = "ON_TIME"
+	SUBMISSION_STATUS_LATE    = "LATE"
+	SUBMISSION_STATUS_MISSING = "MISSING"
+	SUBMISSION_STATUS_OPEN    = "OPEN"
+)
+
+// ErrReportDeadlinePassed is returned when a report type rejects submissions after its grace period
+var ErrReportDeadlinePassed = errors.New("report submission deadline has passed")
+
+// LatePolicy decides what happens to a report submitted after its schedule end date
+type LatePolicy struct {
+	Mode        string
+	GracePeriod time.Duration
+}
+
+// LatePolicies holds the late policy per report type
+type LatePolicies map[string]LatePolicy
+
+// DefaultLatePolicy accepts late reports and flags them
+var DefaultLatePolicy = LatePolicy{Mode: LATE_POLICY_ACCEPT_WITH_FLAG}
+
+// ValidateLatePolicyMode checks if the given mode is a known late policy
+func ValidateLatePolicyMode(mode string) bool {
+	return mode == LATE_POLICY_ACCEPT || mode == LATE_POLICY_ACCEPT_WITH_FLAG || mode == LATE_POLICY_REJECT
+}
+
+// ForReportType returns the policy of a report type, falling back to DefaultLatePolicy
+func (p LatePolicies) ForReportType(reportType string) LatePolicy {
+	if policy, ok := p[reportType]; ok {
+		return policy
+	}
+
+	return DefaultLatePolicy
+}
+
+// Submission is the outcome of checking a submission time against a schedule deadline. IsLate and
+// LateBy are facts about the submission, Flagged is whether its policy wants the lateness reviewed.
+type Submission struct {
+	IsLate  bool
+	LateBy  time.Duration
+	Flagged bool
+}
+
+// EvaluateSubmission compares a submission time against a deadline under the given policy.
+// Reports without a deadline are always on time.
+func EvaluateSubmission(submittedAt time.Time, deadline *time.Time, policy LatePolicy) (Submission, error) {
+	if deadline == nil || !submittedAt.After(*deadline) {
+		return Submission{}, nil
+	}
+
+	submission := Submission{IsLate: true, LateBy: submittedAt.Sub(*deadline).Truncate(time.Second)}
+
+	switch policy.Mode {
+	case LATE_POLICY_ACCEPT:
+		return submission, nil
+	case LATE_POLICY_REJECT:
+		if submission.LateBy > policy.GracePeriod {
+			return Submission{}, ErrReportDeadlinePassed
+		}
+	}
+
+	submission.Flagged = true
+	return submission, nil
+}
+
+// ResolveSubmissionStatus classifies a schedule from its deadline and the latest submitted report, if any
+func ResolveSubmissionStatus(deadline *time.Time, submitted bool, isLate bool, now time.Time) string {
+	if submitted {
+		if isLate {
+			return SUBMISSION_STATUS_LATE
+		}
+		return SUBMISSION_STATUS_ON_TIME
+	}
+
+	if deadline != nil && now.After(*deadline) {
+		return SUBMISSION_STATUS_MISSING
+	}
+
+	return SUBMISSION_STATUS_OPEN
+}
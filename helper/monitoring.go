@@ -0,0 +1,27 @@
+package helper
+
+import "errors"
+
+// Bounds of the score a monitoring lecturer gives a visit
+const (
+	MONITORING_SCORE_MIN = 0
+	MONITORING_SCORE_MAX = 100
+)
+
+var (
+	// ErrInvalidMonitorAssignment is returned for an assignment missing its registration or monitoring lecturer
+	ErrInvalidMonitorAssignment = errors.New("invalid monitor assignment")
+	// ErrMonitorAssignmentExists is returned when the lecturer already monitors the registration
+	ErrMonitorAssignmentExists = errors.New("monitor already assigned to registration")
+	// ErrMonitorAssignmentNotFound is returned for an unknown assignment
+	ErrMonitorAssignmentNotFound = errors.New("monitor assignment not found")
+	// ErrInvalidReportEvaluation is returned for an evaluation without a note
+	ErrInvalidReportEvaluation = errors.New("invalid report evaluation")
+	// ErrInvalidMonitoringVisit is returned for a visit without a valid date or score
+	ErrInvalidMonitoringVisit = errors.New("invalid monitoring visit")
+)
+
+// ValidateMonitoringScore reports whether score is within the visit score bounds
+func ValidateMonitoringScore(score int) bool {
+	return score >= MONITORING_SCORE_MIN && score <= MONITORING_SCORE_MAX
+}
@@ -0,0 +1,50 @@
+package helper
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	NOTIFICATION_STATUS_PENDING = "PENDING"
+	NOTIFICATION_STATUS_SENT    = "SENT"
+	NOTIFICATION_STATUS_FAILED  = "FAILED"
+)
+
+var (
+	ErrNotificationNotFound  = errors.New("notification not found")
+	ErrNotificationNotFailed = errors.New("only failed notifications can be re-sent")
+)
+
+// NotificationConfig controls the background delivery of queued notifications
+type NotificationConfig struct {
+	Enabled        bool
+	Interval       time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// ClaimDuration is how long a claimed notification is hidden from other workers while it is sent,
+	// a worker that dies mid-batch leaves its notifications to be retried once the claim runs out
+	ClaimDuration time.Duration
+	ServiceToken  string
+}
+
+// Validate rejects a config the worker cannot deliver with, the worker has no user token to send as
+func (c NotificationConfig) Validate() error {
+	if c.Enabled && c.ServiceToken == "" {
+		return errors.New("notification delivery is enabled but NOTIFICATION_SERVICE_TOKEN is not set")
+	}
+
+	return nil
+}
+
+// NextNotificationAttempt returns when a notification that has failed attempts times is tried again,
+// and false once it has used up MaxAttempts
+func NextNotificationAttempt(config NotificationConfig, attempts int, now time.Time) (time.Time, bool) {
+	if attempts >= config.MaxAttempts {
+		return time.Time{}, false
+	}
+
+	return now.Add(Backoff(attempts, config.RetryBaseDelay, config.RetryMaxDelay)), true
+}
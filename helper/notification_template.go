@@ -0,0 +1,209 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	LOCALE_ID = "id"
+	LOCALE_EN = "en"
+
+	// LOCALE_CONTEXT_KEY is the gin context key holding the locale negotiated from Accept-Language
+	LOCALE_CONTEXT_KEY = "locale"
+
+	NOTIFICATION_TYPE_REPORT_APPROVAL = "APPROVAL REPORT"
+)
+
+var (
+	ErrUnsupportedLocale            = errors.New("unsupported locale")
+	ErrNotificationTemplateNotFound = errors.New("notification template not found")
+	ErrNotificationTemplateExists   = errors.New("notification template already exists")
+	ErrInvalidNotificationTemplate  = errors.New("invalid notification template")
+)
+
+// SupportedLocales lists the locales notifications can be rendered in
+var SupportedLocales = []string{LOCALE_ID, LOCALE_EN}
+
+// NotificationTemplateConfig controls how notifications are rendered. Links in the template data
+// that start with a slash are made absolute with LinkBaseURL.
+type NotificationTemplateConfig struct {
+	DefaultLocale string
+	LinkBaseURL   string
+}
+
+// NotificationTemplate is the subject and body of one notification type in one locale
+type NotificationTemplate struct {
+	Type    string `json:"type"`
+	Locale  string `json:"locale"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+//go:embed notification_templates.json
+var defaultNotificationTemplatesJSON []byte
+
+var defaultNotificationTemplates = mustLoadNotificationTemplates(defaultNotificationTemplatesJSON)
+
+func mustLoadNotificationTemplates(data []byte) map[string]NotificationTemplate {
+	var notificationTemplates []NotificationTemplate
+	if err := json.Unmarshal(data, &notificationTemplates); err != nil {
+		panic(fmt.Sprintf("loading default notification templates: %v", err))
+	}
+
+	templatesByKey := make(map[string]NotificationTemplate, len(notificationTemplates))
+	for _, notificationTemplate := range notificationTemplates {
+		if err := ValidateNotificationTemplate(notificationTemplate); err != nil {
+			panic(fmt.Sprintf("loading default notification template %s/%s: %v", notificationTemplate.Type, notificationTemplate.Locale, err))
+		}
+		templatesByKey[notificationTemplateKey(notificationTemplate.Type, notificationTemplate.Locale)] = notificationTemplate
+	}
+
+	return templatesByKey
+}
+
+func notificationTemplateKey(notificationType string, locale string) string {
+	return notificationType + "/" + locale
+}
+
+// DefaultNotificationTemplate returns the embedded template of a notification type in a locale
+func DefaultNotificationTemplate(notificationType string, locale string) (NotificationTemplate, bool) {
+	notificationTemplate, ok := defaultNotificationTemplates[notificationTemplateKey(notificationType, locale)]
+	return notificationTemplate, ok
+}
+
+// DefaultNotificationTemplates returns every embedded template ordered by type and locale
+func DefaultNotificationTemplates() []NotificationTemplate {
+	notificationTemplates := make([]NotificationTemplate, 0, len(defaultNotificationTemplates))
+	for _, notificationTemplate := range defaultNotificationTemplates {
+		notificationTemplates = append(notificationTemplates, notificationTemplate)
+	}
+
+	sort.Slice(notificationTemplates, func(i, j int) bool {
+		if notificationTemplates[i].Type != notificationTemplates[j].Type {
+			return notificationTemplates[i].Type < notificationTemplates[j].Type
+		}
+		return notificationTemplates[i].Locale < notificationTemplates[j].Locale
+	})
+
+	return notificationTemplates
+}
+
+// NormalizeLocale maps a language tag such as "en-US" to a supported locale
+func NormalizeLocale(locale string) (string, bool) {
+	language := strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(language, "-_"); i >= 0 {
+		language = language[:i]
+	}
+
+	for _, supported := range SupportedLocales {
+		if language == supported {
+			return supported, true
+		}
+	}
+
+	return "", false
+}
+
+// ParseAcceptLanguage returns the supported locale the Accept-Language header prefers most,
+// or an empty string when it names none of them
+func ParseAcceptLanguage(header string) string {
+	best := ""
+	bestQuality := 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale, ok := NormalizeLocale(tag)
+		if ok && quality > bestQuality {
+			best = locale
+			bestQuality = quality
+		}
+	}
+
+	return best
+}
+
+// LocaleFromContext returns the locale negotiated for the current request, if any
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(LOCALE_CONTEXT_KEY).(string)
+	return locale
+}
+
+// ValidateNotificationTemplate checks the locale and that subject and body are valid Go templates
+func ValidateNotificationTemplate(notificationTemplate NotificationTemplate) error {
+	if notificationTemplate.Type == "" {
+		return fmt.Errorf("%w: type is required", ErrInvalidNotificationTemplate)
+	}
+
+	if _, ok := NormalizeLocale(notificationTemplate.Locale); !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedLocale, notificationTemplate.Locale)
+	}
+
+	if strings.TrimSpace(notificationTemplate.Body) == "" {
+		return fmt.Errorf("%w: body is required", ErrInvalidNotificationTemplate)
+	}
+
+	if _, err := template.New("subject").Parse(notificationTemplate.Subject); err != nil {
+		return fmt.Errorf("%w: subject: %v", ErrInvalidNotificationTemplate, err)
+	}
+	if _, err := template.New("body").Parse(notificationTemplate.Body); err != nil {
+		return fmt.Errorf("%w: body: %v", ErrInvalidNotificationTemplate, err)
+	}
+
+	return nil
+}
+
+// RenderNotificationTemplate executes the subject and body of a template with data
+func RenderNotificationTemplate(notificationTemplate NotificationTemplate, data interface{}) (string, string, error) {
+	subject, err := renderTemplate("subject", notificationTemplate.Subject, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	body, err := renderTemplate("body", notificationTemplate.Body, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return subject, body, nil
+}
+
+func renderTemplate(name string, text string, data interface{}) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrInvalidNotificationTemplate, name, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("%w: %s: %v", ErrInvalidNotificationTemplate, name, err)
+	}
+
+	return strings.TrimSpace(rendered.String()), nil
+}
+
+// NotificationLink makes a link relative to the frontend absolute
+func NotificationLink(baseURL string, link string) string {
+	if link == "" || !strings.HasPrefix(link, "/") {
+		return link
+	}
+
+	return strings.TrimRight(baseURL, "/") + link
+}
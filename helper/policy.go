@@ -0,0 +1,328 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"monitoring-service/dto"
+)
+
+const (
+	ROLE_ADMIN   = "ADMIN"
+	ROLE_ADVISOR = "DOSEN PEMBIMBING"
+	ROLE_STUDENT = "MAHASISWA"
+	ROLE_LO_MBKM = "LO-MBKM"
+	ROLE_MONITOR = "DOSEN PEMONEV"
+)
+
+// Resources the policy knows about
+const (
+	POLICY_RESOURCE_REPORT                = "REPORT"
+	POLICY_RESOURCE_REPORT_SCHEDULE       = "REPORT_SCHEDULE"
+	POLICY_RESOURCE_SYLLABUS              = "SYLLABUS"
+	POLICY_RESOURCE_TRANSCRIPT            = "TRANSCRIPT"
+	POLICY_RESOURCE_REGISTRATION          = "REGISTRATION"
+	POLICY_RESOURCE_PROGRESS              = "PROGRESS"
+	POLICY_RESOURCE_DOSSIER               = "DOSSIER"
+	POLICY_RESOURCE_ANALYTICS             = "ANALYTICS"
+	POLICY_RESOURCE_EXPORT                = "EXPORT"
+	POLICY_RESOURCE_NOTIFICATION          = "NOTIFICATION"
+	POLICY_RESOURCE_NOTIFICATION_TEMPLATE = "NOTIFICATION_TEMPLATE"
+	POLICY_RESOURCE_DIGEST_PREFERENCE     = "DIGEST_PREFERENCE"
+	POLICY_RESOURCE_LOCALE_PREFERENCE     = "LOCALE_PREFERENCE"
+	POLICY_RESOURCE_AUDIT_EVENT           = "AUDIT_EVENT"
+	POLICY_RESOURCE_ADVISOR_ASSIGNMENT    = "ADVISOR_ASSIGNMENT"
+	POLICY_RESOURCE_ADVISOR_DELEGATION    = "ADVISOR_DELEGATION"
+	POLICY_RESOURCE_MONITOR_ASSIGNMENT    = "MONITOR_ASSIGNMENT"
+	POLICY_RESOURCE_REPORT_EVALUATION     = "REPORT_EVALUATION"
+	POLICY_RESOURCE_MONITORING_VISIT      = "MONITORING_VISIT"
+)
+
+// Actions an actor can take on a resource. LIST reads every resource of a kind, LIST_OWN,
+// LIST_ASSIGNED and LIST_MONITORED read the ones the actor owns, advises or monitors.
+const (
+	POLICY_ACTION_LIST           = "LIST"
+	POLICY_ACTION_LIST_OWN       = "LIST_OWN"
+	POLICY_ACTION_LIST_ASSIGNED  = "LIST_ASSIGNED"
+	POLICY_ACTION_LIST_MONITORED = "LIST_MONITORED"
+	POLICY_ACTION_VIEW           = "VIEW"
+	POLICY_ACTION_CREATE         = "CREATE"
+	POLICY_ACTION_UPDATE         = "UPDATE"
+	POLICY_ACTION_DELETE         = "DELETE"
+	POLICY_ACTION_REVIEW         = "REVIEW"
+)
+
+// Scopes say which resources of a kind a role may act on
+const (
+	// POLICY_SCOPE_ANY allows every resource of the kind
+	POLICY_SCOPE_ANY = "ANY"
+	// POLICY_SCOPE_OWNER allows the resources of the student the actor is
+	POLICY_SCOPE_OWNER = "OWNER"
+	// POLICY_SCOPE_ASSIGNED allows the resources of the registrations the actor advises
+	POLICY_SCOPE_ASSIGNED = "ASSIGNED"
+	// POLICY_SCOPE_MONITORED allows the resources of the registrations the actor is assigned to monitor
+	POLICY_SCOPE_MONITORED = "MONITORED"
+	// POLICY_SCOPE_SELF allows the settings of the actor, or the delegations it gave or received
+	POLICY_SCOPE_SELF = "SELF"
+)
+
+var ErrForbidden = errors.New("forbidden")
+
+// PolicyResource describes the resource an action targets. OwnerID and OwnerNRP identify the
+// student the resource belongs to, AdvisorEmail the academic advisor its registration names.
+// AdvisorEmails adds the other lecturers acting as advisor of the registration: co-advisors and,
+// for the actions PolicyDelegated allows, the delegates of advisors on leave. MonitorEmails are the
+// lecturers monitoring the registration.
+type PolicyResource struct {
+	Kind           string
+	RegistrationID string
+	OwnerID        string
+	OwnerNRP       string
+	AdvisorEmail   string
+	AdvisorEmails  []string
+	MonitorEmails  []string
+}
+
+// policyRule maps each role allowed an action to the scope it is allowed in
+type policyRule map[string]string
+
+var (
+	staffViewRule = policyRule{
+		ROLE_ADMIN:   POLICY_SCOPE_ANY,
+		ROLE_LO_MBKM: POLICY_SCOPE_ANY,
+		ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED,
+	}
+	monitorViewRule = policyRule{
+		ROLE_ADMIN:   POLICY_SCOPE_ANY,
+		ROLE_LO_MBKM: POLICY_SCOPE_ANY,
+		ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED,
+		ROLE_MONITOR: POLICY_SCOPE_MONITORED,
+	}
+	registrationViewRule = policyRule{
+		ROLE_ADMIN:   POLICY_SCOPE_ANY,
+		ROLE_LO_MBKM: POLICY_SCOPE_ANY,
+		ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED,
+		ROLE_MONITOR: POLICY_SCOPE_MONITORED,
+		ROLE_STUDENT: POLICY_SCOPE_OWNER,
+	}
+	documentRules = map[string]policyRule{
+		POLICY_ACTION_LIST:          {ROLE_ADMIN: POLICY_SCOPE_ANY},
+		POLICY_ACTION_LIST_OWN:      {ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_LIST_ASSIGNED: {ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED},
+		POLICY_ACTION_VIEW:          registrationViewRule,
+		POLICY_ACTION_CREATE:        {ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_UPDATE:        {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_DELETE:        {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_STUDENT: POLICY_SCOPE_OWNER},
+	}
+	adminRule      = policyRule{ROLE_ADMIN: POLICY_SCOPE_ANY}
+	staffRule      = policyRule{ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_LO_MBKM: POLICY_SCOPE_ANY}
+	delegationRule = policyRule{
+		ROLE_ADMIN:   POLICY_SCOPE_ANY,
+		ROLE_LO_MBKM: POLICY_SCOPE_ANY,
+		ROLE_ADVISOR: POLICY_SCOPE_SELF,
+	}
+	selfRule = policyRule{
+		ROLE_ADMIN:   POLICY_SCOPE_SELF,
+		ROLE_LO_MBKM: POLICY_SCOPE_SELF,
+		ROLE_ADVISOR: POLICY_SCOPE_SELF,
+		ROLE_STUDENT: POLICY_SCOPE_SELF,
+	}
+)
+
+// policyRules is the single source of who may do what
+var policyRules = map[string]map[string]policyRule{
+	POLICY_RESOURCE_REPORT: {
+		POLICY_ACTION_LIST:   adminRule,
+		POLICY_ACTION_VIEW:   registrationViewRule,
+		POLICY_ACTION_CREATE: {ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_UPDATE: {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_DELETE: {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_REVIEW: {ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED},
+	},
+	POLICY_RESOURCE_REPORT_SCHEDULE: {
+		POLICY_ACTION_LIST:           adminRule,
+		POLICY_ACTION_LIST_OWN:       {ROLE_STUDENT: POLICY_SCOPE_OWNER},
+		POLICY_ACTION_LIST_ASSIGNED:  {ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED},
+		POLICY_ACTION_LIST_MONITORED: {ROLE_MONITOR: POLICY_SCOPE_MONITORED},
+		POLICY_ACTION_VIEW:           registrationViewRule,
+		POLICY_ACTION_CREATE:         staffViewRule,
+		POLICY_ACTION_UPDATE:         staffViewRule,
+		POLICY_ACTION_DELETE:         {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_LO_MBKM: POLICY_SCOPE_ANY},
+	},
+	POLICY_RESOURCE_SYLLABUS:   documentRules,
+	POLICY_RESOURCE_TRANSCRIPT: documentRules,
+	POLICY_RESOURCE_REGISTRATION: {
+		POLICY_ACTION_VIEW: registrationViewRule,
+	},
+	POLICY_RESOURCE_PROGRESS: {
+		POLICY_ACTION_VIEW:          monitorViewRule,
+		POLICY_ACTION_LIST_ASSIGNED: {ROLE_ADVISOR: POLICY_SCOPE_ASSIGNED},
+	},
+	POLICY_RESOURCE_DOSSIER: {
+		POLICY_ACTION_VIEW:   monitorViewRule,
+		POLICY_ACTION_CREATE: staffViewRule,
+	},
+	POLICY_RESOURCE_ANALYTICS: {
+		POLICY_ACTION_VIEW: {ROLE_ADMIN: POLICY_SCOPE_ANY, ROLE_LO_MBKM: POLICY_SCOPE_ANY},
+	},
+	POLICY_RESOURCE_EXPORT: {
+		POLICY_ACTION_LIST: staffViewRule,
+	},
+	POLICY_RESOURCE_NOTIFICATION: {
+		POLICY_ACTION_LIST:   adminRule,
+		POLICY_ACTION_UPDATE: adminRule,
+	},
+	POLICY_RESOURCE_NOTIFICATION_TEMPLATE: {
+		POLICY_ACTION_LIST:   adminRule,
+		POLICY_ACTION_CREATE: adminRule,
+		POLICY_ACTION_UPDATE: adminRule,
+		POLICY_ACTION_DELETE: adminRule,
+	},
+	POLICY_RESOURCE_DIGEST_PREFERENCE: {
+		POLICY_ACTION_VIEW:   {ROLE_ADVISOR: POLICY_SCOPE_SELF},
+		POLICY_ACTION_UPDATE: {ROLE_ADVISOR: POLICY_SCOPE_SELF},
+	},
+	POLICY_RESOURCE_LOCALE_PREFERENCE: {
+		POLICY_ACTION_VIEW:   selfRule,
+		POLICY_ACTION_UPDATE: selfRule,
+	},
+	POLICY_RESOURCE_AUDIT_EVENT: {
+		POLICY_ACTION_LIST: adminRule,
+	},
+	POLICY_RESOURCE_ADVISOR_ASSIGNMENT: {
+		POLICY_ACTION_VIEW:   staffViewRule,
+		POLICY_ACTION_CREATE: staffRule,
+		POLICY_ACTION_DELETE: staffRule,
+	},
+	POLICY_RESOURCE_ADVISOR_DELEGATION: {
+		POLICY_ACTION_LIST:   delegationRule,
+		POLICY_ACTION_CREATE: delegationRule,
+		POLICY_ACTION_DELETE: delegationRule,
+	},
+	POLICY_RESOURCE_MONITOR_ASSIGNMENT: {
+		POLICY_ACTION_VIEW:   monitorViewRule,
+		POLICY_ACTION_CREATE: staffRule,
+		POLICY_ACTION_DELETE: staffRule,
+	},
+	POLICY_RESOURCE_REPORT_EVALUATION: {
+		POLICY_ACTION_VIEW:   monitorViewRule,
+		POLICY_ACTION_CREATE: {ROLE_MONITOR: POLICY_SCOPE_MONITORED},
+	},
+	POLICY_RESOURCE_MONITORING_VISIT: {
+		POLICY_ACTION_VIEW:   monitorViewRule,
+		POLICY_ACTION_CREATE: {ROLE_MONITOR: POLICY_SCOPE_MONITORED},
+	},
+}
+
+// delegatedActions are the actions an advisor on leave hands over to its delegates: reading and
+// reviewing the reports of its registrations. Everything else stays with the advisors themselves.
+var delegatedActions = map[string]map[string]bool{
+	POLICY_RESOURCE_REPORT: {
+		POLICY_ACTION_VIEW:   true,
+		POLICY_ACTION_REVIEW: true,
+	},
+}
+
+// PolicyDelegated answers whether the delegates of an advisor may take action on its resources of the kind
+func PolicyDelegated(kind string, action string) bool {
+	return delegatedActions[kind][action]
+}
+
+// PolicyRoles returns the roles that may take action on some resource of the kind, sorted as
+// they appear in the role constants. Routes use it to turn other roles away before any lookup.
+func PolicyRoles(kind string, action string) []string {
+	rule := policyRules[kind][action]
+
+	var roles []string
+	for _, role := range []string{ROLE_ADMIN, ROLE_LO_MBKM, ROLE_ADVISOR, ROLE_MONITOR, ROLE_STUDENT} {
+		if _, ok := rule[role]; ok {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// PolicyScope returns the scope the role of actor may take action in, and false when it may not
+// take the action at all
+func PolicyScope(actor dto.User, kind string, action string) (string, bool) {
+	scope, ok := policyRules[kind][action][actor.Role]
+	return scope, ok
+}
+
+// Authorize answers whether actor may take action on resource, the error wraps ErrForbidden when not
+func Authorize(actor dto.User, action string, resource PolicyResource) error {
+	scope, ok := PolicyScope(actor, resource.Kind, action)
+	if !ok {
+		return fmt.Errorf("%w: %s may not %s %s", ErrForbidden, roleName(actor.Role), action, resource.Kind)
+	}
+
+	switch scope {
+	case POLICY_SCOPE_ANY, POLICY_SCOPE_SELF:
+		return nil
+	case POLICY_SCOPE_OWNER:
+		if isOwner(actor, resource) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s belongs to another student", ErrForbidden, resource.Kind)
+	case POLICY_SCOPE_ASSIGNED:
+		if isAdvisor(actor, resource) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s is not assigned to this %s", ErrForbidden, roleName(actor.Role), resource.Kind)
+	case POLICY_SCOPE_MONITORED:
+		if isMonitor(actor, resource) {
+			return nil
+		}
+		return fmt.Errorf("%w: %s does not monitor this %s", ErrForbidden, roleName(actor.Role), resource.Kind)
+	}
+
+	return fmt.Errorf("%w: unknown scope %s", ErrForbidden, scope)
+}
+
+func isOwner(actor dto.User, resource PolicyResource) bool {
+	if actor.ID != "" && resource.OwnerID != "" {
+		return actor.ID == resource.OwnerID
+	}
+
+	return actor.NRP != "" && actor.NRP == resource.OwnerNRP
+}
+
+func isAdvisor(actor dto.User, resource PolicyResource) bool {
+	if actor.Email == "" {
+		return false
+	}
+
+	if actor.Email == resource.AdvisorEmail {
+		return true
+	}
+
+	for _, advisorEmail := range resource.AdvisorEmails {
+		if actor.Email == advisorEmail {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isMonitor(actor dto.User, resource PolicyResource) bool {
+	if actor.Email == "" {
+		return false
+	}
+
+	for _, monitorEmail := range resource.MonitorEmails {
+		if actor.Email == monitorEmail {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roleName(role string) string {
+	if role == "" {
+		return "user without role"
+	}
+
+	return role
+}
@@ -0,0 +1,28 @@
+package helper
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	DASHBOARD_SORT_PENDING = "most_pending"
+	DASHBOARD_SORT_OVERDUE = "most_overdue"
+)
+
+// ErrInvalidDashboardSort is returned for a dashboard sort key other than the DASHBOARD_SORT_* values
+var ErrInvalidDashboardSort = errors.New("invalid dashboard sort")
+
+// ValidateDashboardSort checks if the given sort key is empty or a known dashboard sort
+func ValidateDashboardSort(sortBy string) bool {
+	return sortBy == "" || sortBy == DASHBOARD_SORT_PENDING || sortBy == DASHBOARD_SORT_OVERDUE
+}
+
+// Percentage returns part as a share of total, rounded to two decimals
+func Percentage(part int64, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
@@ -0,0 +1,78 @@
+package helper
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	REMINDER_TYPE_DUE_SOON = "REPORT_DUE_SOON"
+	REMINDER_TYPE_OVERDUE  = "REPORT_OVERDUE"
+)
+
+// ReminderConfig controls the background deadline reminders
+type ReminderConfig struct {
+	Enabled         bool
+	Interval        time.Duration
+	DueSoonOffsets  []time.Duration
+	OverdueOffsets  []time.Duration
+	OverdueLookback time.Duration
+	ServiceToken    string
+}
+
+// ReminderWindow selects the report schedules whose end date falls in (From, To] for one reminder kind
+type ReminderWindow struct {
+	Type string
+	Kind string
+	From time.Time
+	To   time.Time
+}
+
+// ReminderWindows turns the configured offsets into end date windows relative to now.
+// Due soon windows do not overlap, so a schedule only gets the reminder of the closest offset it falls into.
+// Overdue windows stop after the lookback so old schedules are not reminded when the scheduler first runs.
+func ReminderWindows(config ReminderConfig, now time.Time) []ReminderWindow {
+	var windows []ReminderWindow
+
+	dueSoonOffsets := sortedOffsets(config.DueSoonOffsets)
+	for i, offset := range dueSoonOffsets {
+		var lower time.Duration
+		if i+1 < len(dueSoonOffsets) {
+			lower = dueSoonOffsets[i+1]
+		}
+
+		windows = append(windows, ReminderWindow{
+			Type: REMINDER_TYPE_DUE_SOON,
+			Kind: REMINDER_TYPE_DUE_SOON + "_" + offset.String(),
+			From: now.Add(lower),
+			To:   now.Add(offset),
+		})
+	}
+
+	for _, offset := range sortedOffsets(config.OverdueOffsets) {
+		windows = append(windows, ReminderWindow{
+			Type: REMINDER_TYPE_OVERDUE,
+			Kind: REMINDER_TYPE_OVERDUE + "_" + offset.String(),
+			From: now.Add(-offset - config.OverdueLookback),
+			To:   now.Add(-offset),
+		})
+	}
+
+	return windows
+}
+
+// sortedOffsets returns the distinct non-negative offsets, largest first
+func sortedOffsets(offsets []time.Duration) []time.Duration {
+	seen := make(map[time.Duration]bool)
+	var result []time.Duration
+	for _, offset := range offsets {
+		if offset < 0 || seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		result = append(result, offset)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] > result[j] })
+	return result
+}
@@ -0,0 +1,122 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	REPORT_STATUS_DRAFT              = "DRAFT"
+	REPORT_STATUS_PENDING            = "PENDING"
+	REPORT_STATUS_REVISION_REQUESTED = "REVISION_REQUESTED"
+	REPORT_STATUS_RESUBMITTED        = "RESUBMITTED"
+	REPORT_STATUS_APPROVED           = "APPROVED"
+	REPORT_STATUS_REJECTED           = "REJECTED"
+)
+
+var (
+	// ErrInvalidReportStatus is returned for a status outside the report lifecycle
+	ErrInvalidReportStatus = errors.New("invalid report status")
+	// ErrReportStatusConflict is returned when a report cannot move to the requested status
+	ErrReportStatusConflict = errors.New("report status conflict")
+	// ErrReportLocked is returned when a student edits a report that has already been approved
+	ErrReportLocked = errors.New("approved report can no longer be edited")
+)
+
+// ReportStatusTransitionError describes a rejected move between two report statuses
+type ReportStatusTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *ReportStatusTransitionError) Error() string {
+	return fmt.Sprintf("cannot change report status from %s to %s", e.From, e.To)
+}
+
+func (e *ReportStatusTransitionError) Unwrap() error {
+	return ErrReportStatusConflict
+}
+
+// reportStatusTransitions lists, per status, the statuses a report may move to
+var reportStatusTransitions = map[string][]string{
+	REPORT_STATUS_DRAFT:              {REPORT_STATUS_PENDING},
+	REPORT_STATUS_PENDING:            {REPORT_STATUS_REVISION_REQUESTED, REPORT_STATUS_APPROVED, REPORT_STATUS_REJECTED},
+	REPORT_STATUS_REVISION_REQUESTED: {REPORT_STATUS_RESUBMITTED},
+	REPORT_STATUS_RESUBMITTED:        {REPORT_STATUS_REVISION_REQUESTED, REPORT_STATUS_APPROVED, REPORT_STATUS_REJECTED},
+	REPORT_STATUS_APPROVED:           {},
+	REPORT_STATUS_REJECTED:           {},
+}
+
+// ValidateReportStatus checks if the given status belongs to the report lifecycle
+func ValidateReportStatus(status string) bool {
+	_, ok := reportStatusTransitions[status]
+	return ok
+}
+
+// ValidateReviewStatus checks if the given status is one an advisor may give a report when reviewing it.
+// The other statuses are reached by the student submitting or editing the report.
+func ValidateReviewStatus(status string) bool {
+	switch status {
+	case REPORT_STATUS_REVISION_REQUESTED, REPORT_STATUS_APPROVED, REPORT_STATUS_REJECTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateReportStatusTransition returns a typed error when a report may not move from one status to another
+func ValidateReportStatusTransition(from string, to string) error {
+	if !ValidateReportStatus(to) {
+		return ErrInvalidReportStatus
+	}
+
+	for _, allowed := range reportStatusTransitions[from] {
+		if allowed == to {
+			return nil
+		}
+	}
+
+	return &ReportStatusTransitionError{From: from, To: to}
+}
+
+// ValidateInitialReportStatus checks the status a new report is created with, defaulting to PENDING
+func ValidateInitialReportStatus(status string) (string, error) {
+	switch status {
+	case "":
+		return REPORT_STATUS_PENDING, nil
+	case REPORT_STATUS_DRAFT, REPORT_STATUS_PENDING:
+		return status, nil
+	default:
+		return "", ErrInvalidReportStatus
+	}
+}
+
+// ResolveEditedReportStatus returns the status a report ends up in after its author edits it.
+// requested is the optional status sent with the edit and is only used to submit a draft.
+func ResolveEditedReportStatus(current string, requested string) (string, error) {
+	if requested != "" && requested != REPORT_STATUS_DRAFT && requested != REPORT_STATUS_PENDING {
+		return "", ErrInvalidReportStatus
+	}
+
+	switch current {
+	case REPORT_STATUS_DRAFT:
+		if requested == REPORT_STATUS_PENDING {
+			return REPORT_STATUS_PENDING, nil
+		}
+		return REPORT_STATUS_DRAFT, nil
+	case REPORT_STATUS_PENDING, REPORT_STATUS_RESUBMITTED:
+		if requested == REPORT_STATUS_DRAFT {
+			return "", &ReportStatusTransitionError{From: current, To: requested}
+		}
+		return current, nil
+	case REPORT_STATUS_REVISION_REQUESTED:
+		if requested == REPORT_STATUS_DRAFT {
+			return "", &ReportStatusTransitionError{From: current, To: requested}
+		}
+		return REPORT_STATUS_RESUBMITTED, nil
+	case REPORT_STATUS_APPROVED:
+		return "", ErrReportLocked
+	default:
+		return "", &ReportStatusTransitionError{From: current, To: REPORT_STATUS_RESUBMITTED}
+	}
+}
@@ -0,0 +1,92 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidDateRange is returned when a date filter cannot be parsed or ends before it starts
+var ErrInvalidDateRange = errors.New("invalid date range")
+
+const (
+	REPORT_CADENCE_WEEKLY   = "WEEKLY"
+	REPORT_CADENCE_BIWEEKLY = "BIWEEKLY"
+)
+
+// ReportPeriod is a single reporting window inside an activity
+type ReportPeriod struct {
+	Week      int
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// ResolveCadenceDays returns the length in days of one reporting period
+func ResolveCadenceDays(cadence string) (int, error) {
+	switch cadence {
+	case REPORT_CADENCE_WEEKLY:
+		return 7, nil
+	case REPORT_CADENCE_BIWEEKLY:
+		return 14, nil
+	default:
+		return 0, errors.New("invalid cadence")
+	}
+}
+
+// ActivityWeeks returns how many (partial) weeks an activity window spans
+func ActivityWeeks(startDate time.Time, endDate time.Time) int {
+	if !endDate.After(startDate) {
+		return 0
+	}
+
+	days := int(endDate.Sub(startDate).Hours() / 24)
+	return days/7 + 1
+}
+
+// ReportScheduleConfig bounds the activity windows report schedules are generated for
+type ReportScheduleConfig struct {
+	MaxWeeks int
+}
+
+// ValidateReportWindow rejects an activity window longer than maxWeeks, any length is allowed when maxWeeks is not positive
+func ValidateReportWindow(startDate time.Time, endDate time.Time, maxWeeks int) error {
+	if maxWeeks > 0 && endDate.After(startDate.AddDate(0, 0, 7*maxWeeks)) {
+		return fmt.Errorf("%w: activity window is longer than %d weeks", ErrInvalidDateRange, maxWeeks)
+	}
+
+	return nil
+}
+
+// GenerateReportPeriods splits an activity window into reporting periods.
+// Week is the activity week in which the period starts, so a biweekly cadence yields weeks 1, 3, 5, ...
+func GenerateReportPeriods(startDate time.Time, endDate time.Time, cadence string) ([]ReportPeriod, error) {
+	if !endDate.After(startDate) {
+		return nil, fmt.Errorf("%w: end date must be after start date", ErrInvalidDateRange)
+	}
+
+	cadenceDays, err := ResolveCadenceDays(cadence)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []ReportPeriod
+	for offset := 0; ; offset += cadenceDays {
+		periodStart := startDate.AddDate(0, 0, offset)
+		if !periodStart.Before(endDate) {
+			break
+		}
+
+		periodEnd := periodStart.AddDate(0, 0, cadenceDays).Add(-time.Second)
+		if periodEnd.After(endDate) {
+			periodEnd = endDate
+		}
+
+		periods = append(periods, ReportPeriod{
+			Week:      offset/7 + 1,
+			StartDate: periodStart,
+			EndDate:   periodEnd,
+		})
+	}
+
+	return periods, nil
+}
@@ -1,11 +1,11 @@
 package main
 
 import (
+	"context"
 	"log"
 	"monitoring-service/config"
 	"monitoring-service/middleware"
 	"monitoring-service/routes"
-	"monitoring-service/service"
 	"strconv"
 
 	storageService "github.com/SIM-MBKM/filestorage/storage"
@@ -18,6 +18,9 @@ func main() {
 	// Load configuration
 	baseServiceHelpers.LoadEnv()
 	cfg := config.LoadConfig()
+	if err := cfg.Notification.Validate(); err != nil {
+		log.Fatalf("Invalid notification config: %v", err)
+	}
 
 	// security
 	securityKeyService := baseServiceHelpers.GetEnv("APP_KEY", "secret")
@@ -25,6 +28,7 @@ func main() {
 
 	// Initialize database connection
 	db := config.SetupDatabaseConnection()
+	config.RunMigration(db)
 	// Initialize file storage
 	storageConfig, err := storageService.LoadConfig()
 	if err != nil {
@@ -40,12 +44,6 @@ func main() {
 	brokerBaseURI := baseServiceHelpers.GetEnv("BROKER_BASE_URI", "http://localhost:8082")
 	port := baseServiceHelpers.GetEnv("GOLANG_PORT", "8088")
 
-	// Initialize user management service for routes
-	userManagementService := service.NewUserManagementService(
-		userManagementBaseURI,
-		[]string{"/async"},
-	)
-
 	// Initialize application with Wire dependency injection
 	app, err := InitializeAPI(
 		db,
@@ -55,13 +53,48 @@ func main() {
 		config.BrokerbaseURI(brokerBaseURI),
 		config.RegistrationManagementbaseURI(registrationBaseURI),
 		[]string{"/async"},
+		cfg.LatePolicies,
+		cfg.Reminder,
+		cfg.UserCache,
+		cfg.Downstream,
+		cfg.RegistrationSync,
+		cfg.ReportSchedule,
+		cfg.ScheduleProvisioning,
+		cfg.Outbox,
+		cfg.Notification,
+		cfg.AdvisorNotification,
+		cfg.NotificationTemplate,
+		cfg.JWT,
 	)
 	if err != nil {
 		log.Fatalf("Failed to initialize API: %v", err)
 	}
 
+	// user management service shared with the services so routes and services use one cache
+	userManagementService := app.UserManagementService
+
+	// Send report deadline reminders in the background
+	go app.ReminderService.Start(context.Background())
+
+	// Keep registration snapshots in sync with registration events
+	go app.RegistrationSyncService.Start(context.Background())
+
+	// Create report schedules as soon as a registration is approved
+	go app.ScheduleProvisioningService.Start(context.Background())
+
+	// Publish the domain events written to the outbox
+	go app.OutboxService.Start(context.Background())
+
+	// Deliver queued notifications, retrying the failed ones
+	go app.NotificationService.Start(context.Background())
+
+	// Send advisors who opted in the daily digest of new submissions
+	go app.AdvisorNotificationService.Start(context.Background())
+
 	// Setup Gin router
 	router := gin.Default()
+	// let services read the request deadline and cancellation from the gin context
+	router.ContextWithFallback = true
 
 	frontendConfig := securityMiddleware.FrontendConfig{
 		AllowedOrigins:    cfg.FrontendAllowedOrigins,
@@ -74,12 +107,26 @@ func main() {
 	// add cors
 	router.Use(middleware.CORS())
 	router.Use(securityMiddleware.AccessKeyMiddleware(securityKeyService, expireSeconds, &frontendConfig))
+	// tag the request for the audit trail
+	router.Use(middleware.RequestMetadata())
+	// negotiate the notification locale from Accept-Language
+	router.Use(middleware.Locale())
+	// verify bearer tokens locally, the rest is looked up in user management by the routes
+	router.Use(middleware.Authentication(app.TokenVerifier))
 
 	// Setup routes for all controllers
-	routes.ReportRoutes(router, app.ReportController, *userManagementService)
-	routes.ReportScheduleRoutes(router, app.ReportScheduleController, *userManagementService)
-	routes.TranscriptRoutes(router, app.TranscriptController, *userManagementService)
-	routes.SyllabusRoutes(router, app.SyllabusController, *userManagementService)
+	routes.ReportRoutes(router, app.ReportController, userManagementService)
+	routes.ReportScheduleRoutes(router, app.ReportScheduleController, userManagementService)
+	routes.TranscriptRoutes(router, app.TranscriptController, userManagementService)
+	routes.SyllabusRoutes(router, app.SyllabusController, userManagementService)
+	routes.ProgressRoutes(router, app.ProgressController, app.DossierController, userManagementService)
+	routes.AnalyticsRoutes(router, app.AnalyticsController, userManagementService)
+	routes.ExportRoutes(router, app.ExportController, userManagementService)
+	routes.NotificationRoutes(router, app.NotificationController, userManagementService)
+	routes.NotificationTemplateRoutes(router, app.NotificationTemplateController, userManagementService)
+	routes.AuditRoutes(router, app.AuditController, userManagementService)
+	routes.AdvisorAssignmentRoutes(router, app.AdvisorAssignmentController, userManagementService)
+	routes.MonitoringRoutes(router, app.MonitoringController, userManagementService)
 
 	// Start server
 	if port == "" {
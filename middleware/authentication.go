@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Authentication verifies the bearer token locally and stores its principal, so the authorization
+// middleware and the services do not have to ask user management who the caller is. Tokens the
+// verifier cannot resolve are left to the remote lookup in AuthorizationRole.
+func Authentication(verifier service.TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader("Authorization")
+		if token == "" {
+			c.Next()
+			return
+		}
+
+		principal, err := verifier.Verify(c, token)
+		if errors.Is(err, helper.ErrRemoteLookupRequired) {
+			c.Next()
+			return
+		}
+
+		if err != nil {
+			log.Println("ERROR VERIFYING TOKEN: ", err)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Response{
+				Status:  dto.STATUS_ERROR,
+				Message: dto.MESSAGE_UNAUTHORIZED,
+			})
+			return
+		}
+
+		c.Set(service.PRINCIPAL_CONTEXT_KEY, principal)
+		c.Set(service.USER_CONTEXT_KEY, principal.User())
+		c.Set("userRole", principal.Role)
+
+		c.Next()
+	}
+}
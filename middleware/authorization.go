@@ -3,6 +3,7 @@ package middleware
 import (
 	"log"
 	"monitoring-service/dto"
+	"monitoring-service/helper"
 	"monitoring-service/service"
 	"net/http"
 
@@ -21,19 +22,34 @@ func AuthorizationRole(userService service.UserManagementService, role []string)
 			return
 		}
 
-		res := userService.GetUserRole("GET", token)
+		// a token verified by Authentication already names the caller, only the others are
+		// looked up. The user data carries the role as well, so one cached lookup serves both
+		// the role check and the services reading the user from the context
+		var user dto.User
 		var userRole string
-		if role, ok := res["role"]; ok && role != nil {
-			userRole, ok = role.(string)
-			if !ok {
-				log.Println("USER ROLE NOT STRING", res)
-				c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Response{
-					Status:  dto.STATUS_ERROR,
-					Message: dto.MESSAGE_UNAUTHORIZED,
-				})
-				return
-			}
+		var err error
+		if principal, ok := c.Value(service.PRINCIPAL_CONTEXT_KEY).(dto.Principal); ok {
+			user = principal.User()
+			userRole = principal.Role
 		} else {
+			user, err = userService.GetUserData(c, "GET", token)
+			userRole = user.Role
+			if err != nil || userRole == "" {
+				userRole, err = userService.GetUserRole(c, "GET", token)
+			}
+		}
+
+		if err != nil && service.IsServiceUnavailable(err) {
+			log.Println("ERROR GETTING USER ROLE: ", err)
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.Response{
+				Status:  dto.STATUS_ERROR,
+				Message: dto.MESSAGE_SERVICE_UNAVAILABLE,
+			})
+			return
+		}
+
+		if err != nil {
+			log.Println("ERROR GETTING USER ROLE: ", err)
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Response{
 				Status:  dto.STATUS_ERROR,
 				Message: dto.MESSAGE_UNAUTHORIZED,
@@ -58,9 +74,27 @@ func AuthorizationRole(userService service.UserManagementService, role []string)
 			return
 		}
 
-		// save role to context
+		// save role, user and principal to context
 		c.Set("userRole", userRole)
+		if user.ID != "" {
+			user.Role = userRole
+			c.Set(service.USER_CONTEXT_KEY, user)
+			c.Set(service.PRINCIPAL_CONTEXT_KEY, dto.PrincipalOf(user))
+		}
 
 		c.Next()
 	}
 }
+
+// AuthorizationPolicy lets through the roles the policy allows to take action on some resource of
+// kind, services then check the resource itself
+func AuthorizationPolicy(userService service.UserManagementService, kind string, action string) gin.HandlerFunc {
+	return AuthorizationRole(userService, helper.PolicyRoles(kind, action))
+}
+
+// Policy returns a shorthand for AuthorizationPolicy bound to userService, for route files
+func Policy(userService service.UserManagementService) func(kind string, action string) gin.HandlerFunc {
+	return func(kind string, action string) gin.HandlerFunc {
+		return AuthorizationPolicy(userService, kind, action)
+	}
+}
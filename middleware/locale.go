@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"monitoring-service/helper"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Locale stores the locale negotiated from Accept-Language so notifications rendered while
+// handling the request use it when the receiver has not chosen one
+func Locale() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if locale := helper.ParseAcceptLanguage(c.GetHeader("Accept-Language")); locale != "" {
+			c.Set(helper.LOCALE_CONTEXT_KEY, locale)
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"monitoring-service/helper"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestMetadata stores the request ID and the caller's IP address for the audit trail. The
+// request ID set by the gateway is kept, otherwise one is generated, and it is echoed back so a
+// caller can quote it when reporting a problem.
+func RequestMetadata() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(helper.REQUEST_ID_HEADER)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.New().String()
+		}
+
+		c.Set(helper.REQUEST_ID_CONTEXT_KEY, requestID)
+		c.Set(helper.CLIENT_IP_CONTEXT_KEY, c.ClientIP())
+		c.Header(helper.REQUEST_ID_HEADER, requestID)
+
+		c.Next()
+	}
+}
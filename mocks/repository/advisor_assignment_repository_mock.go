@@ -0,0 +1,74 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"monitoring-service/repository"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAdvisorAssignmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdvisorAssignmentRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.AdvisorAssignment, error) {
+	args := m.Called(ctx, registrationID, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AdvisorAssignment), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	args := m.Called(ctx, id, tx)
+
+	return args.Get(0).(entity.AdvisorAssignment), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) FindByRegistrationIDAndAdvisorEmail(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	args := m.Called(ctx, registrationID, advisorEmail, tx)
+
+	return args.Get(0).(entity.AdvisorAssignment), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) Create(ctx context.Context, assignment entity.AdvisorAssignment, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	args := m.Called(ctx, assignment, tx)
+
+	return args.Get(0).(entity.AdvisorAssignment), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	args := m.Called(ctx, id, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorAssignmentRepository) FindDelegations(ctx context.Context, filter repository.AdvisorDelegationFilter, tx *gorm.DB) ([]entity.AdvisorDelegation, error) {
+	args := m.Called(ctx, filter, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AdvisorDelegation), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) FindDelegationByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorDelegation, error) {
+	args := m.Called(ctx, id, tx)
+
+	return args.Get(0).(entity.AdvisorDelegation), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) CreateDelegation(ctx context.Context, delegation entity.AdvisorDelegation, tx *gorm.DB) (entity.AdvisorDelegation, error) {
+	args := m.Called(ctx, delegation, tx)
+
+	return args.Get(0).(entity.AdvisorDelegation), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentRepository) DestroyDelegation(ctx context.Context, id string, tx *gorm.DB) error {
+	args := m.Called(ctx, id, tx)
+
+	return args.Error(0)
+}
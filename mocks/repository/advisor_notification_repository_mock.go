@@ -0,0 +1,47 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAdvisorNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdvisorNotificationRepository) FindPreference(ctx context.Context, advisorEmail string, tx *gorm.DB) (entity.AdvisorNotificationPreference, error) {
+	args := m.Called(ctx, advisorEmail, tx)
+
+	return args.Get(0).(entity.AdvisorNotificationPreference), args.Error(1)
+}
+
+func (m *MockAdvisorNotificationRepository) SavePreference(ctx context.Context, preference entity.AdvisorNotificationPreference, tx *gorm.DB) error {
+	args := m.Called(ctx, preference, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorNotificationRepository) CreateDigestEntry(ctx context.Context, entry entity.AdvisorDigestEntry, tx *gorm.DB) error {
+	args := m.Called(ctx, entry, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorNotificationRepository) FindUndigestedEntries(ctx context.Context, tx *gorm.DB) ([]entity.AdvisorDigestEntry, error) {
+	args := m.Called(ctx, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AdvisorDigestEntry), args.Error(1)
+}
+
+func (m *MockAdvisorNotificationRepository) MarkDigested(ctx context.Context, ids []string, digestedAt time.Time, tx *gorm.DB) error {
+	args := m.Called(ctx, ids, digestedAt, tx)
+
+	return args.Error(0)
+}
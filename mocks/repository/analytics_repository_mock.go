@@ -0,0 +1,38 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAnalyticsRepository struct {
+	mock.Mock
+}
+
+func (m *MockAnalyticsRepository) SubmissionRateByWeek(ctx context.Context, filter repository.AnalyticsFilter, now time.Time, tx *gorm.DB) ([]repository.WeeklySubmissionRate, error) {
+	args := m.Called(ctx, filter, now, tx)
+
+	return args.Get(0).([]repository.WeeklySubmissionRate), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) ApprovalLatency(ctx context.Context, filter repository.AnalyticsFilter, tx *gorm.DB) (repository.ApprovalLatency, error) {
+	args := m.Called(ctx, filter, tx)
+
+	return args.Get(0).(repository.ApprovalLatency), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) AdvisorBacklog(ctx context.Context, filter repository.AnalyticsFilter, limit int, tx *gorm.DB) ([]repository.AdvisorBacklog, error) {
+	args := m.Called(ctx, filter, limit, tx)
+
+	return args.Get(0).([]repository.AdvisorBacklog), args.Error(1)
+}
+
+func (m *MockAnalyticsRepository) LowestActivityCompliance(ctx context.Context, filter repository.AnalyticsFilter, now time.Time, limit int, tx *gorm.DB) ([]repository.ActivityCompliance, error) {
+	args := m.Called(ctx, filter, now, limit, tx)
+
+	return args.Get(0).([]repository.ActivityCompliance), args.Error(1)
+}
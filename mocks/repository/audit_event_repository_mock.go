@@ -0,0 +1,27 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/repository"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAuditEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditEventRepository) Create(ctx context.Context, auditEvents []entity.AuditEvent, tx *gorm.DB) error {
+	args := m.Called(ctx, auditEvents, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockAuditEventRepository) FindAll(ctx context.Context, filter repository.AuditEventFilter, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.AuditEvent, int64, error) {
+	args := m.Called(ctx, filter, pagReq, tx)
+
+	return args.Get(0).([]entity.AuditEvent), args.Get(1).(int64), args.Error(2)
+}
@@ -25,3 +25,12 @@ func (m *MockBaseRepository) CommitTx(ctx context.Context, tx *gorm.DB) (*gorm.D
 func (m *MockBaseRepository) RollbackTx(ctx context.Context, tx *gorm.DB) {
 	m.Called(ctx, tx)
 }
+
+func (m *MockBaseRepository) WithinTx(ctx context.Context, tx *gorm.DB, fn func(tx *gorm.DB) error) error {
+	args := m.Called(ctx, tx, fn)
+
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(tx)
+}
@@ -0,0 +1,31 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockDossierRepository struct {
+	mock.Mock
+}
+
+func (m *MockDossierRepository) FindReportSchedulesByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.ReportSchedule, error) {
+	args := m.Called(ctx, registrationID, tx)
+
+	return args.Get(0).([]entity.ReportSchedule), args.Error(1)
+}
+
+func (m *MockDossierRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) (entity.RegistrationDossier, error) {
+	args := m.Called(ctx, registrationID, tx)
+
+	return args.Get(0).(entity.RegistrationDossier), args.Error(1)
+}
+
+func (m *MockDossierRepository) Save(ctx context.Context, dossier entity.RegistrationDossier, tx *gorm.DB) (entity.RegistrationDossier, error) {
+	args := m.Called(ctx, dossier, tx)
+
+	return args.Get(0).(entity.RegistrationDossier), args.Error(1)
+}
@@ -0,0 +1,60 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/repository"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// MockExportRepository feeds the rows given to Return to the callback before returning the error
+type MockExportRepository struct {
+	mock.Mock
+}
+
+func (m *MockExportRepository) StreamReportSchedules(ctx context.Context, filter repository.ExportFilter, fn func(repository.ExportScheduleRow) error, tx *gorm.DB) error {
+	args := m.Called(ctx, filter, tx)
+
+	for _, row := range args.Get(0).([]repository.ExportScheduleRow) {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
+}
+
+func (m *MockExportRepository) StreamReports(ctx context.Context, filter repository.ExportFilter, fn func(repository.ExportScheduleRow) error, tx *gorm.DB) error {
+	args := m.Called(ctx, filter, tx)
+
+	for _, row := range args.Get(0).([]repository.ExportScheduleRow) {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
+}
+
+func (m *MockExportRepository) StreamDocuments(ctx context.Context, filter repository.ExportFilter, fn func(repository.ExportDocumentRow) error, tx *gorm.DB) error {
+	args := m.Called(ctx, filter, tx)
+
+	for _, row := range args.Get(0).([]repository.ExportDocumentRow) {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+
+	return args.Error(1)
+}
+
+func (m *MockExportRepository) FindUnnamedUserIDs(ctx context.Context, filter repository.ExportFilter, tx *gorm.DB) ([]string, error) {
+	args := m.Called(ctx, filter, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
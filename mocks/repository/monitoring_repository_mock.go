@@ -0,0 +1,76 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockMonitoringRepository struct {
+	mock.Mock
+}
+
+func (m *MockMonitoringRepository) FindAssignmentsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitorAssignment, error) {
+	args := m.Called(ctx, registrationID, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.MonitorAssignment), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) FindAssignmentByID(ctx context.Context, id string, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	args := m.Called(ctx, id, tx)
+
+	return args.Get(0).(entity.MonitorAssignment), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) FindAssignmentByRegistrationIDAndMonitorEmail(ctx context.Context, registrationID string, monitorEmail string, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	args := m.Called(ctx, registrationID, monitorEmail, tx)
+
+	return args.Get(0).(entity.MonitorAssignment), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) CreateAssignment(ctx context.Context, assignment entity.MonitorAssignment, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	args := m.Called(ctx, assignment, tx)
+
+	return args.Get(0).(entity.MonitorAssignment), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) DestroyAssignment(ctx context.Context, id string, tx *gorm.DB) error {
+	args := m.Called(ctx, id, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockMonitoringRepository) FindEvaluationsByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportEvaluation, error) {
+	args := m.Called(ctx, reportID, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ReportEvaluation), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) CreateEvaluation(ctx context.Context, evaluation entity.ReportEvaluation, tx *gorm.DB) (entity.ReportEvaluation, error) {
+	args := m.Called(ctx, evaluation, tx)
+
+	return args.Get(0).(entity.ReportEvaluation), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) FindVisitsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitoringVisit, error) {
+	args := m.Called(ctx, registrationID, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.MonitoringVisit), args.Error(1)
+}
+
+func (m *MockMonitoringRepository) CreateVisit(ctx context.Context, visit entity.MonitoringVisit, tx *gorm.DB) (entity.MonitoringVisit, error) {
+	args := m.Called(ctx, visit, tx)
+
+	return args.Get(0).(entity.MonitoringVisit), args.Error(1)
+}
@@ -0,0 +1,72 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockNotificationRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepository) Create(ctx context.Context, notifications []entity.Notification, tx *gorm.DB) error {
+	args := m.Called(ctx, notifications, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindDue(ctx context.Context, now time.Time, limit int, tx *gorm.DB) ([]entity.Notification, error) {
+	args := m.Called(ctx, now, limit, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Claim(ctx context.Context, ids []string, until time.Time, tx *gorm.DB) error {
+	args := m.Called(ctx, ids, until, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) RecordAttempt(ctx context.Context, notification entity.Notification, attempt entity.NotificationAttempt, tx *gorm.DB) error {
+	args := m.Called(ctx, notification, attempt, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Notification, error) {
+	args := m.Called(ctx, id, tx)
+
+	return args.Get(0).(entity.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepository) FindByStatus(ctx context.Context, status string, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.Notification, int64, error) {
+	args := m.Called(ctx, status, pagReq, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int64), args.Error(2)
+	}
+	return args.Get(0).([]entity.Notification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockNotificationRepository) FindAttempts(ctx context.Context, notificationIDs []string, tx *gorm.DB) (map[string][]entity.NotificationAttempt, error) {
+	args := m.Called(ctx, notificationIDs, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.NotificationAttempt), args.Error(1)
+}
+
+func (m *MockNotificationRepository) Resend(ctx context.Context, id string, now time.Time, tx *gorm.DB) (bool, error) {
+	args := m.Called(ctx, id, now, tx)
+
+	return args.Bool(0), args.Error(1)
+}
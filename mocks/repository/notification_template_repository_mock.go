@@ -0,0 +1,64 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockNotificationTemplateRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationTemplateRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity.NotificationTemplate, error) {
+	args := m.Called(ctx, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	args := m.Called(ctx, id, tx)
+
+	return args.Get(0).(entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) FindByTypeAndLocale(ctx context.Context, notificationType string, locale string, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	args := m.Called(ctx, notificationType, locale, tx)
+
+	return args.Get(0).(entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) Create(ctx context.Context, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	args := m.Called(ctx, notificationTemplate, tx)
+
+	return args.Get(0).(entity.NotificationTemplate), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) Update(ctx context.Context, id string, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) error {
+	args := m.Called(ctx, id, notificationTemplate, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationTemplateRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	args := m.Called(ctx, id, tx)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationTemplateRepository) FindLocale(ctx context.Context, userEmail string, tx *gorm.DB) (entity.UserLocalePreference, error) {
+	args := m.Called(ctx, userEmail, tx)
+
+	return args.Get(0).(entity.UserLocalePreference), args.Error(1)
+}
+
+func (m *MockNotificationTemplateRepository) SaveLocale(ctx context.Context, preference entity.UserLocalePreference, tx *gorm.DB) error {
+	args := m.Called(ctx, preference, tx)
+
+	return args.Error(0)
+}
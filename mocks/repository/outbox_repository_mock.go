@@ -0,0 +1,37 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Create(ctx context.Context, outboxEvents []entity.OutboxEvent, tx *gorm.DB) error {
+	args := m.Called(ctx, outboxEvents, tx)
+
+	return args.Error(0)
+}
+
+// PublishPending hands the events of the expectation to publish until one fails and returns its error
+func (m *MockOutboxRepository) PublishPending(ctx context.Context, limit int, publish func(outboxEvent entity.OutboxEvent) error) (int, error) {
+	args := m.Called(ctx, limit, publish)
+	if err := args.Error(1); err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, outboxEvent := range args.Get(0).([]entity.OutboxEvent) {
+		if err := publish(outboxEvent); err != nil {
+			return published, err
+		}
+		published++
+	}
+
+	return published, nil
+}
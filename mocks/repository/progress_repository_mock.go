@@ -0,0 +1,33 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockProgressRepository struct {
+	mock.Mock
+}
+
+func (m *MockProgressRepository) SummarizeByRegistrationID(ctx context.Context, registrationID string, now time.Time, tx *gorm.DB) (repository.RegistrationProgress, error) {
+	args := m.Called(ctx, registrationID, now, tx)
+
+	return args.Get(0).(repository.RegistrationProgress), args.Error(1)
+}
+
+func (m *MockProgressRepository) SummarizeByAdvisorEmail(ctx context.Context, advisorEmail string, sortBy string, pagReq *dto.PaginationRequest, now time.Time, tx *gorm.DB) ([]repository.StudentProgress, error) {
+	args := m.Called(ctx, advisorEmail, sortBy, pagReq, now, tx)
+
+	return args.Get(0).([]repository.StudentProgress), args.Error(1)
+}
+
+func (m *MockProgressRepository) TotalsByAdvisorEmail(ctx context.Context, advisorEmail string, now time.Time, tx *gorm.DB) (repository.AdvisorProgressTotals, error) {
+	args := m.Called(ctx, advisorEmail, now, tx)
+
+	return args.Get(0).(repository.AdvisorProgressTotals), args.Error(1)
+}
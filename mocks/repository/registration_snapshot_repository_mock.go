@@ -0,0 +1,44 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockRegistrationSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockRegistrationSnapshotRepository) FindByIDs(ctx context.Context, registrationIDs []string, tx *gorm.DB) (map[string]entity.RegistrationSnapshot, error) {
+	args := m.Called(ctx, registrationIDs, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]entity.RegistrationSnapshot), args.Error(1)
+}
+
+func (m *MockRegistrationSnapshotRepository) Sync(ctx context.Context, snapshot entity.RegistrationSnapshot, tx *gorm.DB) (bool, error) {
+	args := m.Called(ctx, snapshot, tx)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRegistrationSnapshotRepository) Destroy(ctx context.Context, registrationID string, syncedAt time.Time, tx *gorm.DB) (bool, error) {
+	args := m.Called(ctx, registrationID, syncedAt, tx)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRegistrationSnapshotRepository) FindUnsyncedIDs(ctx context.Context, after string, limit int, tx *gorm.DB) ([]string, error) {
+	args := m.Called(ctx, after, limit, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
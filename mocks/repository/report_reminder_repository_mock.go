@@ -0,0 +1,45 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockReportReminderRepository struct {
+	mock.Mock
+}
+
+func (m *MockReportReminderRepository) WithLock(ctx context.Context, lockKey string, fn func(tx *gorm.DB) error) (bool, error) {
+	args := m.Called(ctx, lockKey, fn)
+
+	acquired := args.Bool(0)
+	if acquired {
+		if err := fn(nil); err != nil {
+			return acquired, err
+		}
+	}
+
+	return acquired, args.Error(1)
+}
+
+func (m *MockReportReminderRepository) FindPendingSchedules(ctx context.Context, kind string, from time.Time, to time.Time, tx *gorm.DB) ([]entity.ReportSchedule, error) {
+	args := m.Called(ctx, kind, from, to, tx)
+
+	return args.Get(0).([]entity.ReportSchedule), args.Error(1)
+}
+
+func (m *MockReportReminderRepository) Create(ctx context.Context, reportReminder entity.ReportReminder, tx *gorm.DB) (bool, error) {
+	args := m.Called(ctx, reportReminder, tx)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReportReminderRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	args := m.Called(ctx, id, tx)
+
+	return args.Error(0)
+}
@@ -0,0 +1,31 @@
+package repository_mock
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockReportRevisionRepository struct {
+	mock.Mock
+}
+
+func (m *MockReportRevisionRepository) Create(ctx context.Context, reportRevision entity.ReportRevision, tx *gorm.DB) (entity.ReportRevision, error) {
+	args := m.Called(ctx, reportRevision, tx)
+
+	return args.Get(0).(entity.ReportRevision), args.Error(1)
+}
+
+func (m *MockReportRevisionRepository) FindByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportRevision, error) {
+	args := m.Called(ctx, reportID, tx)
+
+	return args.Get(0).([]entity.ReportRevision), args.Error(1)
+}
+
+func (m *MockReportRevisionRepository) FindByReportIDAndRevisionNumber(ctx context.Context, reportID string, revisionNumber int, tx *gorm.DB) (entity.ReportRevision, error) {
+	args := m.Called(ctx, reportID, revisionNumber, tx)
+
+	return args.Get(0).(entity.ReportRevision), args.Error(1)
+}
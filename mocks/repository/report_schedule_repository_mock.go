@@ -61,8 +61,20 @@ func (m *MockReportScheduleRepository) FindByUserNRPAndGroupByRegistrationID(ctx
 	return args.Get(0).(map[string][]entity.ReportSchedule), args.Error(1)
 }
 
-func (m *MockReportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string) (map[string][]entity.ReportSchedule, int64, error) {
-	args := m.Called(ctx, advisorEmail, tx, pagReq, userNrp)
+func (m *MockReportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error) {
+	args := m.Called(ctx, advisorEmail, tx, pagReq, userNrp, submissionStatus)
 
 	return args.Get(0).(map[string][]entity.ReportSchedule), args.Get(1).(int64), args.Error(2)
 }
+
+func (m *MockReportScheduleRepository) FindByMonitorEmailAndGroupByUserID(ctx context.Context, monitorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error) {
+	args := m.Called(ctx, monitorEmail, tx, pagReq, userNrp, submissionStatus)
+
+	return args.Get(0).(map[string][]entity.ReportSchedule), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockReportScheduleRepository) CreateForRegistration(ctx context.Context, registrationID string, reportSchedules []entity.ReportSchedule, tx *gorm.DB) ([]entity.ReportSchedule, []entity.ReportSchedule, error) {
+	args := m.Called(ctx, registrationID, reportSchedules, tx)
+
+	return args.Get(0).([]entity.ReportSchedule), args.Get(1).([]entity.ReportSchedule), args.Error(2)
+}
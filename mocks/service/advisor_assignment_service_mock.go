@@ -0,0 +1,72 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAdvisorAssignmentService struct {
+	mock.Mock
+}
+
+func NewMockAdvisorAssignmentService() *MockAdvisorAssignmentService {
+	return &MockAdvisorAssignmentService{}
+}
+
+func (m *MockAdvisorAssignmentService) Authorize(ctx context.Context, actor dto.User, action string, resource helper.PolicyResource) error {
+	args := m.Called(ctx, actor, action, resource)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorAssignmentService) Reviewers(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) ([]string, error) {
+	args := m.Called(ctx, registrationID, advisorEmail, tx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.AdvisorsResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	return args.Get(0).(dto.AdvisorsResponse), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentService) Assign(ctx context.Context, request dto.AdvisorAssignmentRequest, token string) (dto.AdvisorAssignmentResponse, error) {
+	args := m.Called(ctx, request, token)
+
+	return args.Get(0).(dto.AdvisorAssignmentResponse), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentService) Unassign(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorAssignmentService) FindDelegations(ctx context.Context, token string) ([]dto.AdvisorDelegationResponse, error) {
+	args := m.Called(ctx, token)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.AdvisorDelegationResponse), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentService) Delegate(ctx context.Context, request dto.AdvisorDelegationRequest, token string) (dto.AdvisorDelegationResponse, error) {
+	args := m.Called(ctx, request, token)
+
+	return args.Get(0).(dto.AdvisorDelegationResponse), args.Error(1)
+}
+
+func (m *MockAdvisorAssignmentService) RevokeDelegation(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
+
+	return args.Error(0)
+}
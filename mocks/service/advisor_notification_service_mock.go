@@ -0,0 +1,45 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockAdvisorNotificationService struct {
+	mock.Mock
+}
+
+func NewMockAdvisorNotificationService() *MockAdvisorNotificationService {
+	return &MockAdvisorNotificationService{}
+}
+
+func (m *MockAdvisorNotificationService) NotifySubmission(ctx context.Context, tx *gorm.DB, registrationID string, notification dto.NotificationRequest, data dto.NotificationTemplateData) error {
+	args := m.Called(ctx, tx, registrationID, notification, data)
+
+	return args.Error(0)
+}
+
+func (m *MockAdvisorNotificationService) GetPreference(ctx context.Context, token string) (dto.AdvisorNotificationPreferenceResponse, error) {
+	args := m.Called(ctx, token)
+
+	return args.Get(0).(dto.AdvisorNotificationPreferenceResponse), args.Error(1)
+}
+
+func (m *MockAdvisorNotificationService) UpdatePreference(ctx context.Context, token string, request dto.AdvisorNotificationPreferenceRequest) (dto.AdvisorNotificationPreferenceResponse, error) {
+	args := m.Called(ctx, token, request)
+
+	return args.Get(0).(dto.AdvisorNotificationPreferenceResponse), args.Error(1)
+}
+
+func (m *MockAdvisorNotificationService) Start(ctx context.Context) {
+	m.Called(ctx)
+}
+
+func (m *MockAdvisorNotificationService) SendDigests(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+
+	return args.Int(0), args.Error(1)
+}
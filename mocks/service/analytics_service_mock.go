@@ -0,0 +1,22 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAnalyticsService struct {
+	mock.Mock
+}
+
+func NewMockAnalyticsService() *MockAnalyticsService {
+	return &MockAnalyticsService{}
+}
+
+func (m *MockAnalyticsService) Summary(ctx context.Context, filter dto.AnalyticsFilterRequest) (dto.AnalyticsResponse, error) {
+	args := m.Called(ctx, filter)
+
+	return args.Get(0).(dto.AnalyticsResponse), args.Error(1)
+}
@@ -0,0 +1,22 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBrokerService struct {
+	mock.Mock
+}
+
+func NewMockBrokerService() *MockBrokerService {
+	return &MockBrokerService{}
+}
+
+func (m *MockBrokerService) SendNotification(ctx context.Context, notification dto.NotificationRequest, method string, token string) (dto.NotificationResult, error) {
+	args := m.Called(ctx, notification, method, token)
+
+	return args.Get(0).(dto.NotificationResult), args.Error(1)
+}
@@ -0,0 +1,34 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDossierService struct {
+	mock.Mock
+}
+
+func NewMockDossierService() *MockDossierService {
+	return &MockDossierService{}
+}
+
+func (m *MockDossierService) Render(ctx context.Context, registrationID string, token string) ([]byte, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockDossierService) Generate(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	return args.Get(0).(dto.RegistrationDossierResponse), args.Error(1)
+}
+
+func (m *MockDossierService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	return args.Get(0).(dto.RegistrationDossierResponse), args.Error(1)
+}
@@ -0,0 +1,35 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExportService struct {
+	mock.Mock
+}
+
+func NewMockExportService() *MockExportService {
+	return &MockExportService{}
+}
+
+func (m *MockExportService) ExportReportSchedules(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	args := m.Called(ctx, token, filter, writer)
+
+	return args.Error(0)
+}
+
+func (m *MockExportService) ExportReports(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	args := m.Called(ctx, token, filter, writer)
+
+	return args.Error(0)
+}
+
+func (m *MockExportService) ExportDocuments(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	args := m.Called(ctx, token, filter, writer)
+
+	return args.Error(0)
+}
@@ -0,0 +1,67 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMonitoringService struct {
+	mock.Mock
+}
+
+func NewMockMonitoringService() *MockMonitoringService {
+	return &MockMonitoringService{}
+}
+
+func (m *MockMonitoringService) FindAssignments(ctx context.Context, registrationID string, token string) ([]dto.MonitorAssignmentResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.MonitorAssignmentResponse), args.Error(1)
+}
+
+func (m *MockMonitoringService) Assign(ctx context.Context, request dto.MonitorAssignmentRequest, token string) (dto.MonitorAssignmentResponse, error) {
+	args := m.Called(ctx, request, token)
+
+	return args.Get(0).(dto.MonitorAssignmentResponse), args.Error(1)
+}
+
+func (m *MockMonitoringService) Unassign(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
+
+	return args.Error(0)
+}
+
+func (m *MockMonitoringService) FindEvaluations(ctx context.Context, reportID string, token string) ([]dto.ReportEvaluationResponse, error) {
+	args := m.Called(ctx, reportID, token)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.ReportEvaluationResponse), args.Error(1)
+}
+
+func (m *MockMonitoringService) Evaluate(ctx context.Context, reportID string, request dto.ReportEvaluationRequest, token string) (dto.ReportEvaluationResponse, error) {
+	args := m.Called(ctx, reportID, request, token)
+
+	return args.Get(0).(dto.ReportEvaluationResponse), args.Error(1)
+}
+
+func (m *MockMonitoringService) FindVisits(ctx context.Context, registrationID string, token string) ([]dto.MonitoringVisitResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.MonitoringVisitResponse), args.Error(1)
+}
+
+func (m *MockMonitoringService) RecordVisit(ctx context.Context, registrationID string, request dto.MonitoringVisitRequest, token string) (dto.MonitoringVisitResponse, error) {
+	args := m.Called(ctx, registrationID, request, token)
+
+	return args.Get(0).(dto.MonitoringVisitResponse), args.Error(1)
+}
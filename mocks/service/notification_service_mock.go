@@ -0,0 +1,48 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockNotificationService struct {
+	mock.Mock
+}
+
+func NewMockNotificationService() *MockNotificationService {
+	return &MockNotificationService{}
+}
+
+func (m *MockNotificationService) Enqueue(ctx context.Context, tx *gorm.DB, notifications ...dto.NotificationRequest) error {
+	args := m.Called(ctx, tx, notifications)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationService) Start(ctx context.Context) {
+	m.Called(ctx)
+}
+
+func (m *MockNotificationService) DeliverDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationService) FindFailed(ctx context.Context, pagReq dto.PaginationRequest) ([]dto.NotificationResponse, dto.PaginationResponse, error) {
+	args := m.Called(ctx, pagReq)
+
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(dto.PaginationResponse), args.Error(2)
+	}
+	return args.Get(0).([]dto.NotificationResponse), args.Get(1).(dto.PaginationResponse), args.Error(2)
+}
+
+func (m *MockNotificationService) Resend(ctx context.Context, id string) (dto.NotificationResponse, error) {
+	args := m.Called(ctx, id)
+
+	return args.Get(0).(dto.NotificationResponse), args.Error(1)
+}
@@ -0,0 +1,61 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockNotificationTemplateService struct {
+	mock.Mock
+}
+
+func NewMockNotificationTemplateService() *MockNotificationTemplateService {
+	return &MockNotificationTemplateService{}
+}
+
+func (m *MockNotificationTemplateService) Render(ctx context.Context, notification dto.NotificationRequest, data dto.NotificationTemplateData) (dto.NotificationRequest, error) {
+	args := m.Called(ctx, notification, data)
+
+	return args.Get(0).(dto.NotificationRequest), args.Error(1)
+}
+
+func (m *MockNotificationTemplateService) Index(ctx context.Context) ([]dto.NotificationTemplateResponse, error) {
+	args := m.Called(ctx)
+
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]dto.NotificationTemplateResponse), args.Error(1)
+}
+
+func (m *MockNotificationTemplateService) Create(ctx context.Context, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error) {
+	args := m.Called(ctx, request)
+
+	return args.Get(0).(dto.NotificationTemplateResponse), args.Error(1)
+}
+
+func (m *MockNotificationTemplateService) Update(ctx context.Context, id string, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error) {
+	args := m.Called(ctx, id, request)
+
+	return args.Get(0).(dto.NotificationTemplateResponse), args.Error(1)
+}
+
+func (m *MockNotificationTemplateService) Destroy(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockNotificationTemplateService) GetLocale(ctx context.Context, token string) (dto.UserLocaleResponse, error) {
+	args := m.Called(ctx, token)
+
+	return args.Get(0).(dto.UserLocaleResponse), args.Error(1)
+}
+
+func (m *MockNotificationTemplateService) UpdateLocale(ctx context.Context, token string, request dto.UserLocaleRequest) (dto.UserLocaleResponse, error) {
+	args := m.Called(ctx, token, request)
+
+	return args.Get(0).(dto.UserLocaleResponse), args.Error(1)
+}
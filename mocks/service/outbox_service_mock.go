@@ -0,0 +1,42 @@
+package service_mock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type MockOutboxService struct {
+	mock.Mock
+}
+
+func NewMockOutboxService() *MockOutboxService {
+	return &MockOutboxService{}
+}
+
+// Transaction runs fn without a transaction unless the expectation returns an error
+func (m *MockOutboxService) Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	args := m.Called(ctx, fn)
+
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(nil)
+}
+
+func (m *MockOutboxService) Record(ctx context.Context, tx *gorm.DB, eventType string, aggregateType string, aggregateID string, data interface{}) error {
+	args := m.Called(ctx, tx, eventType, aggregateType, aggregateID, data)
+
+	return args.Error(0)
+}
+
+func (m *MockOutboxService) Start(ctx context.Context) {
+	m.Called(ctx)
+}
+
+func (m *MockOutboxService) PublishPending(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,28 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProgressService struct {
+	mock.Mock
+}
+
+func NewMockProgressService() *MockProgressService {
+	return &MockProgressService{}
+}
+
+func (m *MockProgressService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationProgressResponse, error) {
+	args := m.Called(ctx, registrationID, token)
+
+	return args.Get(0).(dto.RegistrationProgressResponse), args.Error(1)
+}
+
+func (m *MockProgressService) FindAdvisorDashboard(ctx context.Context, token string, pagReq dto.PaginationRequest, sortBy string) (dto.AdvisorDashboardResponse, dto.PaginationResponse, error) {
+	args := m.Called(ctx, token, pagReq, sortBy)
+
+	return args.Get(0).(dto.AdvisorDashboardResponse), args.Get(1).(dto.PaginationResponse), args.Error(2)
+}
@@ -1,6 +1,12 @@
 package service_mock
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/service"
+
+	"github.com/stretchr/testify/mock"
+)
 
 type MockRegistrationService struct {
 	mock.Mock
@@ -10,8 +16,14 @@ func NewMockRegistrationService() *MockRegistrationService {
 	return &MockRegistrationService{}
 }
 
-func (m *MockRegistrationService) GetRegistrationByID(method string, id string, token string) map[string]interface{} {
-	args := m.Called(method, id, token)
+func (m *MockRegistrationService) GetRegistrationByID(ctx context.Context, method string, id string, token string) (dto.Registration, error) {
+	args := m.Called(ctx, method, id, token)
+
+	return args.Get(0).(dto.Registration), args.Error(1)
+}
+
+func (m *MockRegistrationService) GetRegistrationsByIDs(ctx context.Context, method string, ids []string, token string) (service.RegistrationLookup, error) {
+	args := m.Called(ctx, method, ids, token)
 
-	return args.Get(0).(map[string]interface{})
+	return args.Get(0).(service.RegistrationLookup), args.Error(1)
 }
@@ -39,18 +39,12 @@ func (m *MockReportScheduleService) Destroy(ctx context.Context, id string, toke
 	return args.Error(0)
 }
 
-func (m *MockReportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string) ([]dto.ReportScheduleResponse, error) {
-	args := m.Called(ctx, registrationID)
+func (m *MockReportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.ReportScheduleResponse, error) {
+	args := m.Called(ctx, registrationID, token)
 
 	return args.Get(0).([]dto.ReportScheduleResponse), args.Error(1)
 }
 
-func (m *MockReportScheduleService) ReportScheduleAccess(ctx context.Context, reportSchedule dto.ReportScheduleRequest, token string) (bool, error) {
-	args := m.Called(ctx, reportSchedule, token)
-
-	return args.Get(0).(bool), args.Error(1)
-}
-
 func (m *MockReportScheduleService) FindByUserID(ctx context.Context, token string) ([]dto.ReportScheduleResponse, error) {
 	args := m.Called(ctx, token)
 
@@ -63,8 +57,20 @@ func (m *MockReportScheduleService) FindByAdvisorEmail(ctx context.Context, toke
 	return args.Get(0).(dto.ReportScheduleByAdvisorResponse), args.Get(1).(dto.PaginationResponse), args.Error(2)
 }
 
+func (m *MockReportScheduleService) FindByMonitorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
+	args := m.Called(ctx, token, pagReq, reportScheduleRequest)
+
+	return args.Get(0).(dto.ReportScheduleByAdvisorResponse), args.Get(1).(dto.PaginationResponse), args.Error(2)
+}
+
 func (m *MockReportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.ReportScheduleByStudentResponse, error) {
 	args := m.Called(ctx, token)
 
 	return args.Get(0).(dto.ReportScheduleByStudentResponse), args.Error(1)
 }
+
+func (m *MockReportScheduleService) Generate(ctx context.Context, registrationID string, request dto.ReportScheduleGenerateRequest, token string) (dto.ReportScheduleGenerateResponse, error) {
+	args := m.Called(ctx, registrationID, request, token)
+
+	return args.Get(0).(dto.ReportScheduleGenerateResponse), args.Error(1)
+}
@@ -28,10 +28,10 @@ func (m *MockReportService) Create(ctx context.Context, report dto.ReportRequest
 	return args.Get(0).(dto.ReportResponse), args.Error(1)
 }
 
-func (m *MockReportService) Update(ctx context.Context, id string, report dto.ReportRequest) (dto.ReportResponse, error) {
-	args := m.Called(ctx, id, report)
+func (m *MockReportService) Update(ctx context.Context, id string, report dto.ReportRequest, token string) error {
+	args := m.Called(ctx, id, report, token)
 
-	return args.Get(0).(dto.ReportResponse), args.Error(1)
+	return args.Error(0)
 }
 
 func (m *MockReportService) FindByID(ctx context.Context, id string, token string) (dto.ReportResponse, error) {
@@ -40,14 +40,14 @@ func (m *MockReportService) FindByID(ctx context.Context, id string, token strin
 	return args.Get(0).(dto.ReportResponse), args.Error(1)
 }
 
-func (m *MockReportService) Destroy(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
+func (m *MockReportService) Destroy(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
 
 	return args.Error(0)
 }
 
-func (m *MockReportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string) ([]dto.ReportResponse, error) {
-	args := m.Called(ctx, reportScheduleID)
+func (m *MockReportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string, token string) ([]dto.ReportResponse, error) {
+	args := m.Called(ctx, reportScheduleID, token)
 
 	return args.Get(0).([]dto.ReportResponse), args.Error(1)
 }
@@ -57,3 +57,15 @@ func (m *MockReportService) Approval(ctx context.Context, token string, report d
 
 	return args.Error(0)
 }
+
+func (m *MockReportService) FindRevisions(ctx context.Context, reportID string, token string) ([]dto.ReportRevisionResponse, error) {
+	args := m.Called(ctx, reportID, token)
+
+	return args.Get(0).([]dto.ReportRevisionResponse), args.Error(1)
+}
+
+func (m *MockReportService) DiffRevisions(ctx context.Context, reportID string, fromRevision int, toRevision int, token string) (dto.ReportRevisionDiffResponse, error) {
+	args := m.Called(ctx, reportID, fromRevision, toRevision, token)
+
+	return args.Get(0).(dto.ReportRevisionDiffResponse), args.Error(1)
+}
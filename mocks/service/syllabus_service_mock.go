@@ -28,8 +28,8 @@ func (m *MockSyllabusService) Create(ctx context.Context, syllabus dto.SyllabusR
 	return args.Get(0).(dto.SyllabusResponse), args.Error(1)
 }
 
-func (m *MockSyllabusService) Update(ctx context.Context, id string, syllabus dto.SyllabusRequest) error {
-	args := m.Called(ctx, id, syllabus)
+func (m *MockSyllabusService) Update(ctx context.Context, id string, syllabus dto.SyllabusRequest, token string) error {
+	args := m.Called(ctx, id, syllabus, token)
 
 	return args.Error(0)
 }
@@ -40,20 +40,20 @@ func (m *MockSyllabusService) FindByID(ctx context.Context, id string, token str
 	return args.Get(0).(dto.SyllabusResponse), args.Error(1)
 }
 
-func (m *MockSyllabusService) Destroy(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
+func (m *MockSyllabusService) Destroy(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
 
 	return args.Error(0)
 }
 
-func (m *MockSyllabusService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.SyllabusResponse, error) {
-	args := m.Called(ctx, registrationID)
+func (m *MockSyllabusService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.SyllabusResponse, error) {
+	args := m.Called(ctx, registrationID, token)
 
 	return args.Get(0).(dto.SyllabusResponse), args.Error(1)
 }
 
-func (m *MockSyllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.SyllabusResponse, error) {
-	args := m.Called(ctx, registrationID)
+func (m *MockSyllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.SyllabusResponse, error) {
+	args := m.Called(ctx, registrationID, token)
 
 	return args.Get(0).([]dto.SyllabusResponse), args.Error(1)
 }
@@ -0,0 +1,22 @@
+package service_mock
+
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTokenVerifier struct {
+	mock.Mock
+}
+
+func NewMockTokenVerifier() *MockTokenVerifier {
+	return &MockTokenVerifier{}
+}
+
+func (m *MockTokenVerifier) Verify(ctx context.Context, token string) (dto.Principal, error) {
+	args := m.Called(ctx, token)
+
+	return args.Get(0).(dto.Principal), args.Error(1)
+}
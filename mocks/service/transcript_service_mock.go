@@ -28,8 +28,8 @@ func (m *MockTranscriptService) Create(ctx context.Context, transcript dto.Trans
 	return args.Get(0).(dto.TranscriptResponse), args.Error(1)
 }
 
-func (m *MockTranscriptService) Update(ctx context.Context, id string, transcript dto.TranscriptRequest) error {
-	args := m.Called(ctx, id, transcript)
+func (m *MockTranscriptService) Update(ctx context.Context, id string, transcript dto.TranscriptRequest, token string) error {
+	args := m.Called(ctx, id, transcript, token)
 
 	return args.Error(0)
 }
@@ -40,20 +40,20 @@ func (m *MockTranscriptService) FindByID(ctx context.Context, id string, token s
 	return args.Get(0).(dto.TranscriptResponse), args.Error(1)
 }
 
-func (m *MockTranscriptService) Destroy(ctx context.Context, id string) error {
-	args := m.Called(ctx, id)
+func (m *MockTranscriptService) Destroy(ctx context.Context, id string, token string) error {
+	args := m.Called(ctx, id, token)
 
 	return args.Error(0)
 }
 
-func (m *MockTranscriptService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.TranscriptResponse, error) {
-	args := m.Called(ctx, registrationID)
+func (m *MockTranscriptService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.TranscriptResponse, error) {
+	args := m.Called(ctx, registrationID, token)
 
 	return args.Get(0).(dto.TranscriptResponse), args.Error(1)
 }
 
-func (m *MockTranscriptService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.TranscriptResponse, error) {
-	args := m.Called(ctx, registrationID)
+func (m *MockTranscriptService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.TranscriptResponse, error) {
+	args := m.Called(ctx, registrationID, token)
 
 	return args.Get(0).([]dto.TranscriptResponse), args.Error(1)
 }
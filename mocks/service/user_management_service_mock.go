@@ -1,6 +1,11 @@
 package service_mock
 
-import "github.com/stretchr/testify/mock"
+import (
+	"context"
+	"monitoring-service/dto"
+
+	"github.com/stretchr/testify/mock"
+)
 
 type MockUserManagementService struct {
 	mock.Mock
@@ -10,32 +15,46 @@ func NewMockUserManagementService() *MockUserManagementService {
 	return &MockUserManagementService{}
 }
 
-func (m *MockUserManagementService) GetUserByID(method string, token string, id string) map[string]interface{} {
-	args := m.Called(method, token, id)
+func (m *MockUserManagementService) CurrentUser(ctx context.Context, token string) (dto.User, error) {
+	args := m.Called(ctx, token)
+
+	return args.Get(0).(dto.User), args.Error(1)
+}
+
+func (m *MockUserManagementService) GetUserByID(ctx context.Context, method string, token string, id string) (dto.User, error) {
+	args := m.Called(ctx, method, token, id)
 
-	return args.Get(0).(map[string]interface{})
+	return args.Get(0).(dto.User), args.Error(1)
 }
 
-func (m *MockUserManagementService) GetUserData(method string, token string) map[string]interface{} {
-	args := m.Called(method, token)
+func (m *MockUserManagementService) GetUserData(ctx context.Context, method string, token string) (dto.User, error) {
+	args := m.Called(ctx, method, token)
 
-	return args.Get(0).(map[string]interface{})
+	return args.Get(0).(dto.User), args.Error(1)
 }
 
-func (m *MockUserManagementService) GetUserByFilter(data map[string]interface{}, method string, token string) []map[string]interface{} {
-	args := m.Called(data, method, token)
+func (m *MockUserManagementService) GetUserByFilter(ctx context.Context, data map[string]interface{}, method string, token string) ([]dto.User, error) {
+	args := m.Called(ctx, data, method, token)
 
-	return args.Get(0).([]map[string]interface{})
+	return args.Get(0).([]dto.User), args.Error(1)
 }
 
-func (m *MockUserManagementService) GetUserRole(method string, token string) map[string]interface{} {
-	args := m.Called(method, token)
+func (m *MockUserManagementService) GetUserRole(ctx context.Context, method string, token string) (string, error) {
+	args := m.Called(ctx, method, token)
 
-	return args.Get(0).(map[string]interface{})
+	return args.String(0), args.Error(1)
 }
 
-func (m *MockUserManagementService) GetDosenDataByEmail(data map[string]interface{}, method string, token string) map[string]interface{} {
-	args := m.Called(data, method, token)
+func (m *MockUserManagementService) GetDosenDataByEmail(ctx context.Context, email string, method string, token string) (dto.Dosen, error) {
+	args := m.Called(ctx, email, method, token)
+
+	return args.Get(0).(dto.Dosen), args.Error(1)
+}
+
+func (m *MockUserManagementService) InvalidateUser(id string) {
+	m.Called(id)
+}
 
-	return args.Get(0).(map[string]interface{})
+func (m *MockUserManagementService) InvalidateEmail(email string) {
+	m.Called(email)
 }
@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type advisorAssignmentRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+// AdvisorDelegationFilter narrows delegations to those of some advisors, running at ActiveAt
+type AdvisorDelegationFilter struct {
+	// Email keeps the delegations the advisor gave or received
+	Email string
+	// DelegatorEmails keeps the delegations given by one of these advisors
+	DelegatorEmails []string
+	ActiveAt        *time.Time
+}
+
+type AdvisorAssignmentRepository interface {
+	FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.AdvisorAssignment, error)
+	FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorAssignment, error)
+	FindByRegistrationIDAndAdvisorEmail(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) (entity.AdvisorAssignment, error)
+	Create(ctx context.Context, assignment entity.AdvisorAssignment, tx *gorm.DB) (entity.AdvisorAssignment, error)
+	Destroy(ctx context.Context, id string, tx *gorm.DB) error
+	FindDelegations(ctx context.Context, filter AdvisorDelegationFilter, tx *gorm.DB) ([]entity.AdvisorDelegation, error)
+	FindDelegationByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorDelegation, error)
+	CreateDelegation(ctx context.Context, delegation entity.AdvisorDelegation, tx *gorm.DB) (entity.AdvisorDelegation, error)
+	DestroyDelegation(ctx context.Context, id string, tx *gorm.DB) error
+}
+
+func NewAdvisorAssignmentRepository(db *gorm.DB) AdvisorAssignmentRepository {
+	return &advisorAssignmentRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// actingForQuery selects @advisor_email and every advisor who delegated their reviews to it at @now
+const actingForQuery = `SELECT CAST(@advisor_email AS VARCHAR)
+	UNION SELECT ad.delegator_email FROM advisor_delegations ad
+	WHERE ad.delegate_email = @advisor_email
+	AND ad.starts_at <= @now AND ad.ends_at > @now
+	AND ad.deleted_at IS NULL`
+
+// advisedBy is the condition matching the rows, of a table with registration_id and
+// academic_advisor_email columns, that @advisor_email advises: as the advisor named on the row or
+// as an assigned advisor of the registration. prefix qualifies the columns, e.g. "s." for a table
+// aliased s.
+func advisedBy(prefix string) string {
+	return advisorCondition(prefix, "CAST(@advisor_email AS VARCHAR)")
+}
+
+// reviewedBy is advisedBy widened to the rows @advisor_email reviews at @now on behalf of an
+// advisor who delegated to it. Delegates only review reports, so only report listings use it.
+func reviewedBy(prefix string) string {
+	return advisorCondition(prefix, actingForQuery)
+}
+
+func advisorCondition(prefix string, advisorEmails string) string {
+	return fmt.Sprintf(`(%[1]sacademic_advisor_email IN (%[2]s)
+		OR %[1]sregistration_id IN (
+			SELECT aa.registration_id FROM advisor_assignments aa
+			WHERE aa.advisor_email IN (%[2]s)
+			AND aa.deleted_at IS NULL
+		))`, prefix, advisorEmails)
+}
+
+// advisedByParams are the named parameters of advisedBy and reviewedBy
+func advisedByParams(advisorEmail string, now time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"advisor_email": advisorEmail,
+		"now":           now,
+	}
+}
+
+// savePrimaryAdvisor makes advisorEmail the primary advisor of a registration in tx, replacing the
+// previous primary advisor. Secondary advisors are kept, and one of them may become the primary.
+func savePrimaryAdvisor(tx *gorm.DB, registrationID string, advisorID string, advisorEmail string, now time.Time) error {
+	err := tx.Debug().
+		Unscoped().
+		Where("registration_id = ? AND role = ? AND advisor_email <> ?", registrationID, helper.ADVISOR_ROLE_PRIMARY, advisorEmail).
+		Delete(&entity.AdvisorAssignment{}).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Debug().
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "registration_id"}, {Name: "advisor_email"}},
+			DoUpdates: clause.AssignmentColumns([]string{"advisor_id", "role", "updated_at", "deleted_at"}),
+		}).
+		Create(&entity.AdvisorAssignment{
+			ID:             uuid.New(),
+			RegistrationID: registrationID,
+			AdvisorID:      advisorID,
+			AdvisorEmail:   advisorEmail,
+			Role:           helper.ADVISOR_ROLE_PRIMARY,
+			BaseModel: entity.BaseModel{
+				CreatedAt: &now,
+				UpdatedAt: &now,
+			},
+		}).Error
+}
+
+// FindByRegistrationID returns the advisors assigned to a registration, the primary one first
+func (r *advisorAssignmentRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.AdvisorAssignment, error) {
+	var assignments []entity.AdvisorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("registration_id = ?", registrationID).
+		Order("CASE role WHEN '" + helper.ADVISOR_ROLE_PRIMARY + "' THEN 0 ELSE 1 END, created_at ASC").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *advisorAssignmentRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	var assignment entity.AdvisorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("id = ?", id).Take(&assignment).Error
+	if err != nil {
+		return entity.AdvisorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// FindByRegistrationIDAndAdvisorEmail returns gorm.ErrRecordNotFound when the advisor is not assigned to the registration
+func (r *advisorAssignmentRepository) FindByRegistrationIDAndAdvisorEmail(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	var assignment entity.AdvisorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("registration_id = ? AND advisor_email = ?", registrationID, advisorEmail).
+		Take(&assignment).Error
+	if err != nil {
+		return entity.AdvisorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+func (r *advisorAssignmentRepository) Create(ctx context.Context, assignment entity.AdvisorAssignment, tx *gorm.DB) (entity.AdvisorAssignment, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&assignment).Error
+	})
+	if err != nil {
+		return entity.AdvisorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// Destroy removes an assignment for good, so the advisor can be assigned to the registration anew
+func (r *advisorAssignmentRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Unscoped().Where("id = ?", id).Delete(&entity.AdvisorAssignment{}).Error
+	})
+}
+
+// FindDelegations returns the delegations matching filter, the latest starting first
+func (r *advisorAssignmentRepository) FindDelegations(ctx context.Context, filter AdvisorDelegationFilter, tx *gorm.DB) ([]entity.AdvisorDelegation, error) {
+	var delegations []entity.AdvisorDelegation
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	query := tx.Debug().Model(&entity.AdvisorDelegation{})
+	if filter.Email != "" {
+		query = query.Where("(delegator_email = ? OR delegate_email = ?)", filter.Email, filter.Email)
+	}
+	if filter.DelegatorEmails != nil {
+		if len(filter.DelegatorEmails) == 0 {
+			return []entity.AdvisorDelegation{}, nil
+		}
+		query = query.Where("delegator_email IN ?", filter.DelegatorEmails)
+	}
+	if filter.ActiveAt != nil {
+		query = query.Where("starts_at <= ? AND ends_at > ?", *filter.ActiveAt, *filter.ActiveAt)
+	}
+
+	err := query.Order("starts_at DESC").Find(&delegations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return delegations, nil
+}
+
+func (r *advisorAssignmentRepository) FindDelegationByID(ctx context.Context, id string, tx *gorm.DB) (entity.AdvisorDelegation, error) {
+	var delegation entity.AdvisorDelegation
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("id = ?", id).Take(&delegation).Error
+	if err != nil {
+		return entity.AdvisorDelegation{}, err
+	}
+
+	return delegation, nil
+}
+
+func (r *advisorAssignmentRepository) CreateDelegation(ctx context.Context, delegation entity.AdvisorDelegation, tx *gorm.DB) (entity.AdvisorDelegation, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&delegation).Error
+	})
+	if err != nil {
+		return entity.AdvisorDelegation{}, err
+	}
+
+	return delegation, nil
+}
+
+// DestroyDelegation revokes a delegation, it is kept soft deleted for the record
+func (r *advisorAssignmentRepository) DestroyDelegation(ctx context.Context, id string, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Where("id = ?", id).Delete(&entity.AdvisorDelegation{}).Error
+	})
+}
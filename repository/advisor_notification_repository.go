@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type advisorNotificationRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type AdvisorNotificationRepository interface {
+	FindPreference(ctx context.Context, advisorEmail string, tx *gorm.DB) (entity.AdvisorNotificationPreference, error)
+	SavePreference(ctx context.Context, preference entity.AdvisorNotificationPreference, tx *gorm.DB) error
+	CreateDigestEntry(ctx context.Context, entry entity.AdvisorDigestEntry, tx *gorm.DB) error
+	FindUndigestedEntries(ctx context.Context, tx *gorm.DB) ([]entity.AdvisorDigestEntry, error)
+	MarkDigested(ctx context.Context, ids []string, digestedAt time.Time, tx *gorm.DB) error
+}
+
+func NewAdvisorNotificationRepository(db *gorm.DB) AdvisorNotificationRepository {
+	return &advisorNotificationRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// FindPreference returns gorm.ErrRecordNotFound when the advisor never chose a preference
+func (r *advisorNotificationRepository) FindPreference(ctx context.Context, advisorEmail string, tx *gorm.DB) (entity.AdvisorNotificationPreference, error) {
+	var preference entity.AdvisorNotificationPreference
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("advisor_email = ?", advisorEmail).Take(&preference).Error
+	if err != nil {
+		return entity.AdvisorNotificationPreference{}, err
+	}
+
+	return preference, nil
+}
+
+func (r *advisorNotificationRepository) SavePreference(ctx context.Context, preference entity.AdvisorNotificationPreference, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "advisor_email"}},
+				DoUpdates: clause.AssignmentColumns([]string{"daily_digest", "updated_at", "deleted_at"}),
+			}).
+			Create(&preference).Error
+	})
+}
+
+func (r *advisorNotificationRepository) CreateDigestEntry(ctx context.Context, entry entity.AdvisorDigestEntry, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&entry).Error
+	})
+}
+
+// FindUndigestedEntries returns the entries of every advisor not digested yet, grouped by advisor and
+// oldest first. Inside a transaction they stay locked so replicas never digest the same entry twice.
+func (r *advisorNotificationRepository) FindUndigestedEntries(ctx context.Context, tx *gorm.DB) ([]entity.AdvisorDigestEntry, error) {
+	var entries []entity.AdvisorDigestEntry
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("digested_at IS NULL").
+		Order("advisor_email ASC, created_at ASC").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *advisorNotificationRepository) MarkDigested(ctx context.Context, ids []string, digestedAt time.Time, tx *gorm.DB) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.AdvisorDigestEntry{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"digested_at": digestedAt,
+				"updated_at":  digestedAt,
+			}).Error
+	})
+}
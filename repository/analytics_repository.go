@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type analyticsRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+// AnalyticsFilter narrows analytics to schedules due in [From, To) of one activity or advisor
+type AnalyticsFilter struct {
+	From         *time.Time
+	To           *time.Time
+	ActivityName string
+	AdvisorEmail string
+}
+
+// WeeklySubmissionRate counts the due weekly schedules of one week and how many were submitted
+type WeeklySubmissionRate struct {
+	Week      int
+	Due       int64
+	Submitted int64
+	OnTime    int64
+	Late      int64
+}
+
+// ApprovalLatency describes the time between report creation and the advisor decision, in seconds
+type ApprovalLatency struct {
+	Reviewed       int64
+	AverageSeconds float64
+	MedianSeconds  float64
+	P90Seconds     float64
+	MaxSeconds     float64
+}
+
+// AdvisorBacklog is the number of reports waiting for one advisor's review
+type AdvisorBacklog struct {
+	AcademicAdvisorEmail string
+	PendingReviews       int64
+	OldestPendingAt      *time.Time
+}
+
+// ActivityCompliance counts the due schedules of one activity and how many were submitted on time
+type ActivityCompliance struct {
+	ActivityName string
+	Due          int64
+	OnTime       int64
+	Late         int64
+	Missing      int64
+}
+
+type AnalyticsRepository interface {
+	SubmissionRateByWeek(ctx context.Context, filter AnalyticsFilter, now time.Time, tx *gorm.DB) ([]WeeklySubmissionRate, error)
+	ApprovalLatency(ctx context.Context, filter AnalyticsFilter, tx *gorm.DB) (ApprovalLatency, error)
+	AdvisorBacklog(ctx context.Context, filter AnalyticsFilter, limit int, tx *gorm.DB) ([]AdvisorBacklog, error)
+	LowestActivityCompliance(ctx context.Context, filter AnalyticsFilter, now time.Time, limit int, tx *gorm.DB) ([]ActivityCompliance, error)
+}
+
+func NewAnalyticsRepository(db *gorm.DB) AnalyticsRepository {
+	return &analyticsRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// analyticsConditions turns the filter into a WHERE clause over report_schedules columns
+// prefixed with alias, together with its named parameters
+func analyticsConditions(alias string, filter AnalyticsFilter) (string, map[string]interface{}) {
+	conditions := []string{alias + "deleted_at IS NULL"}
+	params := map[string]interface{}{}
+
+	if filter.From != nil {
+		conditions = append(conditions, alias+"end_date >= @from")
+		params["from"] = *filter.From
+	}
+	if filter.To != nil {
+		conditions = append(conditions, alias+"end_date < @to")
+		params["to"] = *filter.To
+	}
+	if filter.ActivityName != "" {
+		conditions = append(conditions, alias+"activity_name = @activity_name")
+		params["activity_name"] = filter.ActivityName
+	}
+	if filter.AdvisorEmail != "" {
+		conditions = append(conditions, alias+"academic_advisor_email = @advisor_email")
+		params["advisor_email"] = filter.AdvisorEmail
+	}
+
+	return strings.Join(conditions, " AND "), params
+}
+
+// latestReportsCTE selects the latest submitted report of every schedule matched by the filter
+func latestReportsCTE(filter AnalyticsFilter) string {
+	conditions, _ := analyticsConditions("", filter)
+	return "WITH latest AS (" + fmt.Sprintf(latestSubmittedReportsQuery, conditions) + ")"
+}
+
+// SubmissionRateByWeek counts, per week, the weekly schedules whose deadline has passed and how they were submitted
+func (r *analyticsRepository) SubmissionRateByWeek(ctx context.Context, filter AnalyticsFilter, now time.Time, tx *gorm.DB) ([]WeeklySubmissionRate, error) {
+	var rates []WeeklySubmissionRate
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	conditions, params := analyticsConditions("s.", filter)
+	params["now"] = now
+
+	err := tx.Debug().Raw(latestReportsCTE(filter)+`
+		SELECT
+			s.week,
+			COUNT(*) AS due,
+			COUNT(l.report_schedule_id) AS submitted,
+			COUNT(*) FILTER (WHERE l.report_schedule_id IS NOT NULL AND NOT l.is_late) AS on_time,
+			COUNT(*) FILTER (WHERE l.is_late) AS late
+		FROM report_schedules s
+		LEFT JOIN latest l ON l.report_schedule_id = CAST(s.id AS TEXT)
+		WHERE `+conditions+`
+		AND s.report_type = 'WEEKLY_REPORT'
+		AND s.end_date < @now
+		GROUP BY s.week
+		ORDER BY s.week ASC
+	`, params).Scan(&rates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+// ApprovalLatency measures the time from report creation to the latest advisor decision
+func (r *analyticsRepository) ApprovalLatency(ctx context.Context, filter AnalyticsFilter, tx *gorm.DB) (ApprovalLatency, error) {
+	var latency ApprovalLatency
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	conditions, params := analyticsConditions("s.", filter)
+
+	err := tx.Debug().Raw(`
+		WITH reviewed AS (
+			SELECT EXTRACT(EPOCH FROM r.reviewed_at - r.created_at) AS seconds
+			FROM reports r
+			JOIN report_schedules s ON CAST(s.id AS TEXT) = r.report_schedule_id
+			WHERE `+conditions+`
+			AND r.deleted_at IS NULL
+			AND r.reviewed_at IS NOT NULL
+		)
+		SELECT
+			COUNT(*) AS reviewed,
+			COALESCE(AVG(seconds), 0) AS average_seconds,
+			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY seconds), 0) AS median_seconds,
+			COALESCE(PERCENTILE_CONT(0.9) WITHIN GROUP (ORDER BY seconds), 0) AS p90_seconds,
+			COALESCE(MAX(seconds), 0) AS max_seconds
+		FROM reviewed
+	`, params).Scan(&latency).Error
+	if err != nil {
+		return ApprovalLatency{}, err
+	}
+
+	return latency, nil
+}
+
+// AdvisorBacklog returns the advisors with the most reports waiting for review, oldest first on ties
+func (r *analyticsRepository) AdvisorBacklog(ctx context.Context, filter AnalyticsFilter, limit int, tx *gorm.DB) ([]AdvisorBacklog, error) {
+	var backlogs []AdvisorBacklog
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	conditions, params := analyticsConditions("s.", filter)
+	params["limit"] = limit
+
+	err := tx.Debug().Raw(latestReportsCTE(filter)+`
+		SELECT
+			s.academic_advisor_email,
+			COUNT(*) AS pending_reviews,
+			MIN(COALESCE(l.submitted_at, l.created_at)) AS oldest_pending_at
+		FROM report_schedules s
+		JOIN latest l ON l.report_schedule_id = CAST(s.id AS TEXT)
+		WHERE `+conditions+`
+		AND l.academic_advisor_status IN ('PENDING', 'RESUBMITTED')
+		GROUP BY s.academic_advisor_email
+		ORDER BY pending_reviews DESC, oldest_pending_at ASC
+		LIMIT @limit
+	`, params).Scan(&backlogs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return backlogs, nil
+}
+
+// LowestActivityCompliance returns the activities with the lowest share of due schedules submitted on time
+func (r *analyticsRepository) LowestActivityCompliance(ctx context.Context, filter AnalyticsFilter, now time.Time, limit int, tx *gorm.DB) ([]ActivityCompliance, error) {
+	var compliances []ActivityCompliance
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	conditions, params := analyticsConditions("s.", filter)
+	params["now"] = now
+	params["limit"] = limit
+
+	err := tx.Debug().Raw(latestReportsCTE(filter)+`
+		SELECT
+			s.activity_name,
+			COUNT(*) AS due,
+			COUNT(*) FILTER (WHERE l.report_schedule_id IS NOT NULL AND NOT l.is_late) AS on_time,
+			COUNT(*) FILTER (WHERE l.is_late) AS late,
+			COUNT(*) FILTER (WHERE l.report_schedule_id IS NULL) AS missing
+		FROM report_schedules s
+		LEFT JOIN latest l ON l.report_schedule_id = CAST(s.id AS TEXT)
+		WHERE `+conditions+`
+		AND s.end_date < @now
+		GROUP BY s.activity_name
+		ORDER BY CAST(COUNT(*) FILTER (WHERE l.report_schedule_id IS NOT NULL AND NOT l.is_late) AS FLOAT) / COUNT(*) ASC, due DESC
+		LIMIT @limit
+	`, params).Scan(&compliances).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return compliances, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type auditEventRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+// AuditEventFilter narrows the audit trail to one resource or actor in [From, To)
+type AuditEventFilter struct {
+	ResourceType string
+	ResourceID   string
+	ActorID      string
+	ActorEmail   string
+	Action       string
+	From         *time.Time
+	To           *time.Time
+}
+
+type AuditEventRepository interface {
+	Create(ctx context.Context, auditEvents []entity.AuditEvent, tx *gorm.DB) error
+	FindAll(ctx context.Context, filter AuditEventFilter, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.AuditEvent, int64, error)
+}
+
+func NewAuditEventRepository(db *gorm.DB) AuditEventRepository {
+	return &auditEventRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create stores audit events in the caller's transaction, so a change and its audit trail are committed together
+func (r *auditEventRepository) Create(ctx context.Context, auditEvents []entity.AuditEvent, tx *gorm.DB) error {
+	if len(auditEvents) == 0 {
+		return nil
+	}
+
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.AuditEvent{}).Create(&auditEvents).Error
+	})
+}
+
+// FindAll returns a page of the audit events matching filter, most recent first
+func (r *auditEventRepository) FindAll(ctx context.Context, filter AuditEventFilter, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.AuditEvent, int64, error) {
+	var auditEvents []entity.AuditEvent
+	var total int64
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	query := tx.Debug().Model(&entity.AuditEvent{})
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.ActorEmail != "" {
+		query = query.Where("actor_email = ?", filter.ActorEmail)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("occurred_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("occurred_at < ?", *filter.To)
+	}
+
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if pagReq != nil {
+		query = query.Offset(pagReq.Offset).Limit(pagReq.Limit)
+	}
+
+	err = query.Order("occurred_at DESC").Find(&auditEvents).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return auditEvents, total, nil
+}
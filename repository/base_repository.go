@@ -14,6 +14,7 @@ type BaseRepository interface {
 	BeginTx(ctx context.Context) (*gorm.DB, error)
 	CommitTx(ctx context.Context, tx *gorm.DB) (*gorm.DB, error)
 	RollbackTx(ctx context.Context, tx *gorm.DB)
+	WithinTx(ctx context.Context, tx *gorm.DB, fn func(tx *gorm.DB) error) error
 }
 
 func NewBaseRepository(db *gorm.DB) BaseRepository {
@@ -42,3 +43,13 @@ func (r *baseRepository) CommitTx(ctx context.Context, tx *gorm.DB) (*gorm.DB, e
 func (r *baseRepository) RollbackTx(ctx context.Context, tx *gorm.DB) {
 	tx.WithContext(ctx).Debug().Rollback()
 }
+
+// WithinTx runs fn in a transaction that is committed when fn succeeds. When the caller passes its own
+// transaction, fn runs in a savepoint of it so the caller's commit or rollback decides the outcome.
+func (r *baseRepository) WithinTx(ctx context.Context, tx *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	return tx.Transaction(fn)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type dossierRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type DossierRepository interface {
+	FindReportSchedulesByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.ReportSchedule, error)
+	FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) (entity.RegistrationDossier, error)
+	Save(ctx context.Context, dossier entity.RegistrationDossier, tx *gorm.DB) (entity.RegistrationDossier, error)
+}
+
+func NewDossierRepository(db *gorm.DB) DossierRepository {
+	return &dossierRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// FindReportSchedulesByRegistrationID returns the schedules of a registration in week order with all of their reports, newest first
+func (r *dossierRepository) FindReportSchedulesByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.ReportSchedule, error) {
+	var reportSchedules []entity.ReportSchedule
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Model(&entity.ReportSchedule{}).
+		Preload("Report", func(db *gorm.DB) *gorm.DB {
+			return db.Where("deleted_at IS NULL").Order("created_at DESC")
+		}).
+		Where("registration_id = ?", registrationID).
+		Where("deleted_at IS NULL").
+		Order("report_type DESC, week ASC").
+		Find(&reportSchedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reportSchedules, nil
+}
+
+func (r *dossierRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) (entity.RegistrationDossier, error) {
+	var dossier entity.RegistrationDossier
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Model(&entity.RegistrationDossier{}).
+		Where("registration_id = ?", registrationID).
+		Where("deleted_at IS NULL").
+		First(&dossier).Error
+	if err != nil {
+		return entity.RegistrationDossier{}, err
+	}
+
+	return dossier, nil
+}
+
+// Save stores the dossier of a registration, replacing the previously generated one
+func (r *dossierRepository) Save(ctx context.Context, dossier entity.RegistrationDossier, tx *gorm.DB) (entity.RegistrationDossier, error) {
+	tx, err := r.baseRepository.BeginTx(ctx)
+	if err != nil {
+		return entity.RegistrationDossier{}, err
+	}
+
+	defer func() {
+		if err != nil {
+			r.baseRepository.RollbackTx(ctx, tx)
+		} else {
+			_, err = r.baseRepository.CommitTx(ctx, tx)
+		}
+	}()
+
+	err = tx.Debug().
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "registration_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"file_storage_id", "generated_by_id", "generated_by_email", "generated_at", "updated_at", "deleted_at"}),
+		}).
+		Create(&dossier).Error
+	if err != nil {
+		return entity.RegistrationDossier{}, err
+	}
+
+	// the row keeps its original ID when an existing dossier was replaced
+	err = tx.Debug().
+		Model(&entity.RegistrationDossier{}).
+		Where("registration_id = ?", dossier.RegistrationID).
+		First(&dossier).Error
+	if err != nil {
+		return entity.RegistrationDossier{}, err
+	}
+
+	return dossier, nil
+}
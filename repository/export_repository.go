@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type exportRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+// ExportFilter narrows an export the same way the report schedule list endpoints do. AdvisedBy
+// scopes it to the registrations an advisor advises, as the primary or a co-advisor.
+type ExportFilter struct {
+	AdvisedBy        string
+	AdvisorEmail     string
+	UserNRP          string
+	ActivityName     string
+	SubmissionStatus string
+}
+
+// ExportScheduleRow is one report schedule together with its latest report, if any
+type ExportScheduleRow struct {
+	ReportScheduleID      string
+	UserID                string
+	UserNRP               string
+	UserName              string
+	RegistrationID        string
+	ActivityName          string
+	AcademicAdvisorEmail  string
+	ReportType            string
+	Week                  int
+	StartDate             *time.Time
+	EndDate               *time.Time
+	ReportID              *string
+	Title                 *string
+	AcademicAdvisorStatus *string
+	Feedback              *string
+	SubmittedAt           *time.Time
+	ReviewedAt            *time.Time
+	IsLate                *bool
+}
+
+// ExportDocumentRow is the syllabus and transcript upload state of one registration
+type ExportDocumentRow struct {
+	RegistrationID       string
+	UserID               string
+	UserNRP              string
+	UserName             string
+	ActivityName         string
+	AcademicAdvisorEmail string
+	SyllabusUploaded     bool
+	TranscriptUploaded   bool
+}
+
+type ExportRepository interface {
+	StreamReportSchedules(ctx context.Context, filter ExportFilter, fn func(ExportScheduleRow) error, tx *gorm.DB) error
+	StreamReports(ctx context.Context, filter ExportFilter, fn func(ExportScheduleRow) error, tx *gorm.DB) error
+	StreamDocuments(ctx context.Context, filter ExportFilter, fn func(ExportDocumentRow) error, tx *gorm.DB) error
+	FindUnnamedUserIDs(ctx context.Context, filter ExportFilter, tx *gorm.DB) ([]string, error)
+}
+
+func NewExportRepository(db *gorm.DB) ExportRepository {
+	return &exportRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// exportScheduleQuery applies the export filter to a report_schedules query, with the registration
+// snapshot of each schedule joined in for the student's name
+func exportScheduleQuery(tx *gorm.DB, filter ExportFilter) *gorm.DB {
+	query := tx.Table("report_schedules").
+		Joins("LEFT JOIN registration_snapshots ON registration_snapshots.registration_id = report_schedules.registration_id AND registration_snapshots.deleted_at IS NULL").
+		Where("report_schedules.deleted_at IS NULL")
+
+	if filter.AdvisedBy != "" {
+		query = query.Where(advisedBy("report_schedules."), advisedByParams(filter.AdvisedBy, time.Now()))
+	}
+	if filter.AdvisorEmail != "" {
+		query = query.Where(advisedBy("report_schedules."), advisedByParams(filter.AdvisorEmail, time.Now()))
+	}
+	if filter.UserNRP != "" {
+		query = query.Where("report_schedules.user_nrp = ?", filter.UserNRP)
+	}
+	if filter.ActivityName != "" {
+		query = query.Where("report_schedules.activity_name = ?", filter.ActivityName)
+	}
+
+	return filterBySubmissionStatus(query, filter.SubmissionStatus)
+}
+
+const exportScheduleColumns = `report_schedules.id AS report_schedule_id,
+	report_schedules.user_id,
+	report_schedules.user_nrp,
+	COALESCE(registration_snapshots.user_name, '') AS user_name,
+	report_schedules.registration_id,
+	report_schedules.activity_name,
+	report_schedules.academic_advisor_email,
+	report_schedules.report_type,
+	report_schedules.week,
+	report_schedules.start_date,
+	report_schedules.end_date`
+
+const exportReportColumns = `reports.id AS report_id,
+	reports.title,
+	reports.academic_advisor_status,
+	reports.feedback,
+	reports.submitted_at,
+	reports.reviewed_at,
+	reports.is_late`
+
+// streamRows scans the rows of query one by one so an export never holds the full result in memory
+func streamRows[T any](query *gorm.DB, fn func(T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		err = query.ScanRows(rows, &row)
+		if err != nil {
+			return err
+		}
+
+		err = fn(row)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// StreamReportSchedules walks every matching schedule with its latest report, ordered by student and week
+func (r *exportRepository) StreamReportSchedules(ctx context.Context, filter ExportFilter, fn func(ExportScheduleRow) error, tx *gorm.DB) error {
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	query := exportScheduleQuery(tx.Debug(), filter).
+		Select(exportScheduleColumns + ", " + exportReportColumns).
+		Joins(`LEFT JOIN LATERAL (
+			SELECT * FROM reports
+			WHERE reports.report_schedule_id = CAST(report_schedules.id AS TEXT)
+			AND reports.deleted_at IS NULL
+			ORDER BY reports.created_at DESC
+			LIMIT 1
+		) reports ON TRUE`).
+		Order("report_schedules.user_nrp ASC, report_schedules.registration_id ASC, report_schedules.week ASC")
+
+	return streamRows(query, fn)
+}
+
+// StreamReports walks every report of the matching schedules, oldest first within a schedule
+func (r *exportRepository) StreamReports(ctx context.Context, filter ExportFilter, fn func(ExportScheduleRow) error, tx *gorm.DB) error {
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	query := exportScheduleQuery(tx.Debug(), filter).
+		Select(exportScheduleColumns + ", " + exportReportColumns).
+		Joins("JOIN reports ON reports.report_schedule_id = CAST(report_schedules.id AS TEXT) AND reports.deleted_at IS NULL").
+		Order("report_schedules.user_nrp ASC, report_schedules.registration_id ASC, report_schedules.week ASC, reports.created_at ASC")
+
+	return streamRows(query, fn)
+}
+
+// StreamDocuments walks every registration that has report schedules with its syllabus and transcript upload state
+func (r *exportRepository) StreamDocuments(ctx context.Context, filter ExportFilter, fn func(ExportDocumentRow) error, tx *gorm.DB) error {
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	syllabuses := tx.Model(&entity.Syllabus{}).
+		Select("1").
+		Where("registration_id = report_schedules.registration_id").
+		Where("deleted_at IS NULL")
+	transcripts := tx.Model(&entity.Transcript{}).
+		Select("1").
+		Where("registration_id = report_schedules.registration_id").
+		Where("deleted_at IS NULL")
+
+	query := exportScheduleQuery(tx.Debug(), filter).
+		Select(`report_schedules.registration_id,
+			MAX(report_schedules.user_id) AS user_id,
+			MAX(report_schedules.user_nrp) AS user_nrp,
+			COALESCE(MAX(registration_snapshots.user_name), '') AS user_name,
+			MAX(report_schedules.activity_name) AS activity_name,
+			MAX(report_schedules.academic_advisor_email) AS academic_advisor_email,
+			EXISTS (?) AS syllabus_uploaded,
+			EXISTS (?) AS transcript_uploaded`, syllabuses, transcripts).
+		Group("report_schedules.registration_id").
+		Order("user_nrp ASC, report_schedules.registration_id ASC")
+
+	return streamRows(query, fn)
+}
+
+// FindUnnamedUserIDs lists the students of the matching schedules whose name is not in a registration snapshot
+func (r *exportRepository) FindUnnamedUserIDs(ctx context.Context, filter ExportFilter, tx *gorm.DB) ([]string, error) {
+	var userIDs []string
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := exportScheduleQuery(tx.Debug(), filter).
+		Where("COALESCE(registration_snapshots.user_name, '') = ''").
+		Distinct("report_schedules.user_id").
+		Pluck("report_schedules.user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
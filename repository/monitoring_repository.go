@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"monitoring-service/entity"
+
+	"gorm.io/gorm"
+)
+
+type monitoringRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type MonitoringRepository interface {
+	FindAssignmentsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitorAssignment, error)
+	FindAssignmentByID(ctx context.Context, id string, tx *gorm.DB) (entity.MonitorAssignment, error)
+	FindAssignmentByRegistrationIDAndMonitorEmail(ctx context.Context, registrationID string, monitorEmail string, tx *gorm.DB) (entity.MonitorAssignment, error)
+	CreateAssignment(ctx context.Context, assignment entity.MonitorAssignment, tx *gorm.DB) (entity.MonitorAssignment, error)
+	DestroyAssignment(ctx context.Context, id string, tx *gorm.DB) error
+	FindEvaluationsByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportEvaluation, error)
+	CreateEvaluation(ctx context.Context, evaluation entity.ReportEvaluation, tx *gorm.DB) (entity.ReportEvaluation, error)
+	FindVisitsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitoringVisit, error)
+	CreateVisit(ctx context.Context, visit entity.MonitoringVisit, tx *gorm.DB) (entity.MonitoringVisit, error)
+}
+
+func NewMonitoringRepository(db *gorm.DB) MonitoringRepository {
+	return &monitoringRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// monitoredBy is the condition matching the rows, of a table with a registration_id column, of the
+// registrations @monitor_email is assigned to monitor. prefix qualifies the column, e.g. "s." for a
+// table aliased s.
+func monitoredBy(prefix string) string {
+	return fmt.Sprintf(`%sregistration_id IN (
+		SELECT ma.registration_id FROM monitor_assignments ma
+		WHERE ma.monitor_email = @monitor_email
+		AND ma.deleted_at IS NULL
+	)`, prefix)
+}
+
+// monitoredByParams are the named parameters of monitoredBy
+func monitoredByParams(monitorEmail string) map[string]interface{} {
+	return map[string]interface{}{
+		"monitor_email": monitorEmail,
+	}
+}
+
+// FindAssignmentsByRegistrationID returns the lecturers monitoring a registration, the first assigned first
+func (r *monitoringRepository) FindAssignmentsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitorAssignment, error) {
+	var assignments []entity.MonitorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("registration_id = ?", registrationID).
+		Order("created_at ASC").
+		Find(&assignments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *monitoringRepository) FindAssignmentByID(ctx context.Context, id string, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	var assignment entity.MonitorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("id = ?", id).Take(&assignment).Error
+	if err != nil {
+		return entity.MonitorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// FindAssignmentByRegistrationIDAndMonitorEmail returns gorm.ErrRecordNotFound when the lecturer does not monitor the registration
+func (r *monitoringRepository) FindAssignmentByRegistrationIDAndMonitorEmail(ctx context.Context, registrationID string, monitorEmail string, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	var assignment entity.MonitorAssignment
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("registration_id = ? AND monitor_email = ?", registrationID, monitorEmail).
+		Take(&assignment).Error
+	if err != nil {
+		return entity.MonitorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+func (r *monitoringRepository) CreateAssignment(ctx context.Context, assignment entity.MonitorAssignment, tx *gorm.DB) (entity.MonitorAssignment, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&assignment).Error
+	})
+	if err != nil {
+		return entity.MonitorAssignment{}, err
+	}
+
+	return assignment, nil
+}
+
+// DestroyAssignment removes an assignment for good, so the lecturer can be assigned to the registration anew
+func (r *monitoringRepository) DestroyAssignment(ctx context.Context, id string, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Unscoped().Where("id = ?", id).Delete(&entity.MonitorAssignment{}).Error
+	})
+}
+
+// FindEvaluationsByReportID returns the evaluation notes left on a report, oldest first
+func (r *monitoringRepository) FindEvaluationsByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportEvaluation, error) {
+	var evaluations []entity.ReportEvaluation
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("report_id = ?", reportID).
+		Order("created_at ASC").
+		Find(&evaluations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluations, nil
+}
+
+func (r *monitoringRepository) CreateEvaluation(ctx context.Context, evaluation entity.ReportEvaluation, tx *gorm.DB) (entity.ReportEvaluation, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&evaluation).Error
+	})
+	if err != nil {
+		return entity.ReportEvaluation{}, err
+	}
+
+	return evaluation, nil
+}
+
+// FindVisitsByRegistrationID returns the monitoring visits of a registration, the latest first
+func (r *monitoringRepository) FindVisitsByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.MonitoringVisit, error) {
+	var visits []entity.MonitoringVisit
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("registration_id = ?", registrationID).
+		Order("visited_at DESC").
+		Find(&visits).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return visits, nil
+}
+
+func (r *monitoringRepository) CreateVisit(ctx context.Context, visit entity.MonitoringVisit, tx *gorm.DB) (entity.MonitoringVisit, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&visit).Error
+	})
+	if err != nil {
+		return entity.MonitoringVisit{}, err
+	}
+
+	return visit, nil
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notifications []entity.Notification, tx *gorm.DB) error
+	FindDue(ctx context.Context, now time.Time, limit int, tx *gorm.DB) ([]entity.Notification, error)
+	Claim(ctx context.Context, ids []string, until time.Time, tx *gorm.DB) error
+	RecordAttempt(ctx context.Context, notification entity.Notification, attempt entity.NotificationAttempt, tx *gorm.DB) error
+	FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Notification, error)
+	FindByStatus(ctx context.Context, status string, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.Notification, int64, error)
+	FindAttempts(ctx context.Context, notificationIDs []string, tx *gorm.DB) (map[string][]entity.NotificationAttempt, error)
+	Resend(ctx context.Context, id string, now time.Time, tx *gorm.DB) (bool, error)
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create queues notifications in the caller's transaction, so they are only sent if the change they announce is committed
+func (r *notificationRepository) Create(ctx context.Context, notifications []entity.Notification, tx *gorm.DB) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Notification{}).Create(&notifications).Error
+	})
+}
+
+// FindDue returns up to limit pending notifications whose next attempt is due, oldest first. Inside a
+// transaction the rows stay locked until it ends and rows locked by another replica are skipped.
+func (r *notificationRepository) FindDue(ctx context.Context, now time.Time, limit int, tx *gorm.DB) ([]entity.Notification, error) {
+	var notifications []entity.Notification
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("status = ?", helper.NOTIFICATION_STATUS_PENDING).
+		Where("next_attempt_at <= ?", now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// Claim pushes the next attempt of the notifications to until, so other workers skip them while they are sent
+func (r *notificationRepository) Claim(ctx context.Context, ids []string, until time.Time, tx *gorm.DB) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.Notification{}).
+			Where("id IN ?", ids).
+			Updates(map[string]interface{}{
+				"next_attempt_at": until,
+				"updated_at":      time.Now(),
+			}).Error
+	})
+}
+
+// RecordAttempt stores the state of a notification after a delivery attempt together with the attempt itself
+func (r *notificationRepository) RecordAttempt(ctx context.Context, notification entity.Notification, attempt entity.NotificationAttempt, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		err := tx.Debug().
+			Model(&entity.Notification{}).
+			Where("id = ?", notification.ID).
+			Updates(map[string]interface{}{
+				"status":          notification.Status,
+				"attempts":        notification.Attempts,
+				"next_attempt_at": notification.NextAttemptAt,
+				"last_error":      notification.LastError,
+				"sent_at":         notification.SentAt,
+				"updated_at":      notification.UpdatedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Debug().Create(&attempt).Error
+	})
+}
+
+func (r *notificationRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Notification, error) {
+	var notification entity.Notification
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("id = ?", id).Take(&notification).Error
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	return notification, nil
+}
+
+// FindByStatus returns a page of notifications with the given status, most recently updated first
+func (r *notificationRepository) FindByStatus(ctx context.Context, status string, pagReq *dto.PaginationRequest, tx *gorm.DB) ([]entity.Notification, int64, error) {
+	var notifications []entity.Notification
+	var total int64
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	query := tx.Debug().Model(&entity.Notification{}).Where("status = ?", status)
+
+	err := query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if pagReq != nil {
+		query = query.Offset(pagReq.Offset).Limit(pagReq.Limit)
+	}
+
+	err = query.Order("updated_at DESC").Find(&notifications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return notifications, total, nil
+}
+
+// FindAttempts returns the attempts of each notification in the order they were made
+func (r *notificationRepository) FindAttempts(ctx context.Context, notificationIDs []string, tx *gorm.DB) (map[string][]entity.NotificationAttempt, error) {
+	attempts := make(map[string][]entity.NotificationAttempt)
+	if len(notificationIDs) == 0 {
+		return attempts, nil
+	}
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	var rows []entity.NotificationAttempt
+	err := tx.Debug().
+		Where("notification_id IN ?", notificationIDs).
+		Order("attempted_at ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attempt := range rows {
+		attempts[attempt.NotificationID] = append(attempts[attempt.NotificationID], attempt)
+	}
+
+	return attempts, nil
+}
+
+// Resend queues a failed notification again with a fresh attempt budget. It returns false when
+// the notification is not failed, so a notification is never queued twice by concurrent re-sends.
+func (r *notificationRepository) Resend(ctx context.Context, id string, now time.Time, tx *gorm.DB) (bool, error) {
+	var resent bool
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		result := tx.Debug().
+			Model(&entity.Notification{}).
+			Where("id = ?", id).
+			Where("status = ?", helper.NOTIFICATION_STATUS_FAILED).
+			Updates(map[string]interface{}{
+				"status":          helper.NOTIFICATION_STATUS_PENDING,
+				"attempts":        0,
+				"next_attempt_at": now,
+				"updated_at":      now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		resent = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resent, nil
+}
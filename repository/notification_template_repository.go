@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type notificationTemplateRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type NotificationTemplateRepository interface {
+	Index(ctx context.Context, tx *gorm.DB) ([]entity.NotificationTemplate, error)
+	FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.NotificationTemplate, error)
+	FindByTypeAndLocale(ctx context.Context, notificationType string, locale string, tx *gorm.DB) (entity.NotificationTemplate, error)
+	Create(ctx context.Context, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) (entity.NotificationTemplate, error)
+	Update(ctx context.Context, id string, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) error
+	Destroy(ctx context.Context, id string, tx *gorm.DB) error
+	FindLocale(ctx context.Context, userEmail string, tx *gorm.DB) (entity.UserLocalePreference, error)
+	SaveLocale(ctx context.Context, preference entity.UserLocalePreference, tx *gorm.DB) error
+}
+
+func NewNotificationTemplateRepository(db *gorm.DB) NotificationTemplateRepository {
+	return &notificationTemplateRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+func (r *notificationTemplateRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity.NotificationTemplate, error) {
+	var notificationTemplates []entity.NotificationTemplate
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Order("type ASC, locale ASC").Find(&notificationTemplates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notificationTemplates, nil
+}
+
+func (r *notificationTemplateRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	var notificationTemplate entity.NotificationTemplate
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("id = ?", id).Take(&notificationTemplate).Error
+	if err != nil {
+		return entity.NotificationTemplate{}, err
+	}
+
+	return notificationTemplate, nil
+}
+
+// FindByTypeAndLocale returns gorm.ErrRecordNotFound when the embedded template is not overridden
+func (r *notificationTemplateRepository) FindByTypeAndLocale(ctx context.Context, notificationType string, locale string, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	var notificationTemplate entity.NotificationTemplate
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Where("type = ? AND locale = ?", notificationType, locale).
+		Take(&notificationTemplate).Error
+	if err != nil {
+		return entity.NotificationTemplate{}, err
+	}
+
+	return notificationTemplate, nil
+}
+
+func (r *notificationTemplateRepository) Create(ctx context.Context, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) (entity.NotificationTemplate, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Create(&notificationTemplate).Error
+	})
+	if err != nil {
+		return entity.NotificationTemplate{}, err
+	}
+
+	return notificationTemplate, nil
+}
+
+func (r *notificationTemplateRepository) Update(ctx context.Context, id string, notificationTemplate entity.NotificationTemplate, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.NotificationTemplate{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"subject":    notificationTemplate.Subject,
+				"body":       notificationTemplate.Body,
+				"updated_at": notificationTemplate.UpdatedAt,
+			}).Error
+	})
+}
+
+// Destroy removes an override for good, so the embedded template applies again and the
+// (type, locale) pair can be overridden anew
+func (r *notificationTemplateRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Unscoped().Where("id = ?", id).Delete(&entity.NotificationTemplate{}).Error
+	})
+}
+
+// FindLocale returns gorm.ErrRecordNotFound when the user never chose a locale
+func (r *notificationTemplateRepository) FindLocale(ctx context.Context, userEmail string, tx *gorm.DB) (entity.UserLocalePreference, error) {
+	var preference entity.UserLocalePreference
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Where("user_email = ?", userEmail).Take(&preference).Error
+	if err != nil {
+		return entity.UserLocalePreference{}, err
+	}
+
+	return preference, nil
+}
+
+func (r *notificationTemplateRepository) SaveLocale(ctx context.Context, preference entity.UserLocalePreference, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_email"}},
+				DoUpdates: clause.AssignmentColumns([]string{"locale", "updated_at", "deleted_at"}),
+			}).
+			Create(&preference).Error
+	})
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type outboxRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type OutboxRepository interface {
+	Create(ctx context.Context, outboxEvents []entity.OutboxEvent, tx *gorm.DB) error
+	PublishPending(ctx context.Context, limit int, publish func(outboxEvent entity.OutboxEvent) error) (int, error)
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create stores events in the caller's transaction, so they only exist if the change they describe is committed
+func (r *outboxRepository) Create(ctx context.Context, outboxEvents []entity.OutboxEvent, tx *gorm.DB) error {
+	if len(outboxEvents) == 0 {
+		return nil
+	}
+
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.OutboxEvent{}).Create(&outboxEvents).Error
+	})
+}
+
+// PublishPending hands up to limit unpublished events to publish in the order they occurred and marks
+// the published ones. Rows are claimed with SKIP LOCKED so replicas never publish the same event
+// concurrently. It stops at the first failure to keep the order: the attempt and its error are
+// recorded on the event and the publish error is returned once that record is committed.
+func (r *outboxRepository) PublishPending(ctx context.Context, limit int, publish func(outboxEvent entity.OutboxEvent) error) (int, error) {
+	published := 0
+	var publishErr error
+	err := r.baseRepository.WithinTx(ctx, nil, func(tx *gorm.DB) error {
+		var outboxEvents []entity.OutboxEvent
+		err := tx.Debug().
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").
+			Order("occurred_at ASC").
+			Limit(limit).
+			Find(&outboxEvents).Error
+		if err != nil {
+			return err
+		}
+
+		for _, outboxEvent := range outboxEvents {
+			if err := publish(outboxEvent); err != nil {
+				publishErr = fmt.Errorf("publishing outbox event %s: %w", outboxEvent.ID, err)
+				return tx.Debug().
+					Model(&entity.OutboxEvent{}).
+					Where("id = ?", outboxEvent.ID).
+					Updates(map[string]interface{}{
+						"attempts":   gorm.Expr("attempts + 1"),
+						"last_error": err.Error(),
+						"updated_at": time.Now(),
+					}).Error
+			}
+
+			now := time.Now()
+			err = tx.Debug().
+				Model(&entity.OutboxEvent{}).
+				Where("id = ?", outboxEvent.ID).
+				Updates(map[string]interface{}{
+					"published_at": now,
+					"attempts":     gorm.Expr("attempts + 1"),
+					"last_error":   "",
+					"updated_at":   now,
+				}).Error
+			if err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, publishErr
+}
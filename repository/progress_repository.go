@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type progressRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+// RegistrationProgress is the aggregated state of the report schedules of one registration
+type RegistrationProgress struct {
+	RegistrationID       string
+	UserID               string
+	UserNRP              string
+	AcademicAdvisorEmail string
+	Total                int64
+	Approved             int64
+	Pending              int64
+	RevisionRequested    int64
+	Rejected             int64
+	Missing              int64
+	Late                 int64
+	NextDueWeek          *int
+	NextDueDate          *time.Time
+}
+
+// StudentProgress is the aggregated review state of one advisee across all of their registrations
+type StudentProgress struct {
+	UserID          string
+	UserNRP         string
+	Registrations   int64
+	PendingReviews  int64
+	OverdueWeeks    int64
+	LastSubmittedAt *time.Time
+}
+
+// AdvisorProgressTotals sums the review state of every advisee of one advisor
+type AdvisorProgressTotals struct {
+	Students       int64
+	PendingReviews int64
+	OverdueWeeks   int64
+}
+
+type ProgressRepository interface {
+	SummarizeByRegistrationID(ctx context.Context, registrationID string, now time.Time, tx *gorm.DB) (RegistrationProgress, error)
+	SummarizeByAdvisorEmail(ctx context.Context, advisorEmail string, sortBy string, pagReq *dto.PaginationRequest, now time.Time, tx *gorm.DB) ([]StudentProgress, error)
+	TotalsByAdvisorEmail(ctx context.Context, advisorEmail string, now time.Time, tx *gorm.DB) (AdvisorProgressTotals, error)
+}
+
+func NewProgressRepository(db *gorm.DB) ProgressRepository {
+	return &progressRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// latestSubmittedReportsQuery selects the latest non-draft report of every schedule matched by the given filter
+const latestSubmittedReportsQuery = `SELECT DISTINCT ON (report_schedule_id)
+		report_schedule_id, academic_advisor_status, is_late, submitted_at, created_at, updated_at
+	FROM reports
+	WHERE deleted_at IS NULL
+	AND academic_advisor_status <> 'DRAFT'
+	AND report_schedule_id IN (SELECT CAST(id AS TEXT) FROM report_schedules WHERE deleted_at IS NULL AND %s)
+	ORDER BY report_schedule_id, created_at DESC`
+
+// SummarizeByRegistrationID counts the schedules of a registration by the status of their latest report in a single query
+func (r *progressRepository) SummarizeByRegistrationID(ctx context.Context, registrationID string, now time.Time, tx *gorm.DB) (RegistrationProgress, error) {
+	var progress RegistrationProgress
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Raw(`
+		WITH latest AS (`+fmt.Sprintf(latestSubmittedReportsQuery, "registration_id = @registration_id")+`)
+		SELECT
+			s.registration_id,
+			MAX(s.user_id) AS user_id,
+			MAX(s.user_nrp) AS user_nrp,
+			MAX(s.academic_advisor_email) AS academic_advisor_email,
+			COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE l.academic_advisor_status = 'APPROVED') AS approved,
+			COUNT(*) FILTER (WHERE l.academic_advisor_status IN ('PENDING', 'RESUBMITTED')) AS pending,
+			COUNT(*) FILTER (WHERE l.academic_advisor_status = 'REVISION_REQUESTED') AS revision_requested,
+			COUNT(*) FILTER (WHERE l.academic_advisor_status = 'REJECTED') AS rejected,
+			COUNT(*) FILTER (WHERE l.report_schedule_id IS NULL AND s.end_date < @now) AS missing,
+			COUNT(*) FILTER (WHERE l.is_late) AS late,
+			(ARRAY_AGG(s.week ORDER BY s.end_date) FILTER (WHERE l.report_schedule_id IS NULL AND s.end_date >= @now))[1] AS next_due_week,
+			MIN(s.end_date) FILTER (WHERE l.report_schedule_id IS NULL AND s.end_date >= @now) AS next_due_date
+		FROM report_schedules s
+		LEFT JOIN latest l ON l.report_schedule_id = CAST(s.id AS TEXT)
+		WHERE s.registration_id = @registration_id
+		AND s.deleted_at IS NULL
+		GROUP BY s.registration_id
+	`, map[string]interface{}{
+		"registration_id": registrationID,
+		"now":             now,
+	}).Scan(&progress).Error
+	if err != nil {
+		return RegistrationProgress{}, err
+	}
+
+	if progress.Total == 0 {
+		return RegistrationProgress{}, gorm.ErrRecordNotFound
+	}
+
+	return progress, nil
+}
+
+// advisorStudentsQuery aggregates the schedules of every advisee of @advisor_email at @now into one row
+// per student, co-advised registrations included
+var advisorStudentsQuery = `WITH latest AS (` + fmt.Sprintf(latestSubmittedReportsQuery, advisedBy("")) + `),
+	students AS (
+		SELECT
+			MAX(s.user_id) AS user_id,
+			s.user_nrp,
+			COUNT(DISTINCT s.registration_id) AS registrations,
+			COUNT(*) FILTER (WHERE l.academic_advisor_status IN ('PENDING', 'RESUBMITTED')) AS pending_reviews,
+			COUNT(*) FILTER (WHERE l.report_schedule_id IS NULL AND s.end_date < @now) AS overdue_weeks,
+			MAX(COALESCE(l.submitted_at, l.created_at)) AS last_submitted_at
+		FROM report_schedules s
+		LEFT JOIN latest l ON l.report_schedule_id = CAST(s.id AS TEXT)
+		WHERE ` + advisedBy("s.") + `
+		AND s.deleted_at IS NULL
+		GROUP BY s.user_nrp
+	)`
+
+// advisorStudentsOrder maps a dashboard sort key to its ORDER BY clause, user_nrp keeps pages stable
+var advisorStudentsOrder = map[string]string{
+	"":                            "user_nrp ASC",
+	helper.DASHBOARD_SORT_PENDING: "pending_reviews DESC, overdue_weeks DESC, user_nrp ASC",
+	helper.DASHBOARD_SORT_OVERDUE: "overdue_weeks DESC, pending_reviews DESC, user_nrp ASC",
+}
+
+// SummarizeByAdvisorEmail returns one page of advisees with their pending reviews, overdue weeks and last submission
+func (r *progressRepository) SummarizeByAdvisorEmail(ctx context.Context, advisorEmail string, sortBy string, pagReq *dto.PaginationRequest, now time.Time, tx *gorm.DB) ([]StudentProgress, error) {
+	var students []StudentProgress
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	order, ok := advisorStudentsOrder[sortBy]
+	if !ok {
+		return nil, helper.ErrInvalidDashboardSort
+	}
+
+	params := map[string]interface{}{
+		"advisor_email": advisorEmail,
+		"now":           now,
+	}
+
+	query := advisorStudentsQuery + " SELECT * FROM students ORDER BY " + order
+	if pagReq != nil {
+		query += " LIMIT @limit OFFSET @offset"
+		params["limit"] = pagReq.Limit
+		params["offset"] = pagReq.Offset
+	}
+
+	err := tx.Debug().Raw(query, params).Scan(&students).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return students, nil
+}
+
+// TotalsByAdvisorEmail sums pending reviews and overdue weeks over all advisees of an advisor
+func (r *progressRepository) TotalsByAdvisorEmail(ctx context.Context, advisorEmail string, now time.Time, tx *gorm.DB) (AdvisorProgressTotals, error) {
+	var totals AdvisorProgressTotals
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().Raw(advisorStudentsQuery+`
+		SELECT
+			COUNT(*) AS students,
+			COALESCE(SUM(pending_reviews), 0) AS pending_reviews,
+			COALESCE(SUM(overdue_weeks), 0) AS overdue_weeks
+		FROM students
+	`, map[string]interface{}{
+		"advisor_email": advisorEmail,
+		"now":           now,
+	}).Scan(&totals).Error
+	if err != nil {
+		return AdvisorProgressTotals{}, err
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type registrationSnapshotRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type RegistrationSnapshotRepository interface {
+	FindByIDs(ctx context.Context, registrationIDs []string, tx *gorm.DB) (map[string]entity.RegistrationSnapshot, error)
+	Sync(ctx context.Context, snapshot entity.RegistrationSnapshot, tx *gorm.DB) (bool, error)
+	Destroy(ctx context.Context, registrationID string, syncedAt time.Time, tx *gorm.DB) (bool, error)
+	FindUnsyncedIDs(ctx context.Context, after string, limit int, tx *gorm.DB) ([]string, error)
+}
+
+func NewRegistrationSnapshotRepository(db *gorm.DB) RegistrationSnapshotRepository {
+	return &registrationSnapshotRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// FindByIDs returns the snapshots of the given registrations keyed by registration ID, skipping unknown ones
+func (r *registrationSnapshotRepository) FindByIDs(ctx context.Context, registrationIDs []string, tx *gorm.DB) (map[string]entity.RegistrationSnapshot, error) {
+	snapshots := make(map[string]entity.RegistrationSnapshot)
+	if len(registrationIDs) == 0 {
+		return snapshots, nil
+	}
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	var rows []entity.RegistrationSnapshot
+	err := tx.Debug().
+		Where("registration_id IN ?", registrationIDs).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		snapshots[row.RegistrationID] = row
+	}
+
+	return snapshots, nil
+}
+
+// Sync stores a snapshot unless a newer one was already stored, copies its activity onto the schedules
+// and its academic advisor onto the schedules, syllabuses and transcripts of the registration and makes
+// it the primary advisor assignment of the registration. It reports whether the snapshot was applied.
+func (r *registrationSnapshotRepository) Sync(ctx context.Context, snapshot entity.RegistrationSnapshot, tx *gorm.DB) (bool, error) {
+	var applied bool
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		newer, err := r.isNewer(tx, snapshot.RegistrationID, *snapshot.SyncedAt)
+		if err != nil || !newer {
+			return err
+		}
+
+		err = tx.Debug().
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "registration_id"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"user_id",
+					"user_nrp",
+					"user_name",
+					"academic_advisor_id",
+					"academic_advisor_email",
+					"activity_name",
+					"approval_status",
+					"synced_at",
+					"updated_at",
+					"deleted_at",
+				}),
+			}).
+			Create(&snapshot).Error
+		if err != nil {
+			return err
+		}
+		applied = true
+
+		now := time.Now()
+		if snapshot.ActivityName != "" {
+			err = tx.Debug().
+				Model(&entity.ReportSchedule{}).
+				Where("registration_id = ?", snapshot.RegistrationID).
+				Where("activity_name <> ?", snapshot.ActivityName).
+				Updates(map[string]interface{}{
+					"activity_name": snapshot.ActivityName,
+					"updated_at":    now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if snapshot.AcademicAdvisorID == "" && snapshot.AcademicAdvisorEmail == "" {
+			return nil
+		}
+
+		if snapshot.AcademicAdvisorEmail != "" {
+			err = savePrimaryAdvisor(tx, snapshot.RegistrationID, snapshot.AcademicAdvisorID, snapshot.AcademicAdvisorEmail, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		advisor := map[string]interface{}{
+			"academic_advisor_id":    snapshot.AcademicAdvisorID,
+			"academic_advisor_email": snapshot.AcademicAdvisorEmail,
+			"updated_at":             now,
+		}
+		for _, model := range []interface{}{&entity.ReportSchedule{}, &entity.Syllabus{}, &entity.Transcript{}} {
+			err = tx.Debug().
+				Model(model).
+				Where("registration_id = ?", snapshot.RegistrationID).
+				Where("academic_advisor_id <> ? OR academic_advisor_email <> ?", snapshot.AcademicAdvisorID, snapshot.AcademicAdvisorEmail).
+				Updates(advisor).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return applied, nil
+}
+
+// Destroy removes the snapshot of a deleted registration unless it was synced after syncedAt
+func (r *registrationSnapshotRepository) Destroy(ctx context.Context, registrationID string, syncedAt time.Time, tx *gorm.DB) (bool, error) {
+	var deleted bool
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		newer, err := r.isNewer(tx, registrationID, syncedAt)
+		if err != nil || !newer {
+			return err
+		}
+
+		err = tx.Debug().
+			Model(&entity.RegistrationSnapshot{}).
+			Where("registration_id = ?", registrationID).
+			Updates(map[string]interface{}{
+				"synced_at":  syncedAt,
+				"deleted_at": syncedAt,
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		deleted = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// FindUnsyncedIDs returns up to limit registrations, after the given ID in order, that schedules,
+// syllabuses or transcripts refer to but that were never synced
+func (r *registrationSnapshotRepository) FindUnsyncedIDs(ctx context.Context, after string, limit int, tx *gorm.DB) ([]string, error) {
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	var registrationIDs []string
+	err := tx.Debug().Raw(`
+		SELECT referenced.registration_id
+		FROM (
+			SELECT registration_id FROM report_schedules WHERE deleted_at IS NULL
+			UNION SELECT registration_id FROM syllabuses WHERE deleted_at IS NULL
+			UNION SELECT registration_id FROM transcripts WHERE deleted_at IS NULL
+		) referenced
+		WHERE referenced.registration_id > ?
+		  AND NOT EXISTS (
+			SELECT 1 FROM registration_snapshots
+			WHERE registration_snapshots.registration_id = referenced.registration_id
+		  )
+		ORDER BY referenced.registration_id
+		LIMIT ?
+	`, after, limit).Scan(&registrationIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return registrationIDs, nil
+}
+
+// joinRegistrationSnapshot selects the rows of query as listed, with the entity.ListedRegistration of
+// each joined in from the registration snapshots
+func joinRegistrationSnapshot(tx *gorm.DB, query *gorm.DB) *gorm.DB {
+	return tx.Table("(?) AS listed", query).
+		Select(`listed.*,
+			registration_snapshots.registration_id IS NOT NULL AS registration_synced,
+			COALESCE(registration_snapshots.activity_name, '') AS registration_activity_name,
+			COALESCE(registration_snapshots.approval_status, false) AS registration_approved`).
+		Joins("LEFT JOIN registration_snapshots ON registration_snapshots.registration_id = listed.registration_id AND registration_snapshots.deleted_at IS NULL")
+}
+
+// isNewer locks the stored snapshot, deleted or not, and tells whether syncedAt is not older than it
+func (r *registrationSnapshotRepository) isNewer(tx *gorm.DB, registrationID string, syncedAt time.Time) (bool, error) {
+	var current entity.RegistrationSnapshot
+	err := tx.Debug().
+		Unscoped().
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("registration_id = ?", registrationID).
+		First(&current).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return current.SyncedAt == nil || !syncedAt.Before(*current.SyncedAt), nil
+}
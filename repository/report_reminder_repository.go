@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reportReminderRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type ReportReminderRepository interface {
+	WithLock(ctx context.Context, lockKey string, fn func(tx *gorm.DB) error) (bool, error)
+	FindPendingSchedules(ctx context.Context, kind string, from time.Time, to time.Time, tx *gorm.DB) ([]entity.ReportSchedule, error)
+	Create(ctx context.Context, reportReminder entity.ReportReminder, tx *gorm.DB) (bool, error)
+	Destroy(ctx context.Context, id string, tx *gorm.DB) error
+}
+
+func NewReportReminderRepository(db *gorm.DB) ReportReminderRepository {
+	return &reportReminderRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// WithLock runs fn only if no other replica holds the advisory lock, which is released when fn returns.
+// It reports whether the lock was acquired.
+func (r *reportReminderRepository) WithLock(ctx context.Context, lockKey string, fn func(tx *gorm.DB) error) (bool, error) {
+	tx, err := r.baseRepository.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	err = tx.Raw("SELECT pg_try_advisory_xact_lock(hashtext(?))", lockKey).Scan(&acquired).Error
+	if err != nil || !acquired {
+		r.baseRepository.RollbackTx(ctx, tx)
+		return false, err
+	}
+
+	err = fn(tx)
+	if err != nil {
+		r.baseRepository.RollbackTx(ctx, tx)
+		return true, err
+	}
+
+	_, err = r.baseRepository.CommitTx(ctx, tx)
+	return true, err
+}
+
+// FindPendingSchedules returns schedules ending in (from, to] that have no submitted report and no reminder of the given kind yet
+func (r *reportReminderRepository) FindPendingSchedules(ctx context.Context, kind string, from time.Time, to time.Time, tx *gorm.DB) ([]entity.ReportSchedule, error) {
+	var reportSchedules []entity.ReportSchedule
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Model(&entity.ReportSchedule{}).
+		Where("deleted_at IS NULL").
+		Where("end_date > ? AND end_date <= ?", from, to).
+		Where("NOT EXISTS ("+submittedReportQuery+")").
+		Where(`NOT EXISTS (SELECT 1 FROM report_reminders
+			WHERE report_reminders.report_schedule_id = CAST(report_schedules.id AS TEXT)
+			AND report_reminders.kind = ?
+			AND report_reminders.deleted_at IS NULL)`, kind).
+		Order("end_date ASC").
+		Find(&reportSchedules).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reportSchedules, nil
+}
+
+// Create claims a reminder in tx, returning false when the same (schedule, kind) was already claimed
+func (r *reportReminderRepository) Create(ctx context.Context, reportReminder entity.ReportReminder, tx *gorm.DB) (bool, error) {
+	created := false
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		result := tx.Debug().
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&reportReminder)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		created = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return created, nil
+}
+
+// Destroy releases a reminder claim in tx so it is retried on the next run
+func (r *reportReminderRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Unscoped().Where("id = ?", id).Delete(&entity.ReportReminder{}).Error
+	})
+}
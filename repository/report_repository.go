@@ -30,25 +30,9 @@ func NewReportRepository(db *gorm.DB) ReportRepository {
 }
 
 func (r *reportRepository) Approval(ctx context.Context, id string, report entity.Report, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Updates(&report).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Updates(&report).Error
+	})
 }
 
 func (r *reportRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity.Report, error) {
@@ -66,23 +50,9 @@ func (r *reportRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity.Rep
 	return reports, nil
 }
 func (r *reportRepository) Create(ctx context.Context, report entity.Report, tx *gorm.DB) (entity.Report, error) {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return entity.Report{}, err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Report{}).Create(&report).Error
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Report{}).Create(&report).Error
+	})
 	if err != nil {
 		return entity.Report{}, err
 	}
@@ -90,28 +60,9 @@ func (r *reportRepository) Create(ctx context.Context, report entity.Report, tx
 	return report, nil
 }
 func (r *reportRepository) Update(ctx context.Context, id string, report entity.Report, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Updates(&report).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Updates(&report).Error
+	})
 }
 func (r *reportRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Report, error) {
 	var report entity.Report
@@ -128,28 +79,9 @@ func (r *reportRepository) FindByID(ctx context.Context, id string, tx *gorm.DB)
 	return report, nil
 }
 func (r *reportRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Delete(&entity.Report{}).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Report{}).Where("id = ?", id).Delete(&entity.Report{}).Error
+	})
 }
 func (r *reportRepository) FindByReportScheduleID(ctx context.Context, reportScheduleID string, tx *gorm.DB) ([]entity.Report, error) {
 	var reports []entity.Report
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"monitoring-service/entity"
+
+	"gorm.io/gorm"
+)
+
+type reportRevisionRepository struct {
+	db             *gorm.DB
+	baseRepository BaseRepository
+}
+
+type ReportRevisionRepository interface {
+	Create(ctx context.Context, reportRevision entity.ReportRevision, tx *gorm.DB) (entity.ReportRevision, error)
+	FindByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportRevision, error)
+	FindByReportIDAndRevisionNumber(ctx context.Context, reportID string, revisionNumber int, tx *gorm.DB) (entity.ReportRevision, error)
+}
+
+func NewReportRevisionRepository(db *gorm.DB) ReportRevisionRepository {
+	return &reportRevisionRepository{
+		db:             db,
+		baseRepository: NewBaseRepository(db),
+	}
+}
+
+// Create stores a new revision, numbering it after the latest revision of the same report
+func (r *reportRevisionRepository) Create(ctx context.Context, reportRevision entity.ReportRevision, tx *gorm.DB) (entity.ReportRevision, error) {
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		// serialise revision numbering for the same report
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", reportRevision.ReportID).Error
+		if err != nil {
+			return err
+		}
+
+		var latestRevisionNumber int
+		err = tx.Debug().
+			Model(&entity.ReportRevision{}).
+			Where("report_id = ?", reportRevision.ReportID).
+			Select("COALESCE(MAX(revision_number), 0)").
+			Scan(&latestRevisionNumber).Error
+		if err != nil {
+			return err
+		}
+
+		reportRevision.RevisionNumber = latestRevisionNumber + 1
+
+		return tx.Debug().Model(&entity.ReportRevision{}).Create(&reportRevision).Error
+	})
+	if err != nil {
+		return entity.ReportRevision{}, err
+	}
+
+	return reportRevision, nil
+}
+
+func (r *reportRevisionRepository) FindByReportID(ctx context.Context, reportID string, tx *gorm.DB) ([]entity.ReportRevision, error) {
+	var reportRevisions []entity.ReportRevision
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Model(&entity.ReportRevision{}).
+		Where("report_id = ?", reportID).
+		Where("deleted_at IS NULL").
+		Order("revision_number ASC").
+		Find(&reportRevisions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reportRevisions, nil
+}
+
+func (r *reportRevisionRepository) FindByReportIDAndRevisionNumber(ctx context.Context, reportID string, revisionNumber int, tx *gorm.DB) (entity.ReportRevision, error) {
+	var reportRevision entity.ReportRevision
+
+	if tx == nil {
+		tx = r.db.WithContext(ctx)
+	}
+
+	err := tx.Debug().
+		Model(&entity.ReportRevision{}).
+		Where("report_id = ?", reportID).
+		Where("revision_number = ?", revisionNumber).
+		Where("deleted_at IS NULL").
+		First(&reportRevision).Error
+	if err != nil {
+		return entity.ReportRevision{}, err
+	}
+
+	return reportRevision, nil
+}
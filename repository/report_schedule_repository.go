@@ -2,8 +2,11 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"monitoring-service/dto"
 	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -23,7 +26,9 @@ type ReportScheduleReposiotry interface {
 	FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.ReportSchedule, error)
 	FindByUserID(ctx context.Context, userNRP string, tx *gorm.DB) ([]entity.ReportSchedule, error)
 	FindByUserNRPAndGroupByRegistrationID(ctx context.Context, userNRP string, tx *gorm.DB) (map[string][]entity.ReportSchedule, error)
-	FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string) (map[string][]entity.ReportSchedule, int64, error)
+	FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error)
+	FindByMonitorEmailAndGroupByUserID(ctx context.Context, monitorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error)
+	CreateForRegistration(ctx context.Context, registrationID string, reportSchedules []entity.ReportSchedule, tx *gorm.DB) ([]entity.ReportSchedule, []entity.ReportSchedule, error)
 }
 
 func NewReportScheduleRepository(db *gorm.DB) ReportScheduleReposiotry {
@@ -39,11 +44,11 @@ func (r *reportScheduleRepository) FindByUserNRPAndGroupByRegistrationID(ctx con
 	}
 
 	var reportSchedules []entity.ReportSchedule
-	err := tx.Debug().
-		Model(&entity.ReportSchedule{}).
+	query := tx.Model(&entity.ReportSchedule{}).
 		Where("user_nrp = ?", userNRP).
-		Where("deleted_at IS NULL").
-		Order("created_at DESC").
+		Where("deleted_at IS NULL")
+	err := joinRegistrationSnapshot(tx.Debug(), query).
+		Order("listed.created_at DESC").
 		Find(&reportSchedules).Error
 
 	if err != nil {
@@ -168,21 +173,40 @@ func (r *reportScheduleRepository) FindByUserNRPAndGroupByRegistrationID(ctx con
 
 // Gunakan subquery untuk pagination yang efisien
 // Ganti logika pagination
-func (r *reportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string) (map[string][]entity.ReportSchedule, int64, error) {
+func (r *reportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx context.Context, advisorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error) {
 	if tx == nil {
 		tx = r.db
 	}
 
+	// the advisor reviews the reports of the registrations it is named on, assigned to, or covering for
+	return r.groupByUserNRP(tx, reviewedBy(""), advisedByParams(advisorEmail, time.Now()), pagReq, userNrp, submissionStatus)
+}
+
+// FindByMonitorEmailAndGroupByUserID groups the schedules of the registrations monitorEmail monitors
+// the way FindByAdvisorEmailAndGroupByUserID does for an advisor
+func (r *reportScheduleRepository) FindByMonitorEmailAndGroupByUserID(ctx context.Context, monitorEmail string, tx *gorm.DB, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error) {
+	if tx == nil {
+		tx = r.db
+	}
+
+	return r.groupByUserNRP(tx, monitoredBy(""), monitoredByParams(monitorEmail), pagReq, userNrp, submissionStatus)
+}
+
+// groupByUserNRP pages the students having schedules that match condition, and returns their
+// schedules with the latest report of each, grouped by student NRP
+func (r *reportScheduleRepository) groupByUserNRP(tx *gorm.DB, condition string, params map[string]interface{}, pagReq *dto.PaginationRequest, userNrp string, submissionStatus string) (map[string][]entity.ReportSchedule, int64, error) {
 	// 1. Count total unique users
 	var totalCount int64
 	countQuery := tx.Model(&entity.ReportSchedule{}).
-		Where("academic_advisor_email = ?", advisorEmail).
+		Where(condition, params).
 		Where("deleted_at IS NULL")
 
 	if userNrp != "" {
 		countQuery = countQuery.Where("user_nrp = ?", userNrp)
 	}
 
+	countQuery = filterBySubmissionStatus(countQuery, submissionStatus)
+
 	err := countQuery.Distinct("user_nrp").Count(&totalCount).Error
 	if err != nil {
 		return nil, 0, err
@@ -192,13 +216,15 @@ func (r *reportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx contex
 	var paginatedUserNRPs []string
 	userQuery := tx.Model(&entity.ReportSchedule{}).
 		Select("DISTINCT user_nrp").
-		Where("academic_advisor_email = ?", advisorEmail).
+		Where(condition, params).
 		Where("deleted_at IS NULL")
 
 	if userNrp != "" {
 		userQuery = userQuery.Where("user_nrp = ?", userNrp)
 	}
 
+	userQuery = filterBySubmissionStatus(userQuery, submissionStatus)
+
 	if pagReq != nil {
 		userQuery = userQuery.Limit(pagReq.Limit).Offset(pagReq.Offset)
 	}
@@ -214,59 +240,23 @@ func (r *reportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx contex
 
 	// 3. Get report schedules for paginated users
 	var allReportSchedules []entity.ReportSchedule
-	err = tx.Where("academic_advisor_email = ?", advisorEmail).
+	scheduleQuery := tx.Model(&entity.ReportSchedule{}).
+		Where(condition, params).
 		Where("user_nrp IN ?", paginatedUserNRPs).
-		Where("deleted_at IS NULL").
-		Order("user_nrp ASC").
+		Where("deleted_at IS NULL")
+
+	err = joinRegistrationSnapshot(tx, filterBySubmissionStatus(scheduleQuery, submissionStatus)).
+		Order("listed.user_nrp ASC").
 		Find(&allReportSchedules).Error
 
 	if err != nil {
 		return nil, 0, err
 	}
 
-	// 4. Get latest reports for each schedule (FIXED: gunakan kolom yang benar)
-	if len(allReportSchedules) > 0 {
-		scheduleIDs := make([]string, len(allReportSchedules))
-		for i, schedule := range allReportSchedules {
-			scheduleIDs[i] = schedule.ID.String()
-		}
-
-		// Raw query dengan kolom yang sesuai struct Report
-		var latestReports []entity.Report
-		err = tx.Raw(`
-            SELECT DISTINCT ON (report_schedule_id) 
-                   id, 
-                   report_schedule_id, 
-                   title, 
-                   content, 
-                   report_type, 
-                   file_storage_id, 
-                   feedback, 
-                   academic_advisor_status, 
-                   created_at, 
-                   updated_at
-            FROM reports 
-            WHERE deleted_at IS NULL 
-              AND report_schedule_id IN ?
-            ORDER BY report_schedule_id, created_at DESC
-        `, scheduleIDs).Scan(&latestReports).Error
-
-		if err != nil {
-			return nil, 0, err
-		}
-
-		// Map reports to schedules
-		reportMap := make(map[string]entity.Report)
-		for _, report := range latestReports {
-			reportMap[report.ReportScheduleID] = report
-		}
-
-		// Attach reports to schedules
-		for i := range allReportSchedules {
-			if report, exists := reportMap[allReportSchedules[i].ID.String()]; exists {
-				allReportSchedules[i].Report = []entity.Report{report}
-			}
-		}
+	// 4. Get latest reports for each schedule
+	err = attachLatestReports(tx, allReportSchedules)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	// 5. Group by user_nrp
@@ -278,6 +268,80 @@ func (r *reportScheduleRepository) FindByAdvisorEmailAndGroupByUserID(ctx contex
 	return userReportSchedules, totalCount, nil
 }
 
+// attachLatestReports sets the latest report of every schedule as its only Report. A preload with a
+// limit would limit the one batched query, leaving every schedule but one without its report.
+func attachLatestReports(tx *gorm.DB, reportSchedules []entity.ReportSchedule) error {
+	if len(reportSchedules) == 0 {
+		return nil
+	}
+
+	scheduleIDs := make([]string, len(reportSchedules))
+	for i, schedule := range reportSchedules {
+		scheduleIDs[i] = schedule.ID.String()
+	}
+
+	var latestReports []entity.Report
+	err := tx.Debug().Raw(`
+		SELECT DISTINCT ON (report_schedule_id)
+			id,
+			report_schedule_id,
+			title,
+			content,
+			report_type,
+			file_storage_id,
+			feedback,
+			academic_advisor_status,
+			submitted_at,
+			is_late,
+			late_seconds,
+			late_flagged,
+			reviewed_at,
+			created_at,
+			updated_at
+		FROM reports
+		WHERE deleted_at IS NULL
+		AND report_schedule_id IN ?
+		ORDER BY report_schedule_id, created_at DESC
+	`, scheduleIDs).Scan(&latestReports).Error
+	if err != nil {
+		return err
+	}
+
+	reportMap := make(map[string]entity.Report, len(latestReports))
+	for _, report := range latestReports {
+		reportMap[report.ReportScheduleID] = report
+	}
+
+	for i := range reportSchedules {
+		if report, exists := reportMap[reportSchedules[i].ID.String()]; exists {
+			reportSchedules[i].Report = []entity.Report{report}
+		}
+	}
+
+	return nil
+}
+
+// submittedReportQuery matches the non-draft reports of the schedule in the outer query
+const submittedReportQuery = `SELECT 1 FROM reports
+	WHERE reports.report_schedule_id = CAST(report_schedules.id AS TEXT)
+	AND reports.deleted_at IS NULL
+	AND reports.academic_advisor_status <> 'DRAFT'`
+
+// filterBySubmissionStatus narrows a report schedule query to schedules that were submitted late, on time or are missing
+func filterBySubmissionStatus(query *gorm.DB, submissionStatus string) *gorm.DB {
+	switch submissionStatus {
+	case helper.SUBMISSION_STATUS_LATE:
+		return query.Where("EXISTS (" + submittedReportQuery + " AND reports.is_late = TRUE)")
+	case helper.SUBMISSION_STATUS_ON_TIME:
+		return query.Where("EXISTS (" + submittedReportQuery + " AND reports.is_late = FALSE)")
+	case helper.SUBMISSION_STATUS_MISSING:
+		return query.Where("end_date < ?", time.Now()).
+			Where("NOT EXISTS (" + submittedReportQuery + ")")
+	default:
+		return query
+	}
+}
+
 func (r *reportScheduleRepository) FindByUserID(ctx context.Context, userNRP string, tx *gorm.DB) ([]entity.ReportSchedule, error) {
 	var reportSchedules []entity.ReportSchedule
 
@@ -287,9 +351,6 @@ func (r *reportScheduleRepository) FindByUserID(ctx context.Context, userNRP str
 
 	err := tx.Debug().
 		Model(&entity.ReportSchedule{}).
-		Preload("Report", func(db *gorm.DB) *gorm.DB {
-			return db.Order("created_at DESC").Limit(1)
-		}).
 		Where("user_nrp = ?", userNRP).
 		Where("deleted_at IS NULL").
 		Find(&reportSchedules).Error
@@ -298,6 +359,11 @@ func (r *reportScheduleRepository) FindByUserID(ctx context.Context, userNRP str
 		return nil, err
 	}
 
+	err = attachLatestReports(tx, reportSchedules)
+	if err != nil {
+		return nil, err
+	}
+
 	return reportSchedules, nil
 }
 
@@ -311,15 +377,17 @@ func (r *reportScheduleRepository) Index(ctx context.Context, tx *gorm.DB) (map[
 	err := tx.Debug().
 		Model(&entity.ReportSchedule{}).
 		Where("deleted_at IS NULL").
-		Preload("Report", func(db *gorm.DB) *gorm.DB {
-			return db.Order("created_at DESC").Limit(1)
-		}).
 		Find(&allReportSchedules).Error
 
 	if err != nil {
 		return nil, err
 	}
 
+	err = attachLatestReports(tx, allReportSchedules)
+	if err != nil {
+		return nil, err
+	}
+
 	// Group them by user_id
 	userReportSchedules := make(map[string][]entity.ReportSchedule)
 	for _, schedule := range allReportSchedules {
@@ -330,26 +398,9 @@ func (r *reportScheduleRepository) Index(ctx context.Context, tx *gorm.DB) (map[
 }
 
 func (r *reportScheduleRepository) Create(ctx context.Context, reportSchedule entity.ReportSchedule, tx *gorm.DB) (entity.ReportSchedule, error) {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return entity.ReportSchedule{}, err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-	if tx == nil {
-		tx = r.db.WithContext(ctx)
-	}
-
-	err = tx.Debug().Model(&entity.ReportSchedule{}).Create(&reportSchedule).Error
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.ReportSchedule{}).Create(&reportSchedule).Error
+	})
 	if err != nil {
 		return reportSchedule, err
 	}
@@ -357,32 +408,68 @@ func (r *reportScheduleRepository) Create(ctx context.Context, reportSchedule en
 	return reportSchedule, nil
 }
 
-func (r *reportScheduleRepository) Update(ctx context.Context, id string, reportSchedule entity.ReportSchedule, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-	defer func() {
+// CreateForRegistration creates the given schedules in one transaction, skipping weeks that already exist for the registration
+func (r *reportScheduleRepository) CreateForRegistration(ctx context.Context, registrationID string, reportSchedules []entity.ReportSchedule, tx *gorm.DB) ([]entity.ReportSchedule, []entity.ReportSchedule, error) {
+	var createdSchedules []entity.ReportSchedule
+	var skippedSchedules []entity.ReportSchedule
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		// serialise concurrent generation for the same registration
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", registrationID).Error
 		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
+			return err
+		}
+
+		var existingSchedules []entity.ReportSchedule
+		err = tx.Debug().
+			Model(&entity.ReportSchedule{}).
+			Where("registration_id = ?", registrationID).
+			Where("deleted_at IS NULL").
+			Find(&existingSchedules).Error
+		if err != nil {
+			return err
+		}
+
+		// a registration has at most one final report, weekly reports are unique per week
+		existingMap := make(map[string]entity.ReportSchedule)
+		for _, schedule := range existingSchedules {
+			existingMap[reportScheduleKey(schedule)] = schedule
+		}
+
+		for _, schedule := range reportSchedules {
+			if existing, exists := existingMap[reportScheduleKey(schedule)]; exists {
+				skippedSchedules = append(skippedSchedules, existing)
+				continue
 			}
+
+			existingMap[reportScheduleKey(schedule)] = schedule
+			createdSchedules = append(createdSchedules, schedule)
 		}
-	}()
 
-	if tx == nil {
-		tx = r.db.WithContext(ctx)
-	}
+		if len(createdSchedules) == 0 {
+			return nil
+		}
 
-	err = tx.Debug().Model(&entity.ReportSchedule{}).Where("id = ?", id).Updates(reportSchedule).Error
+		return tx.Debug().Model(&entity.ReportSchedule{}).Create(&createdSchedules).Error
+	})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	return nil
+	return createdSchedules, skippedSchedules, nil
+}
+
+func reportScheduleKey(reportSchedule entity.ReportSchedule) string {
+	if reportSchedule.ReportType == "FINAL_REPORT" {
+		return reportSchedule.ReportType
+	}
+
+	return fmt.Sprintf("%s-%d", reportSchedule.ReportType, reportSchedule.Week)
+}
+
+func (r *reportScheduleRepository) Update(ctx context.Context, id string, reportSchedule entity.ReportSchedule, tx *gorm.DB) error {
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.ReportSchedule{}).Where("id = ?", id).Updates(reportSchedule).Error
+	})
 }
 func (r *reportScheduleRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.ReportSchedule, error) {
 	var reportSchedule entity.ReportSchedule
@@ -405,32 +492,9 @@ func (r *reportScheduleRepository) FindByID(ctx context.Context, id string, tx *
 	return reportSchedule, nil
 }
 func (r *reportScheduleRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	if tx == nil {
-		tx = r.db.WithContext(ctx)
-	}
-
-	err = tx.Debug().Model(&entity.ReportSchedule{}).Where("id = ?", id).Delete(&entity.ReportSchedule{}).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.ReportSchedule{}).Where("id = ?", id).Delete(&entity.ReportSchedule{}).Error
+	})
 }
 
 func (r *reportScheduleRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) ([]entity.ReportSchedule, error) {
@@ -445,5 +509,10 @@ func (r *reportScheduleRepository) FindByRegistrationID(ctx context.Context, reg
 		return nil, err
 	}
 
+	err = attachLatestReports(tx, reportSchedules)
+	if err != nil {
+		return nil, err
+	}
+
 	return reportSchedules, nil
 }
@@ -38,10 +38,13 @@ func (r *syllabusRepository) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Con
 		tx = r.db
 	}
 
+	// the advisor sees the registrations it is named on or assigned to
+	advised := advisedByParams(advisorEmail, time.Now())
+
 	// Build the query for unique UserNRPs
 	query := tx.Debug().
 		Model(&entity.Syllabus{}).
-		Where("academic_advisor_email = ?", advisorEmail).
+		Where(advisedBy(""), advised).
 		Where("deleted_at IS NULL")
 
 	// Apply user_nrp filter if provided
@@ -87,12 +90,12 @@ func (r *syllabusRepository) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Con
 
 	// Query for syllabuses with the paginated user NRPs
 	var syllabuses []entity.Syllabus
-	err = tx.Debug().
-		Model(&entity.Syllabus{}).
-		Where("academic_advisor_email = ?", advisorEmail).
+	query = tx.Model(&entity.Syllabus{}).
+		Where(advisedBy(""), advised).
 		Where("user_nrp IN ?", paginatedUserNRPs).
-		Where("deleted_at IS NULL").
-		Order("created_at DESC").
+		Where("deleted_at IS NULL")
+	err = joinRegistrationSnapshot(tx.Debug(), query).
+		Order("listed.created_at DESC").
 		Find(&syllabuses).Error
 
 	if err != nil {
@@ -127,23 +130,9 @@ func (r *syllabusRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity.S
 }
 
 func (r *syllabusRepository) Create(ctx context.Context, syllabus entity.Syllabus, tx *gorm.DB) (entity.Syllabus, error) {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return entity.Syllabus{}, err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Syllabus{}).Create(&syllabus).Error
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Syllabus{}).Create(&syllabus).Error
+	})
 	if err != nil {
 		return entity.Syllabus{}, err
 	}
@@ -152,32 +141,13 @@ func (r *syllabusRepository) Create(ctx context.Context, syllabus entity.Syllabu
 }
 
 func (r *syllabusRepository) Update(ctx context.Context, id string, syllabus entity.Syllabus, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().
-		Model(&entity.Syllabus{}).
-		Where("id = ?", id).
-		Where("deleted_at IS NULL").
-		Updates(&syllabus).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.Syllabus{}).
+			Where("id = ?", id).
+			Where("deleted_at IS NULL").
+			Updates(&syllabus).Error
+	})
 }
 
 func (r *syllabusRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Syllabus, error) {
@@ -200,31 +170,12 @@ func (r *syllabusRepository) FindByID(ctx context.Context, id string, tx *gorm.D
 }
 
 func (r *syllabusRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().
-		Model(&entity.Syllabus{}).
-		Where("id = ?", id).
-		Update("deleted_at", time.Now()).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.Syllabus{}).
+			Where("id = ?", id).
+			Update("deleted_at", time.Now()).Error
+	})
 }
 
 func (r *syllabusRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) (entity.Syllabus, error) {
@@ -274,11 +225,11 @@ func (r *syllabusRepository) FindByUserNRPAndGroupByRegistrationID(ctx context.C
 		tx = r.db.WithContext(ctx)
 	}
 
-	err := tx.Debug().
-		Model(&entity.Syllabus{}).
+	query := tx.Model(&entity.Syllabus{}).
 		Where("user_nrp = ?", userNRP).
-		Where("deleted_at IS NULL").
-		Order("created_at DESC").
+		Where("deleted_at IS NULL")
+	err := joinRegistrationSnapshot(tx.Debug(), query).
+		Order("listed.created_at DESC").
 		Find(&syllabuses).Error
 
 	if err != nil {
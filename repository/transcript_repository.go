@@ -38,10 +38,13 @@ func (r *transcriptRepository) FindByAdvisorEmailAndGroupByUserNRP(ctx context.C
 		tx = r.db
 	}
 
+	// the advisor sees the registrations it is named on or assigned to
+	advised := advisedByParams(advisorEmail, time.Now())
+
 	// Build the query for unique UserNRPs
 	query := tx.Debug().
 		Model(&entity.Transcript{}).
-		Where("academic_advisor_email = ?", advisorEmail).
+		Where(advisedBy(""), advised).
 		Where("deleted_at IS NULL")
 
 	// Apply user_nrp filter if provided
@@ -87,12 +90,12 @@ func (r *transcriptRepository) FindByAdvisorEmailAndGroupByUserNRP(ctx context.C
 
 	// Query for transcripts with the paginated user NRPs
 	var transcripts []entity.Transcript
-	err = tx.Debug().
-		Model(&entity.Transcript{}).
-		Where("academic_advisor_email = ?", advisorEmail).
+	query = tx.Model(&entity.Transcript{}).
+		Where(advisedBy(""), advised).
 		Where("user_nrp IN ?", paginatedUserNRPs).
-		Where("deleted_at IS NULL").
-		Order("created_at DESC").
+		Where("deleted_at IS NULL")
+	err = joinRegistrationSnapshot(tx.Debug(), query).
+		Order("listed.created_at DESC").
 		Find(&transcripts).Error
 
 	if err != nil {
@@ -127,23 +130,9 @@ func (r *transcriptRepository) Index(ctx context.Context, tx *gorm.DB) ([]entity
 }
 
 func (r *transcriptRepository) Create(ctx context.Context, transcript entity.Transcript, tx *gorm.DB) (entity.Transcript, error) {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return entity.Transcript{}, err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().Model(&entity.Transcript{}).Create(&transcript).Error
+	err := r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().Model(&entity.Transcript{}).Create(&transcript).Error
+	})
 	if err != nil {
 		return entity.Transcript{}, err
 	}
@@ -152,32 +141,13 @@ func (r *transcriptRepository) Create(ctx context.Context, transcript entity.Tra
 }
 
 func (r *transcriptRepository) Update(ctx context.Context, id string, transcript entity.Transcript, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().
-		Model(&entity.Transcript{}).
-		Where("id = ?", id).
-		Where("deleted_at IS NULL").
-		Updates(&transcript).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.Transcript{}).
+			Where("id = ?", id).
+			Where("deleted_at IS NULL").
+			Updates(&transcript).Error
+	})
 }
 
 func (r *transcriptRepository) FindByID(ctx context.Context, id string, tx *gorm.DB) (entity.Transcript, error) {
@@ -200,31 +170,12 @@ func (r *transcriptRepository) FindByID(ctx context.Context, id string, tx *gorm
 }
 
 func (r *transcriptRepository) Destroy(ctx context.Context, id string, tx *gorm.DB) error {
-	tx, err := r.baseRepository.BeginTx(ctx)
-	if err != nil {
-		return err
-	}
-
-	defer func() {
-		if err != nil {
-			r.baseRepository.RollbackTx(ctx, tx)
-		} else {
-			_, err = r.baseRepository.CommitTx(ctx, tx)
-			if err != nil {
-				return
-			}
-		}
-	}()
-
-	err = tx.Debug().
-		Model(&entity.Transcript{}).
-		Where("id = ?", id).
-		Update("deleted_at", time.Now()).Error
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.baseRepository.WithinTx(ctx, tx, func(tx *gorm.DB) error {
+		return tx.Debug().
+			Model(&entity.Transcript{}).
+			Where("id = ?", id).
+			Update("deleted_at", time.Now()).Error
+	})
 }
 
 func (r *transcriptRepository) FindByRegistrationID(ctx context.Context, registrationID string, tx *gorm.DB) (entity.Transcript, error) {
@@ -274,11 +225,11 @@ func (r *transcriptRepository) FindByUserNRPAndGroupByRegistrationID(ctx context
 		tx = r.db.WithContext(ctx)
 	}
 
-	err := tx.Debug().
-		Model(&entity.Transcript{}).
+	query := tx.Model(&entity.Transcript{}).
 		Where("user_nrp = ?", userNRP).
-		Where("deleted_at IS NULL").
-		Order("created_at DESC").
+		Where("deleted_at IS NULL")
+	err := joinRegistrationSnapshot(tx.Debug(), query).
+		Order("listed.created_at DESC").
 		Find(&transcripts).Error
 
 	if err != nil {
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AdvisorAssignmentRoutes(router *gin.Engine, advisorAssignmentController controller.AdvisorAssignmentController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	assignmentRoutes := router.Group("/monitoring-service/api/v1/advisor-assignments")
+	{
+		assignmentRoutes.GET("", can(helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, helper.POLICY_ACTION_VIEW), advisorAssignmentController.Index)
+		assignmentRoutes.POST("", can(helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, helper.POLICY_ACTION_CREATE), advisorAssignmentController.Create)
+		assignmentRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, helper.POLICY_ACTION_DELETE), advisorAssignmentController.Destroy)
+	}
+
+	delegationRoutes := router.Group("/monitoring-service/api/v1/advisor-delegations")
+	{
+		delegationRoutes.GET("", can(helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_LIST), advisorAssignmentController.Delegations)
+		delegationRoutes.POST("", can(helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_CREATE), advisorAssignmentController.Delegate)
+		delegationRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_DELETE), advisorAssignmentController.Revoke)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AnalyticsRoutes(router *gin.Engine, analyticsController controller.AnalyticsController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	analyticsRoutes := router.Group("/monitoring-service/api/v1/analytics")
+	{
+		analyticsRoutes.GET("", can(helper.POLICY_RESOURCE_ANALYTICS, helper.POLICY_ACTION_VIEW), analyticsController.Summary)
+	}
+}
@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AuditRoutes(router *gin.Engine, auditController controller.AuditController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	auditRoutes := router.Group("/monitoring-service/api/v1/audit-events")
+	{
+		auditRoutes.GET("", can(helper.POLICY_RESOURCE_AUDIT_EVENT, helper.POLICY_ACTION_LIST), auditController.Index)
+	}
+}
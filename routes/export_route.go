@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ExportRoutes(router *gin.Engine, exportController controller.ExportController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	exportRoutes := router.Group("/monitoring-service/api/v1/exports")
+	exportRoutes.Use(can(helper.POLICY_RESOURCE_EXPORT, helper.POLICY_ACTION_LIST))
+	{
+		exportRoutes.GET("/report-schedules", exportController.ReportSchedules)
+		exportRoutes.GET("/reports", exportController.Reports)
+		exportRoutes.GET("/documents", exportController.Documents)
+	}
+}
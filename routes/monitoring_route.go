@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func MonitoringRoutes(router *gin.Engine, monitoringController controller.MonitoringController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	assignmentRoutes := router.Group("/monitoring-service/api/v1/monitor-assignments")
+	{
+		assignmentRoutes.GET("", can(helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, helper.POLICY_ACTION_VIEW), monitoringController.Assignments)
+		assignmentRoutes.POST("", can(helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, helper.POLICY_ACTION_CREATE), monitoringController.Assign)
+		assignmentRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, helper.POLICY_ACTION_DELETE), monitoringController.Unassign)
+	}
+
+	reportRoutes := router.Group("/monitoring-service/api/v1/reports")
+	{
+		reportRoutes.GET("/:id/evaluations", can(helper.POLICY_RESOURCE_REPORT_EVALUATION, helper.POLICY_ACTION_VIEW), monitoringController.Evaluations)
+		reportRoutes.POST("/:id/evaluations", can(helper.POLICY_RESOURCE_REPORT_EVALUATION, helper.POLICY_ACTION_CREATE), monitoringController.Evaluate)
+	}
+
+	registrationRoutes := router.Group("/monitoring-service/api/v1/registrations")
+	{
+		registrationRoutes.GET("/:id/monitoring-visits", can(helper.POLICY_RESOURCE_MONITORING_VISIT, helper.POLICY_ACTION_VIEW), monitoringController.Visits)
+		registrationRoutes.POST("/:id/monitoring-visits", can(helper.POLICY_RESOURCE_MONITORING_VISIT, helper.POLICY_ACTION_CREATE), monitoringController.RecordVisit)
+	}
+}
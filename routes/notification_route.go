@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NotificationRoutes(router *gin.Engine, notificationController controller.NotificationController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	notificationRoutes := router.Group("/monitoring-service/api/v1/notifications")
+	{
+		notificationRoutes.GET("/failed", can(helper.POLICY_RESOURCE_NOTIFICATION, helper.POLICY_ACTION_LIST), notificationController.Failed)
+		notificationRoutes.POST("/:id/resend", can(helper.POLICY_RESOURCE_NOTIFICATION, helper.POLICY_ACTION_UPDATE), notificationController.Resend)
+		notificationRoutes.GET("/preferences", can(helper.POLICY_RESOURCE_DIGEST_PREFERENCE, helper.POLICY_ACTION_VIEW), notificationController.Preference)
+		notificationRoutes.PUT("/preferences", can(helper.POLICY_RESOURCE_DIGEST_PREFERENCE, helper.POLICY_ACTION_UPDATE), notificationController.UpdatePreference)
+	}
+}
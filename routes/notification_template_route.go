@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NotificationTemplateRoutes(router *gin.Engine, notificationTemplateController controller.NotificationTemplateController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	notificationRoutes := router.Group("/monitoring-service/api/v1/notifications")
+	{
+		notificationRoutes.GET("/templates", can(helper.POLICY_RESOURCE_NOTIFICATION_TEMPLATE, helper.POLICY_ACTION_LIST), notificationTemplateController.Index)
+		notificationRoutes.POST("/templates", can(helper.POLICY_RESOURCE_NOTIFICATION_TEMPLATE, helper.POLICY_ACTION_CREATE), notificationTemplateController.Create)
+		notificationRoutes.PUT("/templates/:id", can(helper.POLICY_RESOURCE_NOTIFICATION_TEMPLATE, helper.POLICY_ACTION_UPDATE), notificationTemplateController.Update)
+		notificationRoutes.DELETE("/templates/:id", can(helper.POLICY_RESOURCE_NOTIFICATION_TEMPLATE, helper.POLICY_ACTION_DELETE), notificationTemplateController.Destroy)
+		notificationRoutes.GET("/locale", can(helper.POLICY_RESOURCE_LOCALE_PREFERENCE, helper.POLICY_ACTION_VIEW), notificationTemplateController.Locale)
+		notificationRoutes.PUT("/locale", can(helper.POLICY_RESOURCE_LOCALE_PREFERENCE, helper.POLICY_ACTION_UPDATE), notificationTemplateController.UpdateLocale)
+	}
+}
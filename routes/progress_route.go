@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"monitoring-service/controller"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	"monitoring-service/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func ProgressRoutes(router *gin.Engine, progressController controller.ProgressController, dossierController controller.DossierController, userManagementService service.UserManagementService) {
+	can := middleware.Policy(userManagementService)
+
+	registrationRoutes := router.Group("/monitoring-service/api/v1/registrations")
+	{
+		registrationRoutes.GET("/:id/progress", can(helper.POLICY_RESOURCE_PROGRESS, helper.POLICY_ACTION_VIEW), progressController.FindByRegistrationID)
+		registrationRoutes.GET("/:id/dossier", can(helper.POLICY_RESOURCE_DOSSIER, helper.POLICY_ACTION_VIEW), dossierController.Download)
+		registrationRoutes.POST("/:id/dossier", can(helper.POLICY_RESOURCE_DOSSIER, helper.POLICY_ACTION_CREATE), dossierController.Generate)
+		registrationRoutes.GET("/:id/dossier/file", can(helper.POLICY_RESOURCE_DOSSIER, helper.POLICY_ACTION_VIEW), dossierController.Show)
+	}
+
+	dashboardRoutes := router.Group("/monitoring-service/api/v1/dashboard")
+	{
+		dashboardRoutes.GET("/advisor", can(helper.POLICY_RESOURCE_PROGRESS, helper.POLICY_ACTION_LIST_ASSIGNED), progressController.AdvisorDashboard)
+	}
+}
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"monitoring-service/controller"
+	"monitoring-service/helper"
 	"monitoring-service/middleware"
 	"monitoring-service/service"
 
@@ -9,24 +10,19 @@ import (
 )
 
 func ReportRoutes(router *gin.Engine, reportController controller.ReportController, userManagementService service.UserManagementService) {
-	authMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN", "DOSEN PEMBIMBING", "MAHASISWA"})
-	adminMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN"})
-	advisorMiddleware := middleware.AuthorizationRole(userManagementService, []string{"DOSEN PEMBIMBING"})
+	can := middleware.Policy(userManagementService)
 
 	reportRoutes := router.Group("/monitoring-service/api/v1/reports")
 	{
-		reportRoutes.GET("", adminMiddleware, reportController.Index)
-		reportRoutes.GET("/report-schedules/:id/reports", reportController.FindByReportScheduleID)
-		reportRoutes.POST("/approval/:id", advisorMiddleware, reportController.Approval)
-		reportRoutes.POST("/approval", advisorMiddleware, reportController.Approval)
-
-		authorized := reportRoutes.Group("")
-		authorized.Use(authMiddleware)
-		{
-			reportRoutes.GET("/:id", reportController.Show)
-			authorized.POST("", reportController.Create)
-			authorized.PUT("/:id", reportController.Update)
-			authorized.DELETE("/:id", reportController.Destroy)
-		}
+		reportRoutes.GET("", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_LIST), reportController.Index)
+		reportRoutes.GET("/report-schedules/:id/reports", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_VIEW), reportController.FindByReportScheduleID)
+		reportRoutes.POST("/approval/:id", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_REVIEW), reportController.Approval)
+		reportRoutes.POST("/approval", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_REVIEW), reportController.Approval)
+		reportRoutes.GET("/:id", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_VIEW), reportController.Show)
+		reportRoutes.GET("/:id/revisions", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_VIEW), reportController.Revisions)
+		reportRoutes.GET("/:id/revisions/diff", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_VIEW), reportController.DiffRevisions)
+		reportRoutes.POST("", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_CREATE), reportController.Create)
+		reportRoutes.PUT("/:id", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_UPDATE), reportController.Update)
+		reportRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_DELETE), reportController.Destroy)
 	}
 }
@@ -2,6 +2,7 @@ package routes
 
 import (
 	"monitoring-service/controller"
+	"monitoring-service/helper"
 	"monitoring-service/middleware"
 	"monitoring-service/service"
 
@@ -9,25 +10,19 @@ import (
 )
 
 func ReportScheduleRoutes(router *gin.Engine, reportScheduleController controller.ReportScheduleController, userManagementService service.UserManagementService) {
-	authMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN", "DOSEN PEMBIMBING", "MAHASISWA", "LO-MBKM"})
-	adminMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN"})
-	advisorMiddleware := middleware.AuthorizationRole(userManagementService, []string{"DOSEN PEMBIMBING"})
-	studentMiddleware := middleware.AuthorizationRole(userManagementService, []string{"MAHASISWA"})
+	can := middleware.Policy(userManagementService)
 
 	reportScheduleRoutes := router.Group("/monitoring-service/api/v1/report-schedules")
 	{
-		reportScheduleRoutes.GET("", adminMiddleware, reportScheduleController.Index)
-		reportScheduleRoutes.GET("/student", studentMiddleware, reportScheduleController.FindByStudentID)
-		reportScheduleRoutes.POST("/advisor", advisorMiddleware, reportScheduleController.FindByAdvisorEmail)
-		reportScheduleRoutes.GET("/:id", authMiddleware, reportScheduleController.Show)
-		reportScheduleRoutes.GET("/registrations/:id/report-schedules", reportScheduleController.FindByRegistrationID)
-
-		authorized := reportScheduleRoutes.Group("")
-		authorized.Use(authMiddleware)
-		{
-			authorized.POST("", reportScheduleController.Create)
-			authorized.PUT("/:id", reportScheduleController.Update)
-			authorized.DELETE("/:id", reportScheduleController.Destroy)
-		}
+		reportScheduleRoutes.GET("", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_LIST), reportScheduleController.Index)
+		reportScheduleRoutes.GET("/student", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_LIST_OWN), reportScheduleController.FindByStudentID)
+		reportScheduleRoutes.POST("/advisor", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_LIST_ASSIGNED), reportScheduleController.FindByAdvisorEmail)
+		reportScheduleRoutes.POST("/monitor", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_LIST_MONITORED), reportScheduleController.FindByMonitorEmail)
+		reportScheduleRoutes.GET("/:id", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_VIEW), reportScheduleController.Show)
+		reportScheduleRoutes.GET("/registrations/:id/report-schedules", can(helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW), reportScheduleController.FindByRegistrationID)
+		reportScheduleRoutes.POST("/registrations/:id/generate", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_CREATE), reportScheduleController.Generate)
+		reportScheduleRoutes.POST("", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_CREATE), reportScheduleController.Create)
+		reportScheduleRoutes.PUT("/:id", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_UPDATE), reportScheduleController.Update)
+		reportScheduleRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_DELETE), reportScheduleController.Destroy)
 	}
 }
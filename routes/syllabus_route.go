@@ -2,6 +2,7 @@ package routes
 
 import (
 	"monitoring-service/controller"
+	"monitoring-service/helper"
 	"monitoring-service/middleware"
 	"monitoring-service/service"
 
@@ -9,26 +10,18 @@ import (
 )
 
 func SyllabusRoutes(router *gin.Engine, syllabusController controller.SyllabusController, userManagementService service.UserManagementService) {
-	authMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN", "DOSEN PEMBIMBING", "MAHASISWA"})
-	adminMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN"})
-	advisorMiddleware := middleware.AuthorizationRole(userManagementService, []string{"DOSEN PEMBIMBING"})
-	studentMiddleware := middleware.AuthorizationRole(userManagementService, []string{"MAHASISWA"})
+	can := middleware.Policy(userManagementService)
 
 	syllabusRoutes := router.Group("/monitoring-service/api/v1/syllabuses")
 	{
-		syllabusRoutes.GET("", adminMiddleware, syllabusController.Index)
-		syllabusRoutes.POST("/advisor", advisorMiddleware, syllabusController.FindByAdvisorEmail)
-		syllabusRoutes.GET("/student", studentMiddleware, syllabusController.FindByUserNRPAndGroupByRegistrationID)
-		syllabusRoutes.GET("/registrations/:id", authMiddleware, syllabusController.FindAllByRegistrationID)
-		syllabusRoutes.GET("/registrations/:id/syllabuses", authMiddleware, syllabusController.FindByRegistrationID)
-		syllabusRoutes.GET("/:id", authMiddleware, syllabusController.Show)
-
-		authorized := syllabusRoutes.Group("")
-		authorized.Use(authMiddleware)
-		{
-			authorized.POST("", syllabusController.Create)
-			authorized.PUT("/:id", syllabusController.Update)
-			authorized.DELETE("/:id", syllabusController.Destroy)
-		}
+		syllabusRoutes.GET("", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_LIST), syllabusController.Index)
+		syllabusRoutes.POST("/advisor", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_LIST_ASSIGNED), syllabusController.FindByAdvisorEmail)
+		syllabusRoutes.GET("/student", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_LIST_OWN), syllabusController.FindByUserNRPAndGroupByRegistrationID)
+		syllabusRoutes.GET("/registrations/:id", can(helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW), syllabusController.FindAllByRegistrationID)
+		syllabusRoutes.GET("/registrations/:id/syllabuses", can(helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW), syllabusController.FindByRegistrationID)
+		syllabusRoutes.GET("/:id", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_VIEW), syllabusController.Show)
+		syllabusRoutes.POST("", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_CREATE), syllabusController.Create)
+		syllabusRoutes.PUT("/:id", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_UPDATE), syllabusController.Update)
+		syllabusRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_DELETE), syllabusController.Destroy)
 	}
 }
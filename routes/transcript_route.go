@@ -2,6 +2,7 @@ package routes
 
 import (
 	"monitoring-service/controller"
+	"monitoring-service/helper"
 	"monitoring-service/middleware"
 	"monitoring-service/service"
 
@@ -9,26 +10,18 @@ import (
 )
 
 func TranscriptRoutes(router *gin.Engine, transcriptController controller.TranscriptController, userManagementService service.UserManagementService) {
-	authMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN", "DOSEN PEMBIMBING", "MAHASISWA"})
-	adminMiddleware := middleware.AuthorizationRole(userManagementService, []string{"ADMIN"})
-	advisorMiddleware := middleware.AuthorizationRole(userManagementService, []string{"DOSEN PEMBIMBING"})
-	studentMiddleware := middleware.AuthorizationRole(userManagementService, []string{"MAHASISWA"})
+	can := middleware.Policy(userManagementService)
 
 	transcriptRoutes := router.Group("/monitoring-service/api/v1/transcripts")
 	{
-		transcriptRoutes.GET("", adminMiddleware, transcriptController.Index)
-		transcriptRoutes.POST("/advisor", advisorMiddleware, transcriptController.FindByAdvisorEmail)
-		transcriptRoutes.GET("/student", studentMiddleware, transcriptController.FindByUserNRPAndGroupByRegistrationID)
-		transcriptRoutes.GET("/registrations/:id", authMiddleware, transcriptController.FindAllByRegistrationID)
-		transcriptRoutes.GET("/registrations/:id/transcripts", authMiddleware, transcriptController.FindByRegistrationID)
-		transcriptRoutes.GET("/:id", authMiddleware, transcriptController.Show)
-
-		authorized := transcriptRoutes.Group("")
-		authorized.Use(authMiddleware)
-		{
-			authorized.POST("", transcriptController.Create)
-			authorized.PUT("/:id", transcriptController.Update)
-			authorized.DELETE("/:id", transcriptController.Destroy)
-		}
+		transcriptRoutes.GET("", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_LIST), transcriptController.Index)
+		transcriptRoutes.POST("/advisor", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_LIST_ASSIGNED), transcriptController.FindByAdvisorEmail)
+		transcriptRoutes.GET("/student", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_LIST_OWN), transcriptController.FindByUserNRPAndGroupByRegistrationID)
+		transcriptRoutes.GET("/registrations/:id", can(helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW), transcriptController.FindAllByRegistrationID)
+		transcriptRoutes.GET("/registrations/:id/transcripts", can(helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW), transcriptController.FindByRegistrationID)
+		transcriptRoutes.GET("/:id", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_VIEW), transcriptController.Show)
+		transcriptRoutes.POST("", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_CREATE), transcriptController.Create)
+		transcriptRoutes.PUT("/:id", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_UPDATE), transcriptController.Update)
+		transcriptRoutes.DELETE("/:id", can(helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_DELETE), transcriptController.Destroy)
 	}
 }
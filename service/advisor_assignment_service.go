@@ -0,0 +1,487 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type advisorAssignmentService struct {
+	advisorAssignmentRepo repository.AdvisorAssignmentRepository
+	monitoringRepo        repository.MonitoringRepository
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	outboxService         OutboxService
+	auditService          AuditService
+}
+
+type AdvisorAssignmentService interface {
+	Authorize(ctx context.Context, actor dto.User, action string, resource helper.PolicyResource) error
+	Reviewers(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) ([]string, error)
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.AdvisorsResponse, error)
+	Assign(ctx context.Context, request dto.AdvisorAssignmentRequest, token string) (dto.AdvisorAssignmentResponse, error)
+	Unassign(ctx context.Context, id string, token string) error
+	FindDelegations(ctx context.Context, token string) ([]dto.AdvisorDelegationResponse, error)
+	Delegate(ctx context.Context, request dto.AdvisorDelegationRequest, token string) (dto.AdvisorDelegationResponse, error)
+	RevokeDelegation(ctx context.Context, id string, token string) error
+}
+
+func NewAdvisorAssignmentService(advisorAssignmentRepo repository.AdvisorAssignmentRepository, monitoringRepo repository.MonitoringRepository, userManagementService UserManagementService, registrationService RegistrationManagementService, outboxService OutboxService, auditService AuditService) AdvisorAssignmentService {
+	return &advisorAssignmentService{
+		advisorAssignmentRepo: advisorAssignmentRepo,
+		monitoringRepo:        monitoringRepo,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		outboxService:         outboxService,
+		auditService:          auditService,
+	}
+}
+
+// Authorize answers like helper.Authorize, except that an advisor who is not the one the resource
+// names is also let through when assigned to its registration, or covering for one of its advisors
+// when helper.PolicyDelegated allows the action, and a monitoring lecturer when assigned to monitor
+// its registration. Those are only looked up when the check on the resource alone fails.
+func (s *advisorAssignmentService) Authorize(ctx context.Context, actor dto.User, action string, resource helper.PolicyResource) error {
+	err := helper.Authorize(actor, action, resource)
+	if !errors.Is(err, helper.ErrForbidden) {
+		return err
+	}
+
+	scope, ok := helper.PolicyScope(actor, resource.Kind, action)
+	if !ok {
+		return err
+	}
+
+	switch scope {
+	case helper.POLICY_SCOPE_ASSIGNED:
+		advisors, lookupErr := s.advisors(ctx, resource.RegistrationID, resource.AdvisorEmail, helper.PolicyDelegated(resource.Kind, action), nil)
+		if lookupErr != nil {
+			return lookupErr
+		}
+		resource.AdvisorEmails = advisors
+	case helper.POLICY_SCOPE_MONITORED:
+		if resource.RegistrationID == "" {
+			return err
+		}
+		monitors, lookupErr := s.monitoringRepo.FindAssignmentsByRegistrationID(ctx, resource.RegistrationID, nil)
+		if lookupErr != nil {
+			return lookupErr
+		}
+		for _, monitor := range monitors {
+			resource.MonitorEmails = append(resource.MonitorEmails, monitor.MonitorEmail)
+		}
+	default:
+		return err
+	}
+
+	return helper.Authorize(actor, action, resource)
+}
+
+// Reviewers returns everyone reviewing the reports of a registration right now: advisorEmail, the
+// advisor its documents name, then the advisors assigned to it, then the delegates of any of them
+func (s *advisorAssignmentService) Reviewers(ctx context.Context, registrationID string, advisorEmail string, tx *gorm.DB) ([]string, error) {
+	return s.advisors(ctx, registrationID, advisorEmail, true, tx)
+}
+
+// advisors returns advisorEmail and the advisors assigned to a registration, followed by their
+// active delegates when delegated is set
+func (s *advisorAssignmentService) advisors(ctx context.Context, registrationID string, advisorEmail string, delegated bool, tx *gorm.DB) ([]string, error) {
+	var advisors []string
+	seen := make(map[string]bool)
+	add := func(email string) {
+		if email != "" && !seen[email] {
+			seen[email] = true
+			advisors = append(advisors, email)
+		}
+	}
+
+	add(advisorEmail)
+
+	if registrationID != "" {
+		assignments, err := s.advisorAssignmentRepo.FindByRegistrationID(ctx, registrationID, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, assignment := range assignments {
+			add(assignment.AdvisorEmail)
+		}
+	}
+
+	if len(advisors) == 0 || !delegated {
+		return advisors, nil
+	}
+
+	now := time.Now()
+	delegations, err := s.advisorAssignmentRepo.FindDelegations(ctx, repository.AdvisorDelegationFilter{
+		DelegatorEmails: append([]string{}, advisors...),
+		ActiveAt:        &now,
+	}, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delegation := range delegations {
+		add(delegation.DelegateEmail)
+	}
+
+	return advisors, nil
+}
+
+// FindByRegistrationID lists the advisors of a registration and the delegations running for them
+func (s *advisorAssignmentService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.AdvisorsResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorsResponse{}, err
+	}
+
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+	if err != nil {
+		return dto.AdvisorsResponse{}, err
+	}
+
+	err = s.Authorize(ctx, user, helper.POLICY_ACTION_VIEW, advisorAssignmentPolicyResource(registrationID, registration))
+	if err != nil {
+		return dto.AdvisorsResponse{}, err
+	}
+
+	assignments, err := s.advisorAssignmentRepo.FindByRegistrationID(ctx, registrationID, nil)
+	if err != nil {
+		return dto.AdvisorsResponse{}, err
+	}
+
+	response := dto.AdvisorsResponse{
+		RegistrationID: registrationID,
+		Assignments:    make([]dto.AdvisorAssignmentResponse, 0, len(assignments)),
+		Delegations:    []dto.AdvisorDelegationResponse{},
+	}
+
+	advisorEmails := make([]string, 0, len(assignments)+1)
+	if registration.AcademicAdvisorEmail != "" {
+		advisorEmails = append(advisorEmails, registration.AcademicAdvisorEmail)
+	}
+	for _, assignment := range assignments {
+		response.Assignments = append(response.Assignments, toAdvisorAssignmentResponse(assignment))
+		advisorEmails = append(advisorEmails, assignment.AdvisorEmail)
+	}
+
+	now := time.Now()
+	delegations, err := s.advisorAssignmentRepo.FindDelegations(ctx, repository.AdvisorDelegationFilter{
+		DelegatorEmails: advisorEmails,
+		ActiveAt:        &now,
+	}, nil)
+	if err != nil {
+		return dto.AdvisorsResponse{}, err
+	}
+
+	for _, delegation := range delegations {
+		response.Delegations = append(response.Delegations, toAdvisorDelegationResponse(delegation, now))
+	}
+
+	return response, nil
+}
+
+// Assign adds a co-advisor to a registration. The primary advisor is the one the registration
+// names and follows it, so only secondary advisors are assigned here.
+func (s *advisorAssignmentService) Assign(ctx context.Context, request dto.AdvisorAssignmentRequest, token string) (dto.AdvisorAssignmentResponse, error) {
+	role := strings.ToUpper(strings.TrimSpace(request.Role))
+	if role == "" {
+		role = helper.ADVISOR_ROLE_SECONDARY
+	}
+	if !helper.ValidateAdvisorRole(role) {
+		return dto.AdvisorAssignmentResponse{}, fmt.Errorf("%w: unknown role %q", helper.ErrInvalidAdvisorAssignment, request.Role)
+	}
+	if role == helper.ADVISOR_ROLE_PRIMARY {
+		return dto.AdvisorAssignmentResponse{}, fmt.Errorf("%w: the primary advisor follows the registration", helper.ErrInvalidAdvisorAssignment)
+	}
+
+	advisorEmail := strings.TrimSpace(request.AdvisorEmail)
+	if request.RegistrationID == "" || advisorEmail == "" {
+		return dto.AdvisorAssignmentResponse{}, fmt.Errorf("%w: registration_id and advisor_email are required", helper.ErrInvalidAdvisorAssignment)
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorAssignmentResponse{}, err
+	}
+
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", request.RegistrationID, token)
+	if err != nil {
+		return dto.AdvisorAssignmentResponse{}, err
+	}
+
+	err = s.Authorize(ctx, user, helper.POLICY_ACTION_CREATE, advisorAssignmentPolicyResource(request.RegistrationID, registration))
+	if err != nil {
+		return dto.AdvisorAssignmentResponse{}, err
+	}
+
+	if advisorEmail == registration.AcademicAdvisorEmail {
+		return dto.AdvisorAssignmentResponse{}, fmt.Errorf("%w: %s is the primary advisor", helper.ErrAdvisorAssignmentExists, advisorEmail)
+	}
+
+	_, err = s.advisorAssignmentRepo.FindByRegistrationIDAndAdvisorEmail(ctx, request.RegistrationID, advisorEmail, nil)
+	if err == nil {
+		return dto.AdvisorAssignmentResponse{}, fmt.Errorf("%w: %s", helper.ErrAdvisorAssignmentExists, advisorEmail)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.AdvisorAssignmentResponse{}, err
+	}
+
+	now := time.Now()
+	assignment := entity.AdvisorAssignment{
+		ID:             uuid.New(),
+		RegistrationID: request.RegistrationID,
+		AdvisorID:      request.AdvisorID,
+		AdvisorEmail:   advisorEmail,
+		Role:           role,
+		AssignedBy:     user.Email,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	var created entity.AdvisorAssignment
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err = s.advisorAssignmentRepo.Create(ctx, assignment, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, created.ID.String(), nil, created)
+	})
+	if err != nil {
+		return dto.AdvisorAssignmentResponse{}, err
+	}
+
+	return toAdvisorAssignmentResponse(created), nil
+}
+
+// Unassign removes a co-advisor from a registration
+func (s *advisorAssignmentService) Unassign(ctx context.Context, id string, token string) error {
+	assignment, err := s.advisorAssignmentRepo.FindByID(ctx, id, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.ErrAdvisorAssignmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	err = helper.Authorize(user, helper.POLICY_ACTION_DELETE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT,
+		RegistrationID: assignment.RegistrationID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if assignment.Role == helper.ADVISOR_ROLE_PRIMARY {
+		return fmt.Errorf("%w: the primary advisor follows the registration", helper.ErrInvalidAdvisorAssignment)
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.advisorAssignmentRepo.Destroy(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, id, assignment, nil)
+	})
+}
+
+// FindDelegations lists every delegation for the staff, and those an advisor gave or received
+func (s *advisorAssignmentService) FindDelegations(ctx context.Context, token string) ([]dto.AdvisorDelegationResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, ok := helper.PolicyScope(user, helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_LIST)
+	if !ok {
+		return nil, helper.Authorize(user, helper.POLICY_ACTION_LIST, helper.PolicyResource{Kind: helper.POLICY_RESOURCE_ADVISOR_DELEGATION})
+	}
+
+	var filter repository.AdvisorDelegationFilter
+	if scope == helper.POLICY_SCOPE_SELF {
+		if user.Email == "" {
+			return nil, errors.New("advisor email not found")
+		}
+		filter.Email = user.Email
+	}
+
+	delegations, err := s.advisorAssignmentRepo.FindDelegations(ctx, filter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	delegationResponses := make([]dto.AdvisorDelegationResponse, 0, len(delegations))
+	for _, delegation := range delegations {
+		delegationResponses = append(delegationResponses, toAdvisorDelegationResponse(delegation, now))
+	}
+
+	return delegationResponses, nil
+}
+
+// Delegate hands the reviews of an advisor to another lecturer for a period. Advisors delegate
+// their own reviews, the staff those of any advisor.
+func (s *advisorAssignmentService) Delegate(ctx context.Context, request dto.AdvisorDelegationRequest, token string) (dto.AdvisorDelegationResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorDelegationResponse{}, err
+	}
+
+	err = helper.Authorize(user, helper.POLICY_ACTION_CREATE, helper.PolicyResource{Kind: helper.POLICY_RESOURCE_ADVISOR_DELEGATION})
+	if err != nil {
+		return dto.AdvisorDelegationResponse{}, err
+	}
+
+	delegatorEmail := strings.TrimSpace(request.DelegatorEmail)
+	if scope, _ := helper.PolicyScope(user, helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_CREATE); scope == helper.POLICY_SCOPE_SELF {
+		if delegatorEmail != "" && delegatorEmail != user.Email {
+			return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: advisors can only delegate their own reviews", helper.ErrForbidden)
+		}
+		delegatorEmail = user.Email
+	}
+
+	delegateEmail := strings.TrimSpace(request.DelegateEmail)
+	if delegatorEmail == "" || delegateEmail == "" {
+		return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: delegator_email and delegate_email are required", helper.ErrInvalidAdvisorDelegation)
+	}
+	if delegatorEmail == delegateEmail {
+		return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: an advisor cannot delegate to itself", helper.ErrInvalidAdvisorDelegation)
+	}
+
+	if request.StartDate == "" || request.EndDate == "" {
+		return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: start_date and end_date are required", helper.ErrInvalidAdvisorDelegation)
+	}
+
+	startsAt, endsAt, err := helper.ParseDateRange(request.StartDate, request.EndDate)
+	if err != nil {
+		return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: %v", helper.ErrInvalidAdvisorDelegation, err)
+	}
+
+	now := time.Now()
+	if !endsAt.After(now) {
+		return dto.AdvisorDelegationResponse{}, fmt.Errorf("%w: the period is already over", helper.ErrInvalidAdvisorDelegation)
+	}
+
+	delegation := entity.AdvisorDelegation{
+		ID:             uuid.New(),
+		DelegatorEmail: delegatorEmail,
+		DelegateEmail:  delegateEmail,
+		StartsAt:       startsAt,
+		EndsAt:         endsAt,
+		Reason:         request.Reason,
+		CreatedBy:      user.Email,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	var created entity.AdvisorDelegation
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err = s.advisorAssignmentRepo.CreateDelegation(ctx, delegation, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_ADVISOR_DELEGATION, created.ID.String(), nil, created)
+	})
+	if err != nil {
+		return dto.AdvisorDelegationResponse{}, err
+	}
+
+	return toAdvisorDelegationResponse(created, now), nil
+}
+
+// RevokeDelegation ends a delegation, advisors may revoke the ones they gave or received
+func (s *advisorAssignmentService) RevokeDelegation(ctx context.Context, id string, token string) error {
+	delegation, err := s.advisorAssignmentRepo.FindDelegationByID(ctx, id, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.ErrAdvisorDelegationNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	err = helper.Authorize(user, helper.POLICY_ACTION_DELETE, helper.PolicyResource{Kind: helper.POLICY_RESOURCE_ADVISOR_DELEGATION})
+	if err != nil {
+		return err
+	}
+
+	scope, _ := helper.PolicyScope(user, helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_DELETE)
+	if scope == helper.POLICY_SCOPE_SELF && user.Email != delegation.DelegatorEmail && user.Email != delegation.DelegateEmail {
+		return fmt.Errorf("%w: delegation belongs to other advisors", helper.ErrForbidden)
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.advisorAssignmentRepo.DestroyDelegation(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_ADVISOR_DELEGATION, id, delegation, nil)
+	})
+}
+
+// advisorAssignmentPolicyResource describes the advisors of a registration to the policy
+func advisorAssignmentPolicyResource(registrationID string, registration dto.Registration) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT,
+		RegistrationID: registrationID,
+		OwnerID:        registration.UserID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	}
+}
+
+func toAdvisorAssignmentResponse(assignment entity.AdvisorAssignment) dto.AdvisorAssignmentResponse {
+	return dto.AdvisorAssignmentResponse{
+		ID:             assignment.ID.String(),
+		RegistrationID: assignment.RegistrationID,
+		AdvisorID:      assignment.AdvisorID,
+		AdvisorEmail:   assignment.AdvisorEmail,
+		Role:           assignment.Role,
+		AssignedBy:     assignment.AssignedBy,
+		CreatedAt:      assignment.CreatedAt,
+	}
+}
+
+func toAdvisorDelegationResponse(delegation entity.AdvisorDelegation, now time.Time) dto.AdvisorDelegationResponse {
+	response := dto.AdvisorDelegationResponse{
+		ID:             delegation.ID.String(),
+		DelegatorEmail: delegation.DelegatorEmail,
+		DelegateEmail:  delegation.DelegateEmail,
+		StartsAt:       delegation.StartsAt,
+		EndsAt:         delegation.EndsAt,
+		Reason:         delegation.Reason,
+		CreatedBy:      delegation.CreatedBy,
+	}
+
+	if delegation.StartsAt != nil && delegation.EndsAt != nil {
+		response.Active = helper.DelegationActive(*delegation.StartsAt, *delegation.EndsAt, now)
+	}
+
+	return response
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type advisorNotificationService struct {
+	baseRepo                repository.BaseRepository
+	advisorNotificationRepo repository.AdvisorNotificationRepository
+	notificationService     NotificationService
+	templateService         NotificationTemplateService
+	userManagementService   UserManagementService
+	advisorAssignments      AdvisorAssignmentService
+	config                  helper.AdvisorNotificationConfig
+}
+
+type AdvisorNotificationService interface {
+	NotifySubmission(ctx context.Context, tx *gorm.DB, registrationID string, notification dto.NotificationRequest, data dto.NotificationTemplateData) error
+	GetPreference(ctx context.Context, token string) (dto.AdvisorNotificationPreferenceResponse, error)
+	UpdatePreference(ctx context.Context, token string, request dto.AdvisorNotificationPreferenceRequest) (dto.AdvisorNotificationPreferenceResponse, error)
+	Start(ctx context.Context)
+	SendDigests(ctx context.Context) (int, error)
+}
+
+func NewAdvisorNotificationService(baseRepo repository.BaseRepository, advisorNotificationRepo repository.AdvisorNotificationRepository, notificationService NotificationService, templateService NotificationTemplateService, userManagementService UserManagementService, advisorAssignments AdvisorAssignmentService, config helper.AdvisorNotificationConfig) AdvisorNotificationService {
+	return &advisorNotificationService{
+		baseRepo:                baseRepo,
+		advisorNotificationRepo: advisorNotificationRepo,
+		notificationService:     notificationService,
+		templateService:         templateService,
+		userManagementService:   userManagementService,
+		advisorAssignments:      advisorAssignments,
+		config:                  config,
+	}
+}
+
+// NotifySubmission tells every reviewer of a registration about a new submission in tx: the advisor
+// in ReceiverEmail, the other advisors assigned to the registration and whoever covers for them.
+// Each one is notified right away or in the next daily digest depending on their preference.
+// Submissions without an advisor are skipped, and so are those whose notification cannot be
+// rendered: the submission itself stands.
+func (s *advisorNotificationService) NotifySubmission(ctx context.Context, tx *gorm.DB, registrationID string, notification dto.NotificationRequest, data dto.NotificationTemplateData) error {
+	reviewers, err := s.advisorAssignments.Reviewers(ctx, registrationID, notification.ReceiverEmail, tx)
+	if err != nil {
+		return err
+	}
+
+	if len(reviewers) == 0 {
+		log.Printf("skipping %s notification without academic advisor", notification.Type)
+		return nil
+	}
+
+	for _, reviewer := range reviewers {
+		notification.ReceiverEmail = reviewer
+		err = s.notifyReviewer(ctx, tx, notification, data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *advisorNotificationService) notifyReviewer(ctx context.Context, tx *gorm.DB, notification dto.NotificationRequest, data dto.NotificationTemplateData) error {
+	notification, err := s.templateService.Render(ctx, notification, data)
+	if err != nil {
+		log.Println("ERROR RENDERING SUBMISSION NOTIFICATION: ", err)
+		return nil
+	}
+
+	dailyDigest, err := s.dailyDigest(ctx, notification.ReceiverEmail, tx)
+	if err != nil {
+		return err
+	}
+
+	if !dailyDigest {
+		return s.notificationService.Enqueue(ctx, tx, notification)
+	}
+
+	now := time.Now()
+	return s.advisorNotificationRepo.CreateDigestEntry(ctx, entity.AdvisorDigestEntry{
+		ID:           uuid.New(),
+		AdvisorEmail: notification.ReceiverEmail,
+		SenderName:   notification.SenderName,
+		SenderEmail:  notification.SenderEmail,
+		Type:         notification.Type,
+		Message:      notification.Message,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}, tx)
+}
+
+func (s *advisorNotificationService) dailyDigest(ctx context.Context, advisorEmail string, tx *gorm.DB) (bool, error) {
+	preference, err := s.advisorNotificationRepo.FindPreference(ctx, advisorEmail, tx)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return s.config.DefaultDailyDigest, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return preference.DailyDigest, nil
+}
+
+func (s *advisorNotificationService) GetPreference(ctx context.Context, token string) (dto.AdvisorNotificationPreferenceResponse, error) {
+	advisor, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorNotificationPreferenceResponse{}, err
+	}
+
+	if advisor.Email == "" {
+		return dto.AdvisorNotificationPreferenceResponse{}, errors.New("advisor email not found")
+	}
+
+	dailyDigest, err := s.dailyDigest(ctx, advisor.Email, nil)
+	if err != nil {
+		return dto.AdvisorNotificationPreferenceResponse{}, err
+	}
+
+	return dto.AdvisorNotificationPreferenceResponse{
+		AdvisorEmail: advisor.Email,
+		DailyDigest:  dailyDigest,
+	}, nil
+}
+
+func (s *advisorNotificationService) UpdatePreference(ctx context.Context, token string, request dto.AdvisorNotificationPreferenceRequest) (dto.AdvisorNotificationPreferenceResponse, error) {
+	advisor, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorNotificationPreferenceResponse{}, err
+	}
+
+	if advisor.Email == "" {
+		return dto.AdvisorNotificationPreferenceResponse{}, errors.New("advisor email not found")
+	}
+
+	if request.DailyDigest == nil {
+		return dto.AdvisorNotificationPreferenceResponse{}, errors.New("daily_digest is required")
+	}
+
+	now := time.Now()
+	err = s.advisorNotificationRepo.SavePreference(ctx, entity.AdvisorNotificationPreference{
+		AdvisorEmail: advisor.Email,
+		DailyDigest:  *request.DailyDigest,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}, nil)
+	if err != nil {
+		return dto.AdvisorNotificationPreferenceResponse{}, err
+	}
+
+	return dto.AdvisorNotificationPreferenceResponse{
+		AdvisorEmail: advisor.Email,
+		DailyDigest:  *request.DailyDigest,
+	}, nil
+}
+
+// Start sends the daily digests every day at DigestHour until ctx is cancelled
+func (s *advisorNotificationService) Start(ctx context.Context) {
+	if !s.config.DigestEnabled {
+		return
+	}
+
+	if s.config.DigestHour < 0 || s.config.DigestHour > 23 {
+		log.Printf("ERROR STARTING ADVISOR DIGESTS: invalid digest hour %d", s.config.DigestHour)
+		return
+	}
+
+	for {
+		timer := time.NewTimer(time.Until(helper.NextDigestRun(time.Now(), s.config.DigestHour)))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		sent, err := s.SendDigests(ctx)
+		if err != nil {
+			log.Println("ERROR SENDING ADVISOR DIGESTS: ", err)
+		} else if sent > 0 {
+			log.Printf("queued %d advisor digests", sent)
+		}
+	}
+}
+
+// SendDigests queues one notification per advisor listing the submissions held back since the last
+// digest, and marks those submissions digested in the same transaction
+func (s *advisorNotificationService) SendDigests(ctx context.Context) (int, error) {
+	sent := 0
+	err := s.baseRepo.WithinTx(ctx, nil, func(tx *gorm.DB) error {
+		entries, err := s.advisorNotificationRepo.FindUndigestedEntries(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		var advisorEmails []string
+		entriesByAdvisor := make(map[string][]entity.AdvisorDigestEntry)
+		for _, entry := range entries {
+			if _, ok := entriesByAdvisor[entry.AdvisorEmail]; !ok {
+				advisorEmails = append(advisorEmails, entry.AdvisorEmail)
+			}
+			entriesByAdvisor[entry.AdvisorEmail] = append(entriesByAdvisor[entry.AdvisorEmail], entry)
+		}
+
+		notifications := make([]dto.NotificationRequest, 0, len(advisorEmails))
+		ids := make([]string, 0, len(entries))
+		for _, advisorEmail := range advisorEmails {
+			advisorEntries := entriesByAdvisor[advisorEmail]
+			notification, err := s.templateService.Render(ctx, dto.NotificationRequest{
+				SenderName:    "Monitoring Service",
+				SenderEmail:   advisorEmail,
+				ReceiverEmail: advisorEmail,
+				Type:          helper.ADVISOR_NOTIFICATION_SUBMISSION_DIGEST,
+			}, dto.NotificationTemplateData{
+				Items: digestItems(advisorEntries),
+			})
+			if err != nil {
+				return err
+			}
+			notifications = append(notifications, notification)
+
+			for _, entry := range advisorEntries {
+				ids = append(ids, entry.ID.String())
+			}
+		}
+
+		if err := s.notificationService.Enqueue(ctx, tx, notifications...); err != nil {
+			return err
+		}
+		if err := s.advisorNotificationRepo.MarkDigested(ctx, ids, time.Now(), tx); err != nil {
+			return err
+		}
+
+		sent = len(notifications)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, nil
+}
+
+func digestItems(entries []entity.AdvisorDigestEntry) []string {
+	items := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		items = append(items, entry.Message)
+	}
+
+	return items
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+)
+
+// ANALYTICS_DEFAULT_LIMIT is the number of advisors and activities listed when no limit is requested
+const ANALYTICS_DEFAULT_LIMIT = 10
+
+type analyticsService struct {
+	analyticsRepo repository.AnalyticsRepository
+}
+
+type AnalyticsService interface {
+	Summary(ctx context.Context, filter dto.AnalyticsFilterRequest) (dto.AnalyticsResponse, error)
+}
+
+func NewAnalyticsService(analyticsRepo repository.AnalyticsRepository) AnalyticsService {
+	return &analyticsService{
+		analyticsRepo: analyticsRepo,
+	}
+}
+
+// Summary aggregates submission, review and compliance statistics over every registration matched by the filter
+func (s *analyticsService) Summary(ctx context.Context, filter dto.AnalyticsFilterRequest) (dto.AnalyticsResponse, error) {
+	from, to, err := helper.ParseDateRange(filter.StartDate, filter.EndDate)
+	if err != nil {
+		return dto.AnalyticsResponse{}, err
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = ANALYTICS_DEFAULT_LIMIT
+	}
+
+	analyticsFilter := repository.AnalyticsFilter{
+		From:         from,
+		To:           to,
+		ActivityName: filter.ActivityName,
+		AdvisorEmail: filter.AdvisorEmail,
+	}
+	now := time.Now()
+
+	rates, err := s.analyticsRepo.SubmissionRateByWeek(ctx, analyticsFilter, now, nil)
+	if err != nil {
+		return dto.AnalyticsResponse{}, err
+	}
+
+	latency, err := s.analyticsRepo.ApprovalLatency(ctx, analyticsFilter, nil)
+	if err != nil {
+		return dto.AnalyticsResponse{}, err
+	}
+
+	backlogs, err := s.analyticsRepo.AdvisorBacklog(ctx, analyticsFilter, limit, nil)
+	if err != nil {
+		return dto.AnalyticsResponse{}, err
+	}
+
+	compliances, err := s.analyticsRepo.LowestActivityCompliance(ctx, analyticsFilter, now, limit, nil)
+	if err != nil {
+		return dto.AnalyticsResponse{}, err
+	}
+
+	response := dto.AnalyticsResponse{
+		SubmissionRateByWeek: make([]dto.WeeklySubmissionRateResponse, 0, len(rates)),
+		ApprovalLatency: dto.ApprovalLatencyResponse{
+			Reviewed:       latency.Reviewed,
+			AverageSeconds: latency.AverageSeconds,
+			MedianSeconds:  latency.MedianSeconds,
+			P90Seconds:     latency.P90Seconds,
+			MaxSeconds:     latency.MaxSeconds,
+		},
+		AdvisorBacklog:   make([]dto.AdvisorBacklogResponse, 0, len(backlogs)),
+		LowestCompliance: make([]dto.ActivityComplianceResponse, 0, len(compliances)),
+	}
+
+	for _, rate := range rates {
+		response.SubmissionRateByWeek = append(response.SubmissionRateByWeek, dto.WeeklySubmissionRateResponse{
+			Week:           rate.Week,
+			Due:            rate.Due,
+			Submitted:      rate.Submitted,
+			OnTime:         rate.OnTime,
+			Late:           rate.Late,
+			SubmissionRate: helper.Percentage(rate.Submitted, rate.Due),
+		})
+	}
+
+	for _, backlog := range backlogs {
+		backlogResponse := dto.AdvisorBacklogResponse{
+			AcademicAdvisorEmail: backlog.AcademicAdvisorEmail,
+			PendingReviews:       backlog.PendingReviews,
+		}
+		if backlog.OldestPendingAt != nil {
+			backlogResponse.OldestPendingAt = backlog.OldestPendingAt.Format(time.RFC3339)
+		}
+
+		response.AdvisorBacklog = append(response.AdvisorBacklog, backlogResponse)
+	}
+
+	for _, compliance := range compliances {
+		response.LowestCompliance = append(response.LowestCompliance, dto.ActivityComplianceResponse{
+			ActivityName:   compliance.ActivityName,
+			Due:            compliance.Due,
+			OnTime:         compliance.OnTime,
+			Late:           compliance.Late,
+			Missing:        compliance.Missing,
+			ComplianceRate: helper.Percentage(compliance.OnTime, compliance.Due),
+		})
+	}
+
+	return response, nil
+}
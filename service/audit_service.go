@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type auditService struct {
+	auditEventRepo repository.AuditEventRepository
+}
+
+type AuditService interface {
+	Record(ctx context.Context, tx *gorm.DB, actor dto.User, action string, resourceType string, resourceID string, before interface{}, after interface{}) error
+	FindAll(ctx context.Context, filter dto.AuditEventFilterRequest, pagReq dto.PaginationRequest) ([]dto.AuditEventResponse, dto.PaginationResponse, error)
+}
+
+func NewAuditService(auditEventRepo repository.AuditEventRepository) AuditService {
+	return &auditService{
+		auditEventRepo: auditEventRepo,
+	}
+}
+
+// Record adds what actor did to a resource to the audit trail in tx. before and after are
+// snapshots of the resource, nil when it did not exist yet or any more. The request ID and IP
+// address are read from ctx when it is the gin context of a request.
+func (s *auditService) Record(ctx context.Context, tx *gorm.DB, actor dto.User, action string, resourceType string, resourceID string, before interface{}, after interface{}) error {
+	beforeSnapshot, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+
+	afterSnapshot, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	requestID, _ := ctx.Value(helper.REQUEST_ID_CONTEXT_KEY).(string)
+	ipAddress, _ := ctx.Value(helper.CLIENT_IP_CONTEXT_KEY).(string)
+
+	now := time.Now()
+	return s.auditEventRepo.Create(ctx, []entity.AuditEvent{{
+		ID:           uuid.New(),
+		ActorID:      actor.ID,
+		ActorRole:    actor.Role,
+		ActorEmail:   actor.Email,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Before:       beforeSnapshot,
+		After:        afterSnapshot,
+		RequestID:    requestID,
+		IPAddress:    ipAddress,
+		OccurredAt:   &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}}, tx)
+}
+
+func auditSnapshot(value interface{}) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := string(data)
+	return &snapshot, nil
+}
+
+// FindAll returns a page of the audit trail matching filter, most recent first
+func (s *auditService) FindAll(ctx context.Context, filter dto.AuditEventFilterRequest, pagReq dto.PaginationRequest) ([]dto.AuditEventResponse, dto.PaginationResponse, error) {
+	from, to, err := helper.ParseDateRange(filter.StartDate, filter.EndDate)
+	if err != nil {
+		return nil, dto.PaginationResponse{}, err
+	}
+
+	auditEvents, total, err := s.auditEventRepo.FindAll(ctx, repository.AuditEventFilter{
+		ResourceType: filter.ResourceType,
+		ResourceID:   filter.ResourceID,
+		ActorID:      filter.ActorID,
+		ActorEmail:   filter.ActorEmail,
+		Action:       filter.Action,
+		From:         from,
+		To:           to,
+	}, &pagReq, nil)
+	if err != nil {
+		return nil, dto.PaginationResponse{}, err
+	}
+
+	auditEventResponses := make([]dto.AuditEventResponse, 0, len(auditEvents))
+	for _, auditEvent := range auditEvents {
+		auditEventResponses = append(auditEventResponses, toAuditEventResponse(auditEvent))
+	}
+
+	return auditEventResponses, helper.MetaDataPagination(total, pagReq), nil
+}
+
+func toAuditEventResponse(auditEvent entity.AuditEvent) dto.AuditEventResponse {
+	response := dto.AuditEventResponse{
+		ID:           auditEvent.ID.String(),
+		ActorID:      auditEvent.ActorID,
+		ActorRole:    auditEvent.ActorRole,
+		ActorEmail:   auditEvent.ActorEmail,
+		Action:       auditEvent.Action,
+		ResourceType: auditEvent.ResourceType,
+		ResourceID:   auditEvent.ResourceID,
+		RequestID:    auditEvent.RequestID,
+		IPAddress:    auditEvent.IPAddress,
+		OccurredAt:   auditEvent.OccurredAt,
+	}
+
+	if auditEvent.Before != nil {
+		response.Before = json.RawMessage(*auditEvent.Before)
+	}
+
+	if auditEvent.After != nil {
+		response.After = json.RawMessage(*auditEvent.After)
+	}
+
+	return response
+}
@@ -1,49 +1,58 @@
 package service
 
 import (
+	"context"
 	"errors"
-	"strings"
-
-	baseService "github.com/SIM-MBKM/mod-service/src/service"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
 )
 
-type BrokerService struct {
-	baseService *baseService.Service
+type brokerService struct {
+	client *downstreamClient
+}
+
+type BrokerService interface {
+	SendNotification(ctx context.Context, notification dto.NotificationRequest, method string, token string) (dto.NotificationResult, error)
 }
 
 const (
 	SEND_NOTIFICATION = "broker-service/api/v1/send-notification"
 )
 
-func NewBrokerService(baseURI string, asyncURIs []string) *BrokerService {
-	return &BrokerService{
-		baseService: baseService.NewService(baseURI, asyncURIs),
+func NewBrokerService(baseURI string, asyncURIs []string, downstreamConfig helper.DownstreamConfig) BrokerService {
+	return &brokerService{
+		client: newDownstreamClient("broker", baseURI, asyncURIs, downstreamConfig),
 	}
 }
 
-func (s *BrokerService) SendNotification(data map[string]interface{}, method string, token string) error {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
+func (s *brokerService) SendNotification(ctx context.Context, notification dto.NotificationRequest, method string, token string) (dto.NotificationResult, error) {
+	token, err := bearerToken(token)
+	if err != nil {
+		return dto.NotificationResult{}, err
 	}
 
-	token = tokenParts[1]
+	data := map[string]interface{}{
+		"sender_name":    notification.SenderName,
+		"sender_email":   notification.SenderEmail,
+		"receiver_email": notification.ReceiverEmail,
+		"type":           notification.Type,
+		"subject":        notification.Subject,
+		"message":        notification.Message,
+	}
 
-	res, err := s.baseService.Request(method, SEND_NOTIFICATION, data, token)
+	res, err := s.client.request(ctx, method, SEND_NOTIFICATION, data, token)
 	if err != nil {
-		return err
+		return dto.NotificationResult{}, err
 	}
 
-	res, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return errors.New("invalid response")
+	var result dto.NotificationResult
+	if err := decodeResponseData(res, &result); err != nil {
+		return dto.NotificationResult{}, err
 	}
 
-	status, _ := res["status"].(string)
-	if status != "success" {
-		return errors.New("failed to send notification")
+	if result.Status != "success" {
+		return result, errors.New("failed to send notification")
 	}
 
-	return nil
+	return result, nil
 }
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"monitoring-service/helper"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	baseServiceHelpers "github.com/SIM-MBKM/mod-service/src/helpers"
+	"github.com/sony/gobreaker"
+)
+
+var (
+	// ErrInvalidToken is returned when a token cannot be forwarded to a downstream service
+	ErrInvalidToken = errors.New("invalid token format")
+	// ErrUnexpectedResponse is returned when a downstream service answers without the expected data
+	ErrUnexpectedResponse = errors.New("unexpected response")
+	// ErrServiceUnavailable is returned while the circuit breaker of a failing downstream service is open
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+// DownstreamStatusError is returned when a downstream service answers with a non 200 status
+type DownstreamStatusError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *DownstreamStatusError) Error() string {
+	return e.Status
+}
+
+// bearerToken strips the "Bearer" scheme, the downstream client adds it back on every request
+func bearerToken(token string) (string, error) {
+	tokenParts := strings.Split(token, " ")
+	if len(tokenParts) != 2 {
+		return "", ErrInvalidToken
+	}
+
+	return tokenParts[1], nil
+}
+
+// decodeResponseData decodes the data field of a downstream response into out.
+// Null fields are left at their zero value instead of failing a type assertion later on.
+func decodeResponseData(res map[string]interface{}, out interface{}) error {
+	data, ok := res["data"]
+	if !ok || data == nil {
+		return ErrUnexpectedResponse
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("%w: %v", ErrUnexpectedResponse, err)
+	}
+
+	return nil
+}
+
+// downstreamClient sends requests to one downstream service. Every attempt is bounded by the
+// request context and the configured timeout, GET requests are retried with jittered backoff,
+// and a circuit breaker per downstream stops calling a service that keeps failing.
+type downstreamClient struct {
+	name      string
+	baseURI   string
+	asyncURIs []string
+	client    *http.Client
+	breaker   *gobreaker.CircuitBreaker
+	config    helper.DownstreamConfig
+}
+
+func newDownstreamClient(name string, baseURI string, asyncURIs []string, config helper.DownstreamConfig) *downstreamClient {
+	return &downstreamClient{
+		name:      name,
+		baseURI:   strings.TrimRight(baseURI, "/") + "/",
+		asyncURIs: asyncURIs,
+		client:    &http.Client{},
+		breaker:   newCircuitBreaker(name, config),
+		config:    config,
+	}
+}
+
+// newCircuitBreaker opens after BreakerFailures consecutive downstream failures and lets a
+// probe request through after BreakerOpenTimeout. A zero BreakerFailures disables the breaker.
+func newCircuitBreaker(name string, config helper.DownstreamConfig) *gobreaker.CircuitBreaker {
+	return gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:    name,
+		Timeout: config.BreakerOpenTimeout,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return config.BreakerFailures > 0 && counts.ConsecutiveFailures >= config.BreakerFailures
+		},
+		IsSuccessful: func(err error) bool {
+			return !isDownstreamFailure(err)
+		},
+		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+			log.Printf("circuit breaker %s changed from %s to %s", name, from, to)
+		},
+	})
+}
+
+// isDownstreamFailure tells whether err means the downstream service is unhealthy, as opposed to
+// a rejected request (4xx) or a caller that went away
+func isDownstreamFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ErrUnexpectedResponse) {
+		return false
+	}
+
+	var statusErr *DownstreamStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError || statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	return true
+}
+
+// IsServiceUnavailable tells whether err comes from a downstream service that is down, too slow
+// or shedding load, so callers can answer 503 or degrade instead of blaming the request
+func IsServiceUnavailable(err error) bool {
+	if errors.Is(err, ErrServiceUnavailable) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var statusErr *DownstreamStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= http.StatusInternalServerError || statusErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// isRetryable only retries idempotent GETs, and never while the circuit breaker is open
+func isRetryable(method string) func(error) bool {
+	return func(err error) bool {
+		return method == http.MethodGet && !errors.Is(err, ErrServiceUnavailable) && isDownstreamFailure(err)
+	}
+}
+
+// request sends a request and returns the decoded JSON body of a 200 response
+func (c *downstreamClient) request(ctx context.Context, method string, uri string, opts map[string]interface{}, token string) (map[string]interface{}, error) {
+	if c.isAsync(uri) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+			defer cancel()
+
+			if _, err := c.execute(ctx, method, uri, opts, token); err != nil {
+				log.Printf("ERROR SENDING ASYNC REQUEST TO %s: %v", c.name, err)
+			}
+		}()
+
+		return map[string]interface{}{
+			"status": "success",
+			"data":   nil,
+		}, nil
+	}
+
+	var res map[string]interface{}
+	err := helper.Retry(ctx, c.config, isRetryable(method), func(ctx context.Context) error {
+		var err error
+		res, err = c.execute(ctx, method, uri, opts, token)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", c.name, err)
+	}
+
+	return res, nil
+}
+
+func (c *downstreamClient) execute(ctx context.Context, method string, uri string, opts map[string]interface{}, token string) (map[string]interface{}, error) {
+	res, err := c.breaker.Execute(func() (interface{}, error) {
+		return c.do(ctx, method, uri, opts, token)
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return nil, ErrServiceUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(map[string]interface{}), nil
+}
+
+func (c *downstreamClient) do(ctx context.Context, method string, uri string, opts map[string]interface{}, token string) (map[string]interface{}, error) {
+	var body []byte
+	if opts != nil {
+		var err error
+		body, err = json.Marshal(opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURI+uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := downstreamHeaders(token)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, &DownstreamStatusError{StatusCode: res.StatusCode, Status: res.Status}
+	}
+
+	var jsonResponse map[string]interface{}
+	if err := json.Unmarshal(resBody, &jsonResponse); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnexpectedResponse, err)
+	}
+
+	return jsonResponse, nil
+}
+
+func (c *downstreamClient) isAsync(uri string) bool {
+	for _, asyncURI := range c.asyncURIs {
+		if strings.Contains(uri, asyncURI) {
+			return true
+		}
+	}
+	return false
+}
+
+// downstreamHeaders builds the same service-to-service headers as the mod-service client
+func downstreamHeaders(token string) (map[string]string, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	accessKey, err := baseServiceHelpers.NewSecurityAccessKey().Encrypt(
+		baseServiceHelpers.GetEnv("APP_KEY", "secret") + "@" + timestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var userToken string
+	if token != "" {
+		userToken = fmt.Sprintf("Bearer %s", token)
+	}
+
+	return map[string]string{
+		"Accept":        "application/json",
+		"Content-Type":  "application/json",
+		"Authorization": userToken,
+		"Access-From":   "service",
+		"Access-Key":    accessKey,
+		"App-Locale":    baseServiceHelpers.GetInstance().GetLocale(),
+	}, nil
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type dossierService struct {
+	dossierRepo           repository.DossierRepository
+	syllabusRepo          repository.SyllabusRepository
+	transcriptRepo        repository.TranscriptRepository
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	fileService           *FileService
+	advisorAssignments    AdvisorAssignmentService
+}
+
+type DossierService interface {
+	Render(ctx context.Context, registrationID string, token string) ([]byte, error)
+	Generate(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error)
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error)
+}
+
+func NewDossierService(
+	dossierRepo repository.DossierRepository,
+	syllabusRepo repository.SyllabusRepository,
+	transcriptRepo repository.TranscriptRepository,
+	userManagementService UserManagementService,
+	registrationService RegistrationManagementService,
+	fileService *FileService,
+	advisorAssignments AdvisorAssignmentService,
+) DossierService {
+	return &dossierService{
+		dossierRepo:           dossierRepo,
+		syllabusRepo:          syllabusRepo,
+		transcriptRepo:        transcriptRepo,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		fileService:           fileService,
+		advisorAssignments:    advisorAssignments,
+	}
+}
+
+// dossierAccess loads the schedules of a registration and checks that the caller may take action on its dossier
+func (s *dossierService) dossierAccess(ctx context.Context, registrationID string, user dto.User, action string) ([]entity.ReportSchedule, error) {
+	reportSchedules, err := s.dossierRepo.FindReportSchedulesByRegistrationID(ctx, registrationID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reportSchedules) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, action, reportSchedulePolicyResource(helper.POLICY_RESOURCE_DOSSIER, reportSchedules[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	return reportSchedules, nil
+}
+
+// Render builds the monitoring dossier PDF of a registration
+func (s *dossierService) Render(ctx context.Context, registrationID string, token string) ([]byte, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.render(ctx, registrationID, token, user, helper.POLICY_ACTION_VIEW)
+}
+
+func (s *dossierService) render(ctx context.Context, registrationID string, token string, user dto.User, action string) ([]byte, error) {
+	reportSchedules, err := s.dossierAccess(ctx, registrationID, user, action)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.dossierDocument(ctx, registrationID, token, reportSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	err = helper.RenderDossierPDF(document, &out)
+	if err != nil {
+		log.Println("ERROR RENDERING DOSSIER: ", err)
+		return nil, err
+	}
+
+	return out.Bytes(), nil
+}
+
+func (s *dossierService) dossierDocument(ctx context.Context, registrationID string, token string, reportSchedules []entity.ReportSchedule) (helper.DossierDocument, error) {
+	first := reportSchedules[0]
+
+	// the student name and activity name are informative only, a failed lookup leaves them blank
+	student, err := s.userManagementService.GetUserByID(ctx, "GET", token, first.UserID)
+	if err != nil {
+		log.Println("ERROR GETTING DOSSIER STUDENT: ", err)
+	}
+
+	activityName := first.ActivityName
+	if activityName == "" {
+		registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+		if err != nil {
+			log.Println("ERROR GETTING DOSSIER REGISTRATION: ", err)
+		}
+		activityName = registration.ActivityName
+	}
+
+	document := helper.DossierDocument{
+		RegistrationID: registrationID,
+		StudentName:    student.Name,
+		StudentNRP:     first.UserNRP,
+		ActivityName:   activityName,
+		AdvisorEmail:   first.AcademicAdvisorEmail,
+		GeneratedAt:    time.Now(),
+	}
+
+	for _, reportSchedule := range reportSchedules {
+		dossierReport := helper.DossierReport{
+			ReportType: reportSchedule.ReportType,
+			Week:       reportSchedule.Week,
+			StartDate:  reportSchedule.StartDate,
+			EndDate:    reportSchedule.EndDate,
+		}
+
+		// reports are ordered newest first, drafts were never handed in
+		for _, report := range reportSchedule.Report {
+			if report.AcademicAdvisorStatus == helper.REPORT_STATUS_DRAFT {
+				continue
+			}
+
+			dossierReport.Submitted = true
+			dossierReport.Title = report.Title
+			dossierReport.Content = report.Content
+			dossierReport.Status = report.AcademicAdvisorStatus
+			dossierReport.Feedback = report.Feedback
+			dossierReport.SubmittedAt = report.SubmittedAt
+			dossierReport.ReviewedAt = report.ReviewedAt
+			dossierReport.IsLate = report.IsLate
+			break
+		}
+
+		document.Reports = append(document.Reports, dossierReport)
+	}
+
+	syllabus, err := s.syllabusRepo.FindByRegistrationID(ctx, registrationID, nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.DossierDocument{}, err
+	}
+	if err == nil {
+		document.Syllabus = &helper.DossierAttachment{Title: syllabus.Title, FileStorageID: syllabus.FileStorageID, UploadedAt: syllabus.CreatedAt}
+	}
+
+	transcript, err := s.transcriptRepo.FindByRegistrationID(ctx, registrationID, nil)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.DossierDocument{}, err
+	}
+	if err == nil {
+		document.Transcript = &helper.DossierAttachment{Title: transcript.Title, FileStorageID: transcript.FileStorageID, UploadedAt: transcript.CreatedAt}
+	}
+
+	return document, nil
+}
+
+// Generate renders the dossier, uploads it to file storage and keeps its file ID for later download
+func (s *dossierService) Generate(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	content, err := s.render(ctx, registrationID, token, user, helper.POLICY_ACTION_CREATE)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	now := time.Now()
+	file, err := helper.NewMultipartFileHeader(fmt.Sprintf("dossier-%s-%s.pdf", registrationID, now.Format("20060102150405")), "application/pdf", content)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	result, err := s.fileService.Upload(ctx, file)
+	if err != nil {
+		log.Println("ERROR UPLOADING DOSSIER: ", err)
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	dossier, err := s.dossierRepo.Save(ctx, entity.RegistrationDossier{
+		ID:               uuid.New(),
+		RegistrationID:   registrationID,
+		FileStorageID:    result.FileID,
+		GeneratedByID:    user.ID,
+		GeneratedByEmail: user.Email,
+		GeneratedAt:      &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}, nil)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	return toRegistrationDossierResponse(dossier), nil
+}
+
+// FindByRegistrationID returns the last uploaded dossier of a registration
+func (s *dossierService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationDossierResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	_, err = s.dossierAccess(ctx, registrationID, user, helper.POLICY_ACTION_VIEW)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	dossier, err := s.dossierRepo.FindByRegistrationID(ctx, registrationID, nil)
+	if err != nil {
+		return dto.RegistrationDossierResponse{}, err
+	}
+
+	return toRegistrationDossierResponse(dossier), nil
+}
+
+func toRegistrationDossierResponse(dossier entity.RegistrationDossier) dto.RegistrationDossierResponse {
+	response := dto.RegistrationDossierResponse{
+		ID:               dossier.ID.String(),
+		RegistrationID:   dossier.RegistrationID,
+		FileStorageID:    dossier.FileStorageID,
+		GeneratedByID:    dossier.GeneratedByID,
+		GeneratedByEmail: dossier.GeneratedByEmail,
+	}
+
+	if dossier.GeneratedAt != nil {
+		response.GeneratedAt = dossier.GeneratedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
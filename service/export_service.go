@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// EXPORT_NAME_LOOKUP_CONCURRENCY bounds the user lookups in flight while resolving student names
+const EXPORT_NAME_LOOKUP_CONCURRENCY = 10
+
+var (
+	exportScheduleHeader = []string{"NRP", "Name", "Activity", "Report Type", "Week", "Start Date", "End Date", "Academic Advisor", "Submission Status", "Report Status", "Feedback", "Submitted At", "Late", "Reviewed At"}
+	exportReportHeader   = []string{"NRP", "Name", "Activity", "Report Type", "Week", "Start Date", "End Date", "Academic Advisor", "Report Title", "Report Status", "Feedback", "Submitted At", "Late", "Reviewed At"}
+	exportDocumentHeader = []string{"NRP", "Name", "Activity", "Academic Advisor", "Registration ID", "Syllabus Uploaded", "Transcript Uploaded"}
+)
+
+type exportService struct {
+	exportRepo            repository.ExportRepository
+	userManagementService UserManagementService
+}
+
+type ExportService interface {
+	ExportReportSchedules(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error
+	ExportReports(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error
+	ExportDocuments(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error
+}
+
+func NewExportService(exportRepo repository.ExportRepository, userManagementService UserManagementService) ExportService {
+	return &exportService{
+		exportRepo:            exportRepo,
+		userManagementService: userManagementService,
+	}
+}
+
+// exportFilter scopes an export to the caller: advisors only ever export the registrations they
+// advise, as their primary or a co-advisor. A delegate only reviews reports and exports nothing.
+func (s *exportService) exportFilter(ctx context.Context, token string, filter dto.ExportFilterRequest) (repository.ExportFilter, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return repository.ExportFilter{}, err
+	}
+
+	exportFilter := repository.ExportFilter{
+		AdvisorEmail:     filter.AdvisorEmail,
+		UserNRP:          filter.UserNRP,
+		ActivityName:     filter.ActivityName,
+		SubmissionStatus: filter.SubmissionStatus,
+	}
+
+	scope, ok := helper.PolicyScope(user, helper.POLICY_RESOURCE_EXPORT, helper.POLICY_ACTION_LIST)
+	if !ok {
+		return repository.ExportFilter{}, fmt.Errorf("%w: %s may not export", helper.ErrForbidden, user.Role)
+	}
+
+	if scope == helper.POLICY_SCOPE_ASSIGNED {
+		if user.Email == "" {
+			return repository.ExportFilter{}, errors.New("advisor email not found")
+		}
+		exportFilter.AdvisedBy = user.Email
+	}
+
+	return exportFilter, nil
+}
+
+// studentNames resolves, before any row is streamed, the names of the exported students that are not
+// in a registration snapshot, so no user lookup runs while the rows are read. At most
+// EXPORT_NAME_LOOKUP_CONCURRENCY lookups run at once. A row's snapshot name wins.
+func (s *exportService) studentNames(ctx context.Context, token string, exportFilter repository.ExportFilter) (func(userID string, snapshotName string) string, error) {
+	userIDs, err := s.exportRepo.FindUnnamedUserIDs(ctx, exportFilter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(userIDs))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, EXPORT_NAME_LOOKUP_CONCURRENCY)
+
+	for _, userID := range uniqueIDs(userIDs) {
+		wg.Add(1)
+		go func(userID string) {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			// a failed lookup leaves the name blank rather than failing the whole export
+			user, err := s.userManagementService.GetUserByID(ctx, "GET", token, userID)
+			if err != nil {
+				log.Println("ERROR GETTING EXPORT STUDENT: ", err)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			names[userID] = user.Name
+		}(userID)
+	}
+
+	wg.Wait()
+
+	return func(userID string, snapshotName string) string {
+		if snapshotName != "" {
+			return snapshotName
+		}
+
+		return names[userID]
+	}, nil
+}
+
+// ExportReportSchedules writes every matching schedule with its latest report
+func (s *exportService) ExportReportSchedules(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	exportFilter, err := s.exportFilter(ctx, token, filter)
+	if err != nil {
+		return err
+	}
+
+	studentName, err := s.studentNames(ctx, token, exportFilter)
+	if err != nil {
+		return err
+	}
+
+	err = writer.WriteRow(exportScheduleHeader)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	err = s.exportRepo.StreamReportSchedules(ctx, exportFilter, func(row repository.ExportScheduleRow) error {
+		submitted := row.ReportID != nil && stringValue(row.AcademicAdvisorStatus) != helper.REPORT_STATUS_DRAFT
+		isLate := row.IsLate != nil && *row.IsLate
+
+		return writer.WriteRow(append(exportScheduleColumns(row, studentName(row.UserID, row.UserName)),
+			helper.ResolveSubmissionStatus(row.EndDate, submitted, isLate, now),
+			stringValue(row.AcademicAdvisorStatus),
+			stringValue(row.Feedback),
+			formatExportTime(row.SubmittedAt),
+			formatExportLate(row),
+			formatExportTime(row.ReviewedAt),
+		))
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// ExportReports writes every report of the matching schedules, including earlier versions
+func (s *exportService) ExportReports(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	exportFilter, err := s.exportFilter(ctx, token, filter)
+	if err != nil {
+		return err
+	}
+
+	studentName, err := s.studentNames(ctx, token, exportFilter)
+	if err != nil {
+		return err
+	}
+
+	err = writer.WriteRow(exportReportHeader)
+	if err != nil {
+		return err
+	}
+
+	err = s.exportRepo.StreamReports(ctx, exportFilter, func(row repository.ExportScheduleRow) error {
+		return writer.WriteRow(append(exportScheduleColumns(row, studentName(row.UserID, row.UserName)),
+			stringValue(row.Title),
+			stringValue(row.AcademicAdvisorStatus),
+			stringValue(row.Feedback),
+			formatExportTime(row.SubmittedAt),
+			formatExportLate(row),
+			formatExportTime(row.ReviewedAt),
+		))
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// ExportDocuments writes the syllabus and transcript upload state of every matching registration
+func (s *exportService) ExportDocuments(ctx context.Context, token string, filter dto.ExportFilterRequest, writer helper.TableWriter) error {
+	exportFilter, err := s.exportFilter(ctx, token, filter)
+	if err != nil {
+		return err
+	}
+
+	studentName, err := s.studentNames(ctx, token, exportFilter)
+	if err != nil {
+		return err
+	}
+
+	err = writer.WriteRow(exportDocumentHeader)
+	if err != nil {
+		return err
+	}
+
+	err = s.exportRepo.StreamDocuments(ctx, exportFilter, func(row repository.ExportDocumentRow) error {
+		return writer.WriteRow([]string{
+			row.UserNRP,
+			studentName(row.UserID, row.UserName),
+			row.ActivityName,
+			row.AcademicAdvisorEmail,
+			row.RegistrationID,
+			formatExportBool(row.SyllabusUploaded),
+			formatExportBool(row.TranscriptUploaded),
+		})
+	}, nil)
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+func exportScheduleColumns(row repository.ExportScheduleRow, name string) []string {
+	return []string{
+		row.UserNRP,
+		name,
+		row.ActivityName,
+		row.ReportType,
+		strconv.Itoa(row.Week),
+		formatExportTime(row.StartDate),
+		formatExportTime(row.EndDate),
+		row.AcademicAdvisorEmail,
+	}
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func formatExportLate(row repository.ExportScheduleRow) string {
+	if row.IsLate == nil {
+		return ""
+	}
+
+	return formatExportBool(*row.IsLate)
+}
+
+func formatExportBool(value bool) string {
+	if value {
+		return "YES"
+	}
+
+	return "NO"
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -1,15 +1,62 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"monitoring-service/helper"
+
 	storageService "github.com/SIM-MBKM/filestorage/storage"
+	"github.com/sony/gobreaker"
 )
 
 type FileService struct {
 	storage *storageService.FileStorageManager
+	breaker *gobreaker.CircuitBreaker
+	config  helper.DownstreamConfig
 }
 
-func NewFileService(config *storageService.Config, tokenManager *storageService.CacheTokenManager) *FileService {
+func NewFileService(config *storageService.Config, tokenManager *storageService.CacheTokenManager, downstreamConfig helper.DownstreamConfig) *FileService {
 	return &FileService{
 		storage: storageService.NewFileStorageManager(config, tokenManager),
+		breaker: newCircuitBreaker("file-storage", downstreamConfig),
+		config:  downstreamConfig,
+	}
+}
+
+// Upload stores a file in GCS. Uploads are not retried, and since the storage client cannot be
+// cancelled the caller stops waiting after UploadTimeout while the upload itself runs to completion.
+func (s *FileService) Upload(ctx context.Context, file *multipart.FileHeader) (*storageService.FileResponse, error) {
+	if s.config.UploadTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.UploadTimeout)
+		defer cancel()
+	}
+
+	type uploadResult struct {
+		response *storageService.FileResponse
+		err      error
+	}
+
+	done := make(chan uploadResult, 1)
+	go func() {
+		res, err := s.breaker.Execute(func() (interface{}, error) {
+			return s.storage.GcsUpload(file, "sim_mbkm", "", "")
+		})
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			err = ErrServiceUnavailable
+		}
+		if err != nil {
+			done <- uploadResult{err: err}
+			return
+		}
+		done <- uploadResult{response: res.(*storageService.FileResponse)}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-done:
+		return result.response, result.err
 	}
 }
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type monitoringService struct {
+	monitoringRepo        repository.MonitoringRepository
+	reportRepo            repository.ReportRepository
+	reportScheduleRepo    repository.ReportScheduleReposiotry
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	advisorAssignments    AdvisorAssignmentService
+	outboxService         OutboxService
+	auditService          AuditService
+}
+
+// MonitoringService covers the work of monitoring and evaluation lecturers (dosen pemonev): who
+// monitors which registration, the notes they leave on reports and their monitoring visits
+type MonitoringService interface {
+	FindAssignments(ctx context.Context, registrationID string, token string) ([]dto.MonitorAssignmentResponse, error)
+	Assign(ctx context.Context, request dto.MonitorAssignmentRequest, token string) (dto.MonitorAssignmentResponse, error)
+	Unassign(ctx context.Context, id string, token string) error
+	FindEvaluations(ctx context.Context, reportID string, token string) ([]dto.ReportEvaluationResponse, error)
+	Evaluate(ctx context.Context, reportID string, request dto.ReportEvaluationRequest, token string) (dto.ReportEvaluationResponse, error)
+	FindVisits(ctx context.Context, registrationID string, token string) ([]dto.MonitoringVisitResponse, error)
+	RecordVisit(ctx context.Context, registrationID string, request dto.MonitoringVisitRequest, token string) (dto.MonitoringVisitResponse, error)
+}
+
+func NewMonitoringService(monitoringRepo repository.MonitoringRepository, reportRepo repository.ReportRepository, reportScheduleRepo repository.ReportScheduleReposiotry, userManagementService UserManagementService, registrationService RegistrationManagementService, advisorAssignments AdvisorAssignmentService, outboxService OutboxService, auditService AuditService) MonitoringService {
+	return &monitoringService{
+		monitoringRepo:        monitoringRepo,
+		reportRepo:            reportRepo,
+		reportScheduleRepo:    reportScheduleRepo,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		advisorAssignments:    advisorAssignments,
+		outboxService:         outboxService,
+		auditService:          auditService,
+	}
+}
+
+// authorizeRegistration checks the current user against the policy for action on the kind of
+// resource of a registration, and returns that user
+func (s *monitoringService) authorizeRegistration(ctx context.Context, token string, action string, kind string, registrationID string) (dto.User, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, action, registrationPolicyResource(kind, registrationID, registration))
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	return user, nil
+}
+
+// authorizeReport checks the current user against the policy for action on the evaluations of a
+// report, through the schedule it was submitted for, and returns that user and the schedule
+func (s *monitoringService) authorizeReport(ctx context.Context, token string, action string, reportID string) (dto.User, entity.ReportSchedule, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.User{}, entity.ReportSchedule{}, err
+	}
+
+	report, err := s.reportRepo.FindByID(ctx, reportID, nil)
+	if err != nil {
+		return dto.User{}, entity.ReportSchedule{}, err
+	}
+
+	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, report.ReportScheduleID, nil)
+	if err != nil {
+		return dto.User{}, entity.ReportSchedule{}, err
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, action, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT_EVALUATION, reportSchedule))
+	if err != nil {
+		return dto.User{}, entity.ReportSchedule{}, err
+	}
+
+	return user, reportSchedule, nil
+}
+
+// FindAssignments lists the monitoring lecturers of a registration
+func (s *monitoringService) FindAssignments(ctx context.Context, registrationID string, token string) ([]dto.MonitorAssignmentResponse, error) {
+	_, err := s.authorizeRegistration(ctx, token, helper.POLICY_ACTION_VIEW, helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.monitoringRepo.FindAssignmentsByRegistrationID(ctx, registrationID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.MonitorAssignmentResponse, 0, len(assignments))
+	for _, assignment := range assignments {
+		responses = append(responses, toMonitorAssignmentResponse(assignment))
+	}
+
+	return responses, nil
+}
+
+// Assign makes a monitoring lecturer a monitor of a registration
+func (s *monitoringService) Assign(ctx context.Context, request dto.MonitorAssignmentRequest, token string) (dto.MonitorAssignmentResponse, error) {
+	monitorEmail := strings.TrimSpace(request.MonitorEmail)
+	if request.RegistrationID == "" || monitorEmail == "" {
+		return dto.MonitorAssignmentResponse{}, fmt.Errorf("%w: registration_id and monitor_email are required", helper.ErrInvalidMonitorAssignment)
+	}
+
+	user, err := s.authorizeRegistration(ctx, token, helper.POLICY_ACTION_CREATE, helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, request.RegistrationID)
+	if err != nil {
+		return dto.MonitorAssignmentResponse{}, err
+	}
+
+	_, err = s.monitoringRepo.FindAssignmentByRegistrationIDAndMonitorEmail(ctx, request.RegistrationID, monitorEmail, nil)
+	if err == nil {
+		return dto.MonitorAssignmentResponse{}, fmt.Errorf("%w: %s", helper.ErrMonitorAssignmentExists, monitorEmail)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.MonitorAssignmentResponse{}, err
+	}
+
+	now := time.Now()
+	assignment := entity.MonitorAssignment{
+		ID:             uuid.New(),
+		RegistrationID: request.RegistrationID,
+		MonitorID:      request.MonitorID,
+		MonitorEmail:   monitorEmail,
+		AssignedBy:     user.Email,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	var created entity.MonitorAssignment
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err = s.monitoringRepo.CreateAssignment(ctx, assignment, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, created.ID.String(), nil, created)
+	})
+	if err != nil {
+		return dto.MonitorAssignmentResponse{}, err
+	}
+
+	return toMonitorAssignmentResponse(created), nil
+}
+
+// Unassign stops a monitoring lecturer from monitoring a registration. The evaluations and visits
+// they recorded are kept.
+func (s *monitoringService) Unassign(ctx context.Context, id string, token string) error {
+	assignment, err := s.monitoringRepo.FindAssignmentByID(ctx, id, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.ErrMonitorAssignmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	err = helper.Authorize(user, helper.POLICY_ACTION_DELETE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT,
+		RegistrationID: assignment.RegistrationID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.monitoringRepo.DestroyAssignment(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, id, assignment, nil)
+	})
+}
+
+// FindEvaluations lists the notes monitoring lecturers left on a report, oldest first
+func (s *monitoringService) FindEvaluations(ctx context.Context, reportID string, token string) ([]dto.ReportEvaluationResponse, error) {
+	_, _, err := s.authorizeReport(ctx, token, helper.POLICY_ACTION_VIEW, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluations, err := s.monitoringRepo.FindEvaluationsByReportID(ctx, reportID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ReportEvaluationResponse, 0, len(evaluations))
+	for _, evaluation := range evaluations {
+		responses = append(responses, toReportEvaluationResponse(evaluation))
+	}
+
+	return responses, nil
+}
+
+// Evaluate leaves a monitoring note on a report. It does not touch the advisor approval or feedback.
+func (s *monitoringService) Evaluate(ctx context.Context, reportID string, request dto.ReportEvaluationRequest, token string) (dto.ReportEvaluationResponse, error) {
+	note := strings.TrimSpace(request.Note)
+	if note == "" {
+		return dto.ReportEvaluationResponse{}, fmt.Errorf("%w: note is required", helper.ErrInvalidReportEvaluation)
+	}
+
+	user, reportSchedule, err := s.authorizeReport(ctx, token, helper.POLICY_ACTION_CREATE, reportID)
+	if err != nil {
+		return dto.ReportEvaluationResponse{}, err
+	}
+
+	now := time.Now()
+	evaluation := entity.ReportEvaluation{
+		ID:             uuid.New(),
+		ReportID:       reportID,
+		RegistrationID: reportSchedule.RegistrationID,
+		MonitorID:      user.ID,
+		MonitorEmail:   user.Email,
+		Note:           note,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	var created entity.ReportEvaluation
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err = s.monitoringRepo.CreateEvaluation(ctx, evaluation, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_REPORT_EVALUATION, created.ID.String(), nil, created)
+	})
+	if err != nil {
+		return dto.ReportEvaluationResponse{}, err
+	}
+
+	return toReportEvaluationResponse(created), nil
+}
+
+// FindVisits lists the monitoring visits of a registration, the latest first
+func (s *monitoringService) FindVisits(ctx context.Context, registrationID string, token string) ([]dto.MonitoringVisitResponse, error) {
+	_, err := s.authorizeRegistration(ctx, token, helper.POLICY_ACTION_VIEW, helper.POLICY_RESOURCE_MONITORING_VISIT, registrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	visits, err := s.monitoringRepo.FindVisitsByRegistrationID(ctx, registrationID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.MonitoringVisitResponse, 0, len(visits))
+	for _, visit := range visits {
+		responses = append(responses, toMonitoringVisitResponse(visit))
+	}
+
+	return responses, nil
+}
+
+// RecordVisit stores the assessment of a monitoring visit. A visit can not be dated in the future.
+func (s *monitoringService) RecordVisit(ctx context.Context, registrationID string, request dto.MonitoringVisitRequest, token string) (dto.MonitoringVisitResponse, error) {
+	visitedAt, err := helper.ParseDate(request.VisitDate)
+	if err != nil {
+		return dto.MonitoringVisitResponse{}, fmt.Errorf("%w: visit_date must be RFC3339 or YYYY-MM-DD", helper.ErrInvalidMonitoringVisit)
+	}
+
+	now := time.Now()
+	if visitedAt.After(now) {
+		return dto.MonitoringVisitResponse{}, fmt.Errorf("%w: visit_date is in the future", helper.ErrInvalidMonitoringVisit)
+	}
+	if request.Score == nil || !helper.ValidateMonitoringScore(*request.Score) {
+		return dto.MonitoringVisitResponse{}, fmt.Errorf("%w: score must be between %d and %d", helper.ErrInvalidMonitoringVisit, helper.MONITORING_SCORE_MIN, helper.MONITORING_SCORE_MAX)
+	}
+
+	user, err := s.authorizeRegistration(ctx, token, helper.POLICY_ACTION_CREATE, helper.POLICY_RESOURCE_MONITORING_VISIT, registrationID)
+	if err != nil {
+		return dto.MonitoringVisitResponse{}, err
+	}
+
+	visit := entity.MonitoringVisit{
+		ID:             uuid.New(),
+		RegistrationID: registrationID,
+		MonitorID:      user.ID,
+		MonitorEmail:   user.Email,
+		VisitedAt:      visitedAt,
+		Score:          *request.Score,
+		Notes:          strings.TrimSpace(request.Notes),
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	var created entity.MonitoringVisit
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err = s.monitoringRepo.CreateVisit(ctx, visit, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_MONITORING_VISIT, created.ID.String(), nil, created)
+	})
+	if err != nil {
+		return dto.MonitoringVisitResponse{}, err
+	}
+
+	return toMonitoringVisitResponse(created), nil
+}
+
+// registrationPolicyResource describes the kind of resource of a registration to the policy
+func registrationPolicyResource(kind string, registrationID string, registration dto.Registration) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           kind,
+		RegistrationID: registrationID,
+		OwnerID:        registration.UserID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	}
+}
+
+func toMonitorAssignmentResponse(assignment entity.MonitorAssignment) dto.MonitorAssignmentResponse {
+	return dto.MonitorAssignmentResponse{
+		ID:             assignment.ID.String(),
+		RegistrationID: assignment.RegistrationID,
+		MonitorID:      assignment.MonitorID,
+		MonitorEmail:   assignment.MonitorEmail,
+		AssignedBy:     assignment.AssignedBy,
+		CreatedAt:      assignment.CreatedAt,
+	}
+}
+
+func toReportEvaluationResponse(evaluation entity.ReportEvaluation) dto.ReportEvaluationResponse {
+	return dto.ReportEvaluationResponse{
+		ID:             evaluation.ID.String(),
+		ReportID:       evaluation.ReportID,
+		RegistrationID: evaluation.RegistrationID,
+		MonitorID:      evaluation.MonitorID,
+		MonitorEmail:   evaluation.MonitorEmail,
+		Note:           evaluation.Note,
+		CreatedAt:      evaluation.CreatedAt,
+	}
+}
+
+func toMonitoringVisitResponse(visit entity.MonitoringVisit) dto.MonitoringVisitResponse {
+	return dto.MonitoringVisitResponse{
+		ID:             visit.ID.String(),
+		RegistrationID: visit.RegistrationID,
+		MonitorID:      visit.MonitorID,
+		MonitorEmail:   visit.MonitorEmail,
+		VisitedAt:      visit.VisitedAt,
+		Score:          visit.Score,
+		Notes:          visit.Notes,
+		CreatedAt:      visit.CreatedAt,
+	}
+}
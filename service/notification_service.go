@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type notificationService struct {
+	baseRepo         repository.BaseRepository
+	notificationRepo repository.NotificationRepository
+	brokerService    BrokerService
+	config           helper.NotificationConfig
+}
+
+type NotificationService interface {
+	Enqueue(ctx context.Context, tx *gorm.DB, notifications ...dto.NotificationRequest) error
+	Start(ctx context.Context)
+	DeliverDue(ctx context.Context) (int, error)
+	FindFailed(ctx context.Context, pagReq dto.PaginationRequest) ([]dto.NotificationResponse, dto.PaginationResponse, error)
+	Resend(ctx context.Context, id string) (dto.NotificationResponse, error)
+}
+
+func NewNotificationService(baseRepo repository.BaseRepository, notificationRepo repository.NotificationRepository, brokerService BrokerService, config helper.NotificationConfig) NotificationService {
+	return &notificationService{
+		baseRepo:         baseRepo,
+		notificationRepo: notificationRepo,
+		brokerService:    brokerService,
+		config:           config,
+	}
+}
+
+// Enqueue queues notifications in tx for the background worker, they are due right away
+func (s *notificationService) Enqueue(ctx context.Context, tx *gorm.DB, notifications ...dto.NotificationRequest) error {
+	now := time.Now()
+
+	entities := make([]entity.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		entities = append(entities, entity.Notification{
+			ID:            uuid.New(),
+			SenderName:    notification.SenderName,
+			SenderEmail:   notification.SenderEmail,
+			ReceiverEmail: notification.ReceiverEmail,
+			Type:          notification.Type,
+			Subject:       notification.Subject,
+			Message:       notification.Message,
+			Status:        helper.NOTIFICATION_STATUS_PENDING,
+			NextAttemptAt: &now,
+			BaseModel: entity.BaseModel{
+				CreatedAt: &now,
+				UpdatedAt: &now,
+			},
+		})
+	}
+
+	return s.notificationRepo.Create(ctx, entities, tx)
+}
+
+// Start delivers due notifications on every interval until ctx is cancelled
+func (s *notificationService) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if s.config.Interval <= 0 {
+		log.Println("ERROR STARTING NOTIFICATION DELIVERY: interval must be positive")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.DeliverDue(ctx)
+		if err != nil {
+			log.Println("ERROR DELIVERING NOTIFICATIONS: ", err)
+		} else if sent > 0 {
+			log.Printf("delivered %d notifications", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue sends one batch of due notifications and records every attempt. A failed notification is
+// retried with exponential backoff until it has used up MaxAttempts, then it is marked failed.
+// The batch is claimed in a short transaction so no row stays locked while the broker is called,
+// delivery is at least once: a sent notification whose attempt could not be stored is sent again.
+func (s *notificationService) DeliverDue(ctx context.Context) (int, error) {
+	if s.config.ServiceToken == "" {
+		return 0, errors.New("notification service token is not configured")
+	}
+
+	var notifications []entity.Notification
+	err := s.baseRepo.WithinTx(ctx, nil, func(tx *gorm.DB) error {
+		now := time.Now()
+
+		var err error
+		notifications, err = s.notificationRepo.FindDue(ctx, now, s.config.BatchSize, tx)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]string, 0, len(notifications))
+		for _, notification := range notifications {
+			ids = append(ids, notification.ID.String())
+		}
+
+		return s.notificationRepo.Claim(ctx, ids, now.Add(s.config.ClaimDuration), tx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, notification := range notifications {
+		_, sendErr := s.brokerService.SendNotification(ctx, dto.NotificationRequest{
+			SenderName:    notification.SenderName,
+			SenderEmail:   notification.SenderEmail,
+			ReceiverEmail: notification.ReceiverEmail,
+			Type:          notification.Type,
+			Subject:       notification.Subject,
+			Message:       notification.Message,
+		}, "POST", s.config.ServiceToken)
+		if sendErr != nil {
+			log.Printf("ERROR SENDING NOTIFICATION %s: %v", notification.ID, sendErr)
+		} else {
+			sent++
+		}
+
+		attempt := s.applyAttempt(&notification, sendErr, time.Now())
+		if err := s.notificationRepo.RecordAttempt(ctx, notification, attempt, nil); err != nil {
+			// the claim runs out and the notification is tried again
+			log.Printf("ERROR RECORDING NOTIFICATION ATTEMPT %s: %v", notification.ID, err)
+		}
+	}
+
+	return sent, nil
+}
+
+// applyAttempt moves notification to its state after an attempt that ended with sendErr
+func (s *notificationService) applyAttempt(notification *entity.Notification, sendErr error, attemptedAt time.Time) entity.NotificationAttempt {
+	notification.Attempts++
+	notification.UpdatedAt = &attemptedAt
+
+	attempt := entity.NotificationAttempt{
+		ID:             uuid.New(),
+		NotificationID: notification.ID.String(),
+		Attempt:        notification.Attempts,
+		Succeeded:      sendErr == nil,
+		AttemptedAt:    &attemptedAt,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &attemptedAt,
+			UpdatedAt: &attemptedAt,
+		},
+	}
+
+	if sendErr == nil {
+		notification.Status = helper.NOTIFICATION_STATUS_SENT
+		notification.SentAt = &attemptedAt
+		notification.NextAttemptAt = nil
+		notification.LastError = ""
+		return attempt
+	}
+
+	attempt.Error = sendErr.Error()
+	notification.LastError = sendErr.Error()
+	if nextAttemptAt, ok := helper.NextNotificationAttempt(s.config, notification.Attempts, attemptedAt); ok {
+		notification.NextAttemptAt = &nextAttemptAt
+	} else {
+		notification.Status = helper.NOTIFICATION_STATUS_FAILED
+		notification.NextAttemptAt = nil
+	}
+
+	return attempt
+}
+
+// FindFailed returns a page of notifications that used up their attempts, with their attempt log
+func (s *notificationService) FindFailed(ctx context.Context, pagReq dto.PaginationRequest) ([]dto.NotificationResponse, dto.PaginationResponse, error) {
+	notifications, total, err := s.notificationRepo.FindByStatus(ctx, helper.NOTIFICATION_STATUS_FAILED, &pagReq, nil)
+	if err != nil {
+		return nil, dto.PaginationResponse{}, err
+	}
+
+	ids := make([]string, 0, len(notifications))
+	for _, notification := range notifications {
+		ids = append(ids, notification.ID.String())
+	}
+
+	attempts, err := s.notificationRepo.FindAttempts(ctx, ids, nil)
+	if err != nil {
+		return nil, dto.PaginationResponse{}, err
+	}
+
+	notificationResponses := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		notificationResponses = append(notificationResponses, toNotificationResponse(notification, attempts[notification.ID.String()]))
+	}
+
+	return notificationResponses, helper.MetaDataPagination(total, pagReq), nil
+}
+
+// Resend queues a failed notification again, it is picked up by the next delivery run
+func (s *notificationService) Resend(ctx context.Context, id string) (dto.NotificationResponse, error) {
+	resent, err := s.notificationRepo.Resend(ctx, id, time.Now(), nil)
+	if err != nil {
+		return dto.NotificationResponse{}, err
+	}
+
+	notification, err := s.notificationRepo.FindByID(ctx, id, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.NotificationResponse{}, helper.ErrNotificationNotFound
+	}
+	if err != nil {
+		return dto.NotificationResponse{}, err
+	}
+
+	if !resent {
+		return dto.NotificationResponse{}, helper.ErrNotificationNotFailed
+	}
+
+	return toNotificationResponse(notification, nil), nil
+}
+
+func toNotificationResponse(notification entity.Notification, attempts []entity.NotificationAttempt) dto.NotificationResponse {
+	attemptLog := make([]dto.NotificationAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		attemptLog = append(attemptLog, dto.NotificationAttemptResponse{
+			Attempt:     attempt.Attempt,
+			Succeeded:   attempt.Succeeded,
+			Error:       attempt.Error,
+			AttemptedAt: attempt.AttemptedAt,
+		})
+	}
+
+	return dto.NotificationResponse{
+		ID:            notification.ID.String(),
+		SenderName:    notification.SenderName,
+		SenderEmail:   notification.SenderEmail,
+		ReceiverEmail: notification.ReceiverEmail,
+		Type:          notification.Type,
+		Subject:       notification.Subject,
+		Message:       notification.Message,
+		Status:        notification.Status,
+		Attempts:      notification.Attempts,
+		NextAttemptAt: notification.NextAttemptAt,
+		LastError:     notification.LastError,
+		SentAt:        notification.SentAt,
+		CreatedAt:     notification.CreatedAt,
+		AttemptLog:    attemptLog,
+	}
+}
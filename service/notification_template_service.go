@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type notificationTemplateService struct {
+	notificationTemplateRepo repository.NotificationTemplateRepository
+	userManagementService    UserManagementService
+	config                   helper.NotificationTemplateConfig
+}
+
+type NotificationTemplateService interface {
+	Render(ctx context.Context, notification dto.NotificationRequest, data dto.NotificationTemplateData) (dto.NotificationRequest, error)
+	Index(ctx context.Context) ([]dto.NotificationTemplateResponse, error)
+	Create(ctx context.Context, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error)
+	Update(ctx context.Context, id string, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error)
+	Destroy(ctx context.Context, id string) error
+	GetLocale(ctx context.Context, token string) (dto.UserLocaleResponse, error)
+	UpdateLocale(ctx context.Context, token string, request dto.UserLocaleRequest) (dto.UserLocaleResponse, error)
+}
+
+func NewNotificationTemplateService(notificationTemplateRepo repository.NotificationTemplateRepository, userManagementService UserManagementService, config helper.NotificationTemplateConfig) NotificationTemplateService {
+	return &notificationTemplateService{
+		notificationTemplateRepo: notificationTemplateRepo,
+		userManagementService:    userManagementService,
+		config:                   config,
+	}
+}
+
+// Render fills the subject and message of a notification from the template of its type, in the
+// locale of its receiver. A stored template wins over the embedded one, and a locale without any
+// template falls back to the default locale.
+func (s *notificationTemplateService) Render(ctx context.Context, notification dto.NotificationRequest, data dto.NotificationTemplateData) (dto.NotificationRequest, error) {
+	locale := s.receiverLocale(ctx, notification.ReceiverEmail)
+
+	notificationTemplate, err := s.findTemplate(ctx, notification.Type, locale)
+	if errors.Is(err, helper.ErrNotificationTemplateNotFound) && locale != s.defaultLocale() {
+		notificationTemplate, err = s.findTemplate(ctx, notification.Type, s.defaultLocale())
+	}
+	if err != nil {
+		return dto.NotificationRequest{}, err
+	}
+
+	data.Link = helper.NotificationLink(s.config.LinkBaseURL, data.Link)
+	notification.Subject, notification.Message, err = helper.RenderNotificationTemplate(notificationTemplate, data)
+	if err != nil {
+		return dto.NotificationRequest{}, err
+	}
+
+	return notification, nil
+}
+
+// receiverLocale is the locale the receiver chose, else the one the current request asked for
+func (s *notificationTemplateService) receiverLocale(ctx context.Context, receiverEmail string) string {
+	if receiverEmail != "" {
+		preference, err := s.notificationTemplateRepo.FindLocale(ctx, receiverEmail, nil)
+		if err == nil {
+			return preference.Locale
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Println("ERROR GETTING USER LOCALE: ", err)
+		}
+	}
+
+	if locale := helper.LocaleFromContext(ctx); locale != "" {
+		return locale
+	}
+
+	return s.defaultLocale()
+}
+
+func (s *notificationTemplateService) defaultLocale() string {
+	if locale, ok := helper.NormalizeLocale(s.config.DefaultLocale); ok {
+		return locale
+	}
+
+	return helper.LOCALE_ID
+}
+
+func (s *notificationTemplateService) findTemplate(ctx context.Context, notificationType string, locale string) (helper.NotificationTemplate, error) {
+	stored, err := s.notificationTemplateRepo.FindByTypeAndLocale(ctx, notificationType, locale, nil)
+	if err == nil {
+		return toHelperNotificationTemplate(stored), nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return helper.NotificationTemplate{}, err
+	}
+
+	if embedded, ok := helper.DefaultNotificationTemplate(notificationType, locale); ok {
+		return embedded, nil
+	}
+
+	return helper.NotificationTemplate{}, fmt.Errorf("%w: %s/%s", helper.ErrNotificationTemplateNotFound, notificationType, locale)
+}
+
+// Index lists the stored templates followed by the embedded ones they do not override
+func (s *notificationTemplateService) Index(ctx context.Context) ([]dto.NotificationTemplateResponse, error) {
+	stored, err := s.notificationTemplateRepo.Index(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := make(map[string]bool, len(stored))
+	notificationTemplateResponses := make([]dto.NotificationTemplateResponse, 0, len(stored))
+	for _, notificationTemplate := range stored {
+		overridden[notificationTemplate.Type+"/"+notificationTemplate.Locale] = true
+		notificationTemplateResponses = append(notificationTemplateResponses, toNotificationTemplateResponse(notificationTemplate))
+	}
+
+	for _, notificationTemplate := range helper.DefaultNotificationTemplates() {
+		if overridden[notificationTemplate.Type+"/"+notificationTemplate.Locale] {
+			continue
+		}
+
+		notificationTemplateResponses = append(notificationTemplateResponses, dto.NotificationTemplateResponse{
+			Type:    notificationTemplate.Type,
+			Locale:  notificationTemplate.Locale,
+			Subject: notificationTemplate.Subject,
+			Body:    notificationTemplate.Body,
+			Default: true,
+		})
+	}
+
+	return notificationTemplateResponses, nil
+}
+
+func (s *notificationTemplateService) Create(ctx context.Context, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error) {
+	locale, ok := helper.NormalizeLocale(request.Locale)
+	if !ok {
+		return dto.NotificationTemplateResponse{}, fmt.Errorf("%w: %q", helper.ErrUnsupportedLocale, request.Locale)
+	}
+
+	_, err := s.notificationTemplateRepo.FindByTypeAndLocale(ctx, request.Type, locale, nil)
+	if err == nil {
+		return dto.NotificationTemplateResponse{}, fmt.Errorf("%w: %s/%s", helper.ErrNotificationTemplateExists, request.Type, locale)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	now := time.Now()
+	notificationTemplate := entity.NotificationTemplate{
+		ID:      uuid.New(),
+		Type:    request.Type,
+		Locale:  locale,
+		Subject: request.Subject,
+		Body:    request.Body,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	if err := helper.ValidateNotificationTemplate(toHelperNotificationTemplate(notificationTemplate)); err != nil {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	created, err := s.notificationTemplateRepo.Create(ctx, notificationTemplate, nil)
+	if err != nil {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	return toNotificationTemplateResponse(created), nil
+}
+
+// Update changes the subject and body of a stored template, its type and locale stay as they are
+func (s *notificationTemplateService) Update(ctx context.Context, id string, request dto.NotificationTemplateRequest) (dto.NotificationTemplateResponse, error) {
+	notificationTemplate, err := s.findByID(ctx, id)
+	if err != nil {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	now := time.Now()
+	notificationTemplate.Subject = request.Subject
+	notificationTemplate.Body = request.Body
+	notificationTemplate.UpdatedAt = &now
+
+	if err := helper.ValidateNotificationTemplate(toHelperNotificationTemplate(notificationTemplate)); err != nil {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	err = s.notificationTemplateRepo.Update(ctx, id, notificationTemplate, nil)
+	if err != nil {
+		return dto.NotificationTemplateResponse{}, err
+	}
+
+	return toNotificationTemplateResponse(notificationTemplate), nil
+}
+
+func (s *notificationTemplateService) Destroy(ctx context.Context, id string) error {
+	if _, err := s.findByID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.notificationTemplateRepo.Destroy(ctx, id, nil)
+}
+
+func (s *notificationTemplateService) findByID(ctx context.Context, id string) (entity.NotificationTemplate, error) {
+	notificationTemplate, err := s.notificationTemplateRepo.FindByID(ctx, id, nil)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return entity.NotificationTemplate{}, helper.ErrNotificationTemplateNotFound
+	}
+
+	return notificationTemplate, err
+}
+
+// GetLocale returns the locale the current user gets notifications in
+func (s *notificationTemplateService) GetLocale(ctx context.Context, token string) (dto.UserLocaleResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.UserLocaleResponse{}, err
+	}
+
+	if user.Email == "" {
+		return dto.UserLocaleResponse{}, errors.New("user email not found")
+	}
+
+	return dto.UserLocaleResponse{
+		UserEmail: user.Email,
+		Locale:    s.receiverLocale(ctx, user.Email),
+	}, nil
+}
+
+func (s *notificationTemplateService) UpdateLocale(ctx context.Context, token string, request dto.UserLocaleRequest) (dto.UserLocaleResponse, error) {
+	locale, ok := helper.NormalizeLocale(request.Locale)
+	if !ok {
+		return dto.UserLocaleResponse{}, fmt.Errorf("%w: %q", helper.ErrUnsupportedLocale, request.Locale)
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.UserLocaleResponse{}, err
+	}
+
+	if user.Email == "" {
+		return dto.UserLocaleResponse{}, errors.New("user email not found")
+	}
+
+	now := time.Now()
+	err = s.notificationTemplateRepo.SaveLocale(ctx, entity.UserLocalePreference{
+		UserEmail: user.Email,
+		Locale:    locale,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}, nil)
+	if err != nil {
+		return dto.UserLocaleResponse{}, err
+	}
+
+	return dto.UserLocaleResponse{
+		UserEmail: user.Email,
+		Locale:    locale,
+	}, nil
+}
+
+func toHelperNotificationTemplate(notificationTemplate entity.NotificationTemplate) helper.NotificationTemplate {
+	return helper.NotificationTemplate{
+		Type:    notificationTemplate.Type,
+		Locale:  notificationTemplate.Locale,
+		Subject: notificationTemplate.Subject,
+		Body:    notificationTemplate.Body,
+	}
+}
+
+func toNotificationTemplateResponse(notificationTemplate entity.NotificationTemplate) dto.NotificationTemplateResponse {
+	return dto.NotificationTemplateResponse{
+		ID:      notificationTemplate.ID.String(),
+		Type:    notificationTemplate.Type,
+		Locale:  notificationTemplate.Locale,
+		Subject: notificationTemplate.Subject,
+		Body:    notificationTemplate.Body,
+	}
+}
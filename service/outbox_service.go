@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// EventPublisher sends one message to the message broker
+type EventPublisher interface {
+	Publish(ctx context.Context, routingKey string, messageID string, body []byte) error
+}
+
+type outboxService struct {
+	baseRepo   repository.BaseRepository
+	outboxRepo repository.OutboxRepository
+	publisher  EventPublisher
+	config     helper.OutboxConfig
+}
+
+type OutboxService interface {
+	Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error
+	Record(ctx context.Context, tx *gorm.DB, eventType string, aggregateType string, aggregateID string, data interface{}) error
+	Start(ctx context.Context)
+	PublishPending(ctx context.Context) (int, error)
+}
+
+func NewOutboxService(baseRepo repository.BaseRepository, outboxRepo repository.OutboxRepository, publisher EventPublisher, config helper.OutboxConfig) OutboxService {
+	return &outboxService{
+		baseRepo:   baseRepo,
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		config:     config,
+	}
+}
+
+// Transaction runs fn in one database transaction. Events recorded with its tx are only stored,
+// and later published, when every write of fn is committed.
+func (s *outboxService) Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return s.baseRepo.WithinTx(ctx, nil, fn)
+}
+
+// Record adds a domain event to the outbox in tx
+func (s *outboxService) Record(ctx context.Context, tx *gorm.DB, eventType string, aggregateType string, aggregateID string, data interface{}) error {
+	id := uuid.New()
+	occurredAt := time.Now()
+
+	payload, err := json.Marshal(dto.DomainEvent{
+		ID:         id.String(),
+		Type:       eventType,
+		Version:    dto.DOMAIN_EVENT_SCHEMA_VERSION,
+		Source:     dto.DOMAIN_EVENT_SOURCE,
+		OccurredAt: occurredAt,
+		Data:       data,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.outboxRepo.Create(ctx, []entity.OutboxEvent{{
+		ID:            id,
+		EventType:     eventType,
+		SchemaVersion: dto.DOMAIN_EVENT_SCHEMA_VERSION,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(payload),
+		OccurredAt:    &occurredAt,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &occurredAt,
+			UpdatedAt: &occurredAt,
+		},
+	}}, tx)
+}
+
+// Start publishes pending events on every interval until ctx is cancelled
+func (s *outboxService) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if s.config.Interval <= 0 {
+		log.Println("ERROR STARTING OUTBOX PUBLISHER: interval must be positive")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		// drain the backlog before waiting for the next tick
+		for {
+			published, err := s.PublishPending(ctx)
+			if err != nil {
+				log.Println("ERROR PUBLISHING OUTBOX EVENTS: ", err)
+			}
+			if err != nil || published < s.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PublishPending publishes one batch of events. Delivery is at least once: an event whose publish
+// was confirmed but whose row could not be marked is published again, so consumers dedupe on its ID.
+func (s *outboxService) PublishPending(ctx context.Context) (int, error) {
+	return s.outboxRepo.PublishPending(ctx, s.config.BatchSize, func(outboxEvent entity.OutboxEvent) error {
+		return s.publisher.Publish(ctx, outboxEvent.EventType, outboxEvent.ID.String(), []byte(outboxEvent.Payload))
+	})
+}
+
+func reportEventData(report entity.Report, reportSchedule entity.ReportSchedule) dto.ReportEventData {
+	return dto.ReportEventData{
+		ReportID:             report.ID.String(),
+		ReportScheduleID:     reportSchedule.ID.String(),
+		RegistrationID:       reportSchedule.RegistrationID,
+		UserID:               reportSchedule.UserID,
+		UserNRP:              reportSchedule.UserNRP,
+		AcademicAdvisorEmail: reportSchedule.AcademicAdvisorEmail,
+		ReportType:           reportSchedule.ReportType,
+		Week:                 reportSchedule.Week,
+		Status:               report.AcademicAdvisorStatus,
+		Feedback:             report.Feedback,
+		IsLate:               report.IsLate,
+		SubmittedAt:          report.SubmittedAt,
+		ReviewedAt:           report.ReviewedAt,
+	}
+}
+
+func reportScheduleEventData(reportSchedule entity.ReportSchedule) dto.ReportScheduleEventData {
+	data := dto.ReportScheduleEventData{
+		ReportScheduleID:     reportSchedule.ID.String(),
+		RegistrationID:       reportSchedule.RegistrationID,
+		UserID:               reportSchedule.UserID,
+		UserNRP:              reportSchedule.UserNRP,
+		AcademicAdvisorEmail: reportSchedule.AcademicAdvisorEmail,
+		ReportType:           reportSchedule.ReportType,
+		Week:                 reportSchedule.Week,
+	}
+	if reportSchedule.StartDate != nil {
+		data.StartDate = *reportSchedule.StartDate
+	}
+	if reportSchedule.EndDate != nil {
+		data.EndDate = *reportSchedule.EndDate
+	}
+
+	return data
+}
+
+// recordReportSchedulesCreated records a report_schedule.created event per schedule
+func recordReportSchedulesCreated(ctx context.Context, outboxService OutboxService, tx *gorm.DB, reportSchedules []entity.ReportSchedule) error {
+	for _, reportSchedule := range reportSchedules {
+		err := outboxService.Record(ctx, tx, dto.DOMAIN_EVENT_REPORT_SCHEDULE_CREATED, "report_schedule", reportSchedule.ID.String(), reportScheduleEventData(reportSchedule))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
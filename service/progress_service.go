@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type progressService struct {
+	progressRepo          repository.ProgressRepository
+	syllabusRepo          repository.SyllabusRepository
+	transcriptRepo        repository.TranscriptRepository
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	advisorAssignments    AdvisorAssignmentService
+}
+
+type ProgressService interface {
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationProgressResponse, error)
+	FindAdvisorDashboard(ctx context.Context, token string, pagReq dto.PaginationRequest, sortBy string) (dto.AdvisorDashboardResponse, dto.PaginationResponse, error)
+}
+
+func NewProgressService(progressRepo repository.ProgressRepository, syllabusRepo repository.SyllabusRepository, transcriptRepo repository.TranscriptRepository, userManagementService UserManagementService, registrationService RegistrationManagementService, advisorAssignments AdvisorAssignmentService) ProgressService {
+	return &progressService{
+		progressRepo:          progressRepo,
+		syllabusRepo:          syllabusRepo,
+		transcriptRepo:        transcriptRepo,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		advisorAssignments:    advisorAssignments,
+	}
+}
+
+// FindByRegistrationID summarises the report progress of one registration. The caller is checked
+// against the registration before anything is aggregated.
+func (s *progressService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.RegistrationProgressResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, helper.POLICY_ACTION_VIEW, registrationPolicyResource(helper.POLICY_RESOURCE_PROGRESS, registrationID, registration))
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	progress, err := s.progressRepo.SummarizeByRegistrationID(ctx, registrationID, time.Now(), nil)
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	syllabusUploaded, err := recordExists(func() error {
+		_, err := s.syllabusRepo.FindByRegistrationID(ctx, registrationID, nil)
+		return err
+	})
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	transcriptUploaded, err := recordExists(func() error {
+		_, err := s.transcriptRepo.FindByRegistrationID(ctx, registrationID, nil)
+		return err
+	})
+	if err != nil {
+		return dto.RegistrationProgressResponse{}, err
+	}
+
+	response := dto.RegistrationProgressResponse{
+		RegistrationID:       progress.RegistrationID,
+		UserID:               progress.UserID,
+		UserNRP:              progress.UserNRP,
+		AcademicAdvisorEmail: progress.AcademicAdvisorEmail,
+		TotalWeeks:           progress.Total,
+		ApprovedWeeks:        progress.Approved,
+		PendingWeeks:         progress.Pending,
+		RevisionWeeks:        progress.RevisionRequested,
+		RejectedWeeks:        progress.Rejected,
+		MissingWeeks:         progress.Missing,
+		LateWeeks:            progress.Late,
+		CompletionPercentage: helper.Percentage(progress.Approved, progress.Total),
+		NextDueWeek:          progress.NextDueWeek,
+		SyllabusUploaded:     syllabusUploaded,
+		TranscriptUploaded:   transcriptUploaded,
+	}
+
+	if progress.NextDueDate != nil {
+		response.NextDueDate = progress.NextDueDate.Format(time.RFC3339)
+	}
+
+	return response, nil
+}
+
+// FindAdvisorDashboard returns review statistics for every advisee of the calling advisor
+func (s *progressService) FindAdvisorDashboard(ctx context.Context, token string, pagReq dto.PaginationRequest, sortBy string) (dto.AdvisorDashboardResponse, dto.PaginationResponse, error) {
+	if !helper.ValidateDashboardSort(sortBy) {
+		return dto.AdvisorDashboardResponse{}, dto.PaginationResponse{}, helper.ErrInvalidDashboardSort
+	}
+
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.AdvisorDashboardResponse{}, dto.PaginationResponse{}, err
+	}
+
+	advisorEmail := user.Email
+	if advisorEmail == "" {
+		return dto.AdvisorDashboardResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
+	}
+
+	now := time.Now()
+
+	totals, err := s.progressRepo.TotalsByAdvisorEmail(ctx, advisorEmail, now, nil)
+	if err != nil {
+		return dto.AdvisorDashboardResponse{}, dto.PaginationResponse{}, err
+	}
+
+	students, err := s.progressRepo.SummarizeByAdvisorEmail(ctx, advisorEmail, sortBy, &pagReq, now, nil)
+	if err != nil {
+		return dto.AdvisorDashboardResponse{}, dto.PaginationResponse{}, err
+	}
+
+	response := dto.AdvisorDashboardResponse{
+		Totals: dto.AdvisorDashboardTotalsResponse{
+			Students:       totals.Students,
+			PendingReviews: totals.PendingReviews,
+			OverdueWeeks:   totals.OverdueWeeks,
+		},
+		Students: make([]dto.AdvisorDashboardStudentResponse, 0, len(students)),
+	}
+
+	for _, student := range students {
+		studentResponse := dto.AdvisorDashboardStudentResponse{
+			UserID:         student.UserID,
+			UserNRP:        student.UserNRP,
+			Registrations:  student.Registrations,
+			PendingReviews: student.PendingReviews,
+			OverdueWeeks:   student.OverdueWeeks,
+		}
+		if student.LastSubmittedAt != nil {
+			studentResponse.LastSubmittedAt = student.LastSubmittedAt.Format(time.RFC3339)
+		}
+
+		response.Students = append(response.Students, studentResponse)
+	}
+
+	return response, helper.MetaDataPagination(totals.Students, pagReq), nil
+}
+
+// recordExists turns a lookup into a found flag, treating "record not found" as absent rather than an error
+func recordExists(find func() error) (bool, error) {
+	err := find()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -1,143 +1,246 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
-	"strings"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"net/http"
 	"sync"
-
-	baseService "github.com/SIM-MBKM/mod-service/src/service"
+	"sync/atomic"
 )
 
-type RegistrationManagementService struct {
-	baseService *baseService.Service
+type registrationManagementService struct {
+	client *downstreamClient
+	// batchUnsupported is set once the registration service answers that it has no batch endpoint
+	batchUnsupported atomic.Bool
+}
+
+type RegistrationManagementService interface {
+	GetRegistrationByID(ctx context.Context, method string, id string, token string) (dto.Registration, error)
+	GetRegistrationsByIDs(ctx context.Context, method string, ids []string, token string) (RegistrationLookup, error)
 }
 
 const (
-	GET_REGISTRATION_BY_ID_ENDPOINT = "registration-management/api/v1/registration/%s"
+	GET_REGISTRATION_BY_ID_ENDPOINT   = "registration-management/api/v1/registration/%s"
+	GET_REGISTRATIONS_BY_IDS_ENDPOINT = "registration-management/api/v1/registration/service/by-ids"
+)
+
+const (
+	// REGISTRATION_BATCH_SIZE is the largest number of IDs sent in one batch request
+	REGISTRATION_BATCH_SIZE = 100
+	// REGISTRATION_MAX_CONCURRENT bounds the per-ID requests made when batching is not possible
+	REGISTRATION_MAX_CONCURRENT = 10
 )
 
-func NewRegistrationManagementService(baseURI string, asyncURIs []string) *RegistrationManagementService {
-	return &RegistrationManagementService{
-		baseService: baseService.NewService(baseURI, asyncURIs),
+// REGISTRATION_UNAVAILABLE_WARNING is reported by list endpoints that fall back to an empty
+// activity name because the registration could not be loaded
+const REGISTRATION_UNAVAILABLE_WARNING = "registration %s is unavailable, its activity name is left empty"
+
+// REGISTRATION_NOT_FOUND_WARNING is reported by list endpoints for records whose registration no longer exists
+const REGISTRATION_NOT_FOUND_WARNING = "registration %s was not found"
+
+// ErrRegistrationNotFound is returned for a registration the registration service does not know
+var ErrRegistrationNotFound = errors.New("registration not found")
+
+// RegistrationLookup is the outcome of looking several registrations up at once. Every requested
+// ID ends up either in Registrations or in Errors.
+type RegistrationLookup struct {
+	Registrations map[string]dto.Registration
+	Errors        map[string]error
+}
+
+func newRegistrationLookup() RegistrationLookup {
+	return RegistrationLookup{
+		Registrations: make(map[string]dto.Registration),
+		Errors:        make(map[string]error),
+	}
+}
+
+// Get returns the registration of an ID, or ErrRegistrationNotFound or the error that made its lookup fail
+func (l RegistrationLookup) Get(id string) (dto.Registration, error) {
+	if registration, ok := l.Registrations[id]; ok {
+		return registration, nil
+	}
+	if err, ok := l.Errors[id]; ok {
+		return dto.Registration{}, err
+	}
+
+	return dto.Registration{}, ErrRegistrationNotFound
+}
+
+// warnRegistration records the list warning matching the lookup error of a registration
+func warnRegistration(warnings *helper.Warnings, id string, err error) {
+	if errors.Is(err, ErrRegistrationNotFound) {
+		warnings.Add(REGISTRATION_NOT_FOUND_WARNING, id)
+		return
+	}
+
+	warnings.Add(REGISTRATION_UNAVAILABLE_WARNING, id)
+}
+
+func NewRegistrationManagementService(baseURI string, asyncURIs []string, downstreamConfig helper.DownstreamConfig) RegistrationManagementService {
+	return &registrationManagementService{
+		client: newDownstreamClient("registration-management", baseURI, asyncURIs, downstreamConfig),
 	}
 }
 
 // create function to get user by id
-func (s *RegistrationManagementService) GetRegistrationByID(method string, id string, token string) map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
+func (s *registrationManagementService) GetRegistrationByID(ctx context.Context, method string, id string, token string) (dto.Registration, error) {
+	token, err := bearerToken(token)
+	if err != nil {
+		return dto.Registration{}, err
 	}
 
-	token = tokenParts[1]
+	return s.getRegistration(ctx, method, id, token)
+}
 
+func (s *registrationManagementService) getRegistration(ctx context.Context, method string, id string, token string) (dto.Registration, error) {
 	endpoint := fmt.Sprintf(GET_REGISTRATION_BY_ID_ENDPOINT, id)
-	res, err := s.baseService.Request(method, endpoint, nil, token)
+	res, err := s.client.request(ctx, method, endpoint, nil, token)
 	if err != nil {
-		return nil
+		var statusErr *DownstreamStatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return dto.Registration{}, fmt.Errorf("failed to get registration %s: %w", id, ErrRegistrationNotFound)
+		}
+		return dto.Registration{}, fmt.Errorf("failed to get registration %s: %w", id, err)
 	}
 
-	registration, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil
+	var registration dto.Registration
+	if err := decodeResponseData(res, &registration); err != nil {
+		return dto.Registration{}, fmt.Errorf("failed to get registration %s: %w", id, err)
 	}
 
-	var registrationData map[string]interface{}
-	registrationData = map[string]interface{}{
-		"id":                     registration["id"],
-		"user_id":                registration["user_id"],
-		"user_nrp":               registration["user_nrp"],
-		"user_name":              registration["user_name"],
-		"academic_advisor":       registration["academic_advisor"],
-		"academic_advisor_email": registration["academic_advisor_email"],
-		"activity_name":          registration["activity_name"],
-		"approval_status":        registration["approval_status"],
+	return registration, nil
+}
+
+// GetRegistrationsByIDs looks registrations up with one batch request per REGISTRATION_BATCH_SIZE IDs.
+// A chunk whose batch request fails, or every chunk once the registration service turns out not to
+// support batching, is looked up ID by ID with at most REGISTRATION_MAX_CONCURRENT requests in flight.
+// Only an unusable token fails the whole lookup, everything else is reported per ID.
+func (s *registrationManagementService) GetRegistrationsByIDs(ctx context.Context, method string, ids []string, token string) (RegistrationLookup, error) {
+	token, err := bearerToken(token)
+	if err != nil {
+		return RegistrationLookup{}, err
 	}
 
-	return registrationData
+	lookup := newRegistrationLookup()
+	for _, chunk := range chunkIDs(uniqueIDs(ids), REGISTRATION_BATCH_SIZE) {
+		if !s.batchUnsupported.Load() {
+			registrations, err := s.getRegistrationBatch(ctx, chunk, token)
+			if err == nil {
+				for _, id := range chunk {
+					if registration, ok := registrations[id]; ok {
+						lookup.Registrations[id] = registration
+					} else {
+						lookup.Errors[id] = ErrRegistrationNotFound
+					}
+				}
+				continue
+			}
+
+			if isBatchUnsupported(err) {
+				log.Println("registration service does not support batch lookups, falling back to per-ID requests")
+				s.batchUnsupported.Store(true)
+			} else {
+				log.Println("ERROR GETTING REGISTRATION BATCH: ", err)
+			}
+		}
+
+		s.getRegistrationsOneByOne(ctx, method, chunk, token, lookup)
+	}
+
+	return lookup, nil
 }
 
-// Tambahkan method baru untuk batch processing
-func (s *RegistrationManagementService) GetRegistrationsByIDs(method string, ids []string, token string) (map[string]map[string]interface{}, error) {
-	// Split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil, fmt.Errorf("invalid token format")
+func (s *registrationManagementService) getRegistrationBatch(ctx context.Context, ids []string, token string) (map[string]dto.Registration, error) {
+	res, err := s.client.request(ctx, http.MethodPost, GET_REGISTRATIONS_BY_IDS_ENDPOINT, map[string]interface{}{
+		"registration_ids": ids,
+	}, token)
+	if err != nil {
+		return nil, err
 	}
-	token = tokenParts[1]
 
-	// Create result map
-	results := make(map[string]map[string]interface{})
-	var mu sync.Mutex
-	var wg sync.WaitGroup
+	var registrations []dto.Registration
+	if err := decodeResponseData(res, &registrations); err != nil {
+		return nil, err
+	}
 
-	// Limit concurrent requests (adjust based on your API rate limits)
-	maxConcurrent := 10
-	semaphore := make(chan struct{}, maxConcurrent)
+	results := make(map[string]dto.Registration, len(registrations))
+	for _, registration := range registrations {
+		results[registration.ID] = registration
+	}
+
+	return results, nil
+}
 
-	// Error collection
-	var errors []error
-	var errorMu sync.Mutex
+// getRegistrationsOneByOne is the bounded fan-out used when a batch request is not possible
+func (s *registrationManagementService) getRegistrationsOneByOne(ctx context.Context, method string, ids []string, token string, lookup RegistrationLookup) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, REGISTRATION_MAX_CONCURRENT)
 
 	for _, id := range ids {
 		wg.Add(1)
 		go func(registrationID string) {
 			defer wg.Done()
 
-			// Acquire semaphore
 			semaphore <- struct{}{}
 			defer func() { <-semaphore }()
 
-			// Call individual API
-			endpoint := fmt.Sprintf(GET_REGISTRATION_BY_ID_ENDPOINT, registrationID)
-			res, err := s.baseService.Request(method, endpoint, nil, token)
+			registration, err := s.getRegistration(ctx, method, registrationID, token)
 
+			mu.Lock()
+			defer mu.Unlock()
 			if err != nil {
-				errorMu.Lock()
-				errors = append(errors, fmt.Errorf("failed to get registration %s: %v", registrationID, err))
-				errorMu.Unlock()
+				log.Printf("Registration API Error: %v", err)
+				lookup.Errors[registrationID] = err
 				return
 			}
+			lookup.Registrations[registrationID] = registration
+		}(id)
+	}
 
-			registration, ok := res["data"].(map[string]interface{})
-			if !ok {
-				errorMu.Lock()
-				errors = append(errors, fmt.Errorf("invalid response format for registration %s", registrationID))
-				errorMu.Unlock()
-				return
-			}
+	wg.Wait()
+}
 
-			registrationData := map[string]interface{}{
-				"id":                     registration["id"],
-				"user_id":                registration["user_id"],
-				"user_nrp":               registration["user_nrp"],
-				"user_name":              registration["user_name"],
-				"academic_advisor":       registration["academic_advisor"],
-				"academic_advisor_email": registration["academic_advisor_email"],
-				"activity_name":          registration["activity_name"],
-				"approval_status":        registration["approval_status"],
-			}
+// isBatchUnsupported tells whether the registration service has no batch endpoint to call
+func isBatchUnsupported(err error) bool {
+	var statusErr *DownstreamStatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
 
-			// Store result safely
-			mu.Lock()
-			results[registrationID] = registrationData
-			mu.Unlock()
+	return statusErr.StatusCode == http.StatusNotFound ||
+		statusErr.StatusCode == http.StatusMethodNotAllowed ||
+		statusErr.StatusCode == http.StatusNotImplemented
+}
 
-		}(id)
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
 	}
 
-	// Wait for all goroutines to complete
-	wg.Wait()
+	return unique
+}
 
-	// Check if there were any errors
-	if len(errors) > 0 {
-		// Log errors but don't fail the entire request
-		for _, err := range errors {
-			log.Printf("Registration API Error: %v", err)
+func chunkIDs(ids []string, size int) [][]string {
+	var chunks [][]string
+	for start := 0; start < len(ids); start += size {
+		end := start + size
+		if end > len(ids) {
+			end = len(ids)
 		}
+		chunks = append(chunks, ids[start:end])
 	}
 
-	return results, nil
+	return chunks
 }
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/repository"
+)
+
+// snapshotRegistrationService serves registrations from the local snapshots and only asks the
+// registration management service for the ones that were never synced. It never writes the snapshots,
+// the registration sync service does.
+type snapshotRegistrationService struct {
+	snapshotRepo        repository.RegistrationSnapshotRepository
+	registrationService RegistrationManagementService
+}
+
+func NewSnapshotRegistrationService(snapshotRepo repository.RegistrationSnapshotRepository, registrationService RegistrationManagementService) RegistrationManagementService {
+	return &snapshotRegistrationService{
+		snapshotRepo:        snapshotRepo,
+		registrationService: registrationService,
+	}
+}
+
+func (s *snapshotRegistrationService) GetRegistrationByID(ctx context.Context, method string, id string, token string) (dto.Registration, error) {
+	if _, err := bearerToken(token); err != nil {
+		return dto.Registration{}, err
+	}
+
+	snapshots, err := s.snapshotRepo.FindByIDs(ctx, []string{id}, nil)
+	if err != nil {
+		log.Println("ERROR GETTING REGISTRATION SNAPSHOT: ", err)
+	} else if snapshot, ok := snapshots[id]; ok {
+		return registrationFromSnapshot(snapshot), nil
+	}
+
+	return s.registrationService.GetRegistrationByID(ctx, method, id, token)
+}
+
+func (s *snapshotRegistrationService) GetRegistrationsByIDs(ctx context.Context, method string, ids []string, token string) (RegistrationLookup, error) {
+	if _, err := bearerToken(token); err != nil {
+		return RegistrationLookup{}, err
+	}
+
+	ids = uniqueIDs(ids)
+	snapshots, err := s.snapshotRepo.FindByIDs(ctx, ids, nil)
+	if err != nil {
+		log.Println("ERROR GETTING REGISTRATION SNAPSHOTS: ", err)
+		return s.registrationService.GetRegistrationsByIDs(ctx, method, ids, token)
+	}
+
+	lookup := newRegistrationLookup()
+	var missing []string
+	for _, id := range ids {
+		if snapshot, ok := snapshots[id]; ok {
+			lookup.Registrations[id] = registrationFromSnapshot(snapshot)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return lookup, nil
+	}
+
+	fetched, err := s.registrationService.GetRegistrationsByIDs(ctx, method, missing, token)
+	if err != nil {
+		return RegistrationLookup{}, err
+	}
+	for id, registration := range fetched.Registrations {
+		lookup.Registrations[id] = registration
+	}
+	for id, err := range fetched.Errors {
+		lookup.Errors[id] = err
+	}
+
+	return lookup, nil
+}
+
+// listedRegistrations resolves the registrations of listed rows, keyed by registration ID, from the
+// snapshots the repositories joined into them. Only the registrations never synced are looked up.
+func listedRegistrations(ctx context.Context, registrationService RegistrationManagementService, listed map[string]entity.ListedRegistration, token string) (RegistrationLookup, error) {
+	lookup := newRegistrationLookup()
+	var missing []string
+	for registrationID, registration := range listed {
+		if !registration.RegistrationSynced {
+			missing = append(missing, registrationID)
+			continue
+		}
+
+		lookup.Registrations[registrationID] = dto.Registration{
+			ID:             registrationID,
+			ActivityName:   registration.RegistrationActivityName,
+			ApprovalStatus: registration.RegistrationApproved,
+		}
+	}
+	if len(missing) == 0 {
+		return lookup, nil
+	}
+
+	fetched, err := registrationService.GetRegistrationsByIDs(ctx, "GET", missing, token)
+	if err != nil {
+		return RegistrationLookup{}, err
+	}
+	for registrationID, registration := range fetched.Registrations {
+		lookup.Registrations[registrationID] = registration
+	}
+	for registrationID, err := range fetched.Errors {
+		lookup.Errors[registrationID] = err
+	}
+
+	return lookup, nil
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+)
+
+type registrationSyncService struct {
+	snapshotRepo          repository.RegistrationSnapshotRepository
+	registrationService   RegistrationManagementService
+	userManagementService UserManagementService
+	config                helper.RegistrationSyncConfig
+}
+
+type RegistrationSyncService interface {
+	Start(ctx context.Context)
+	HandleEvent(ctx context.Context, body []byte) error
+	Backfill(ctx context.Context) (int, error)
+}
+
+func NewRegistrationSyncService(snapshotRepo repository.RegistrationSnapshotRepository, registrationService RegistrationManagementService, userManagementService UserManagementService, config helper.RegistrationSyncConfig) RegistrationSyncService {
+	return &registrationSyncService{
+		snapshotRepo:          snapshotRepo,
+		registrationService:   registrationService,
+		userManagementService: userManagementService,
+		config:                config,
+	}
+}
+
+// Start backfills the snapshots, then consumes registration events until ctx is cancelled
+func (s *registrationSyncService) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if s.config.BackfillToken != "" {
+		synced, err := s.Backfill(ctx)
+		if err != nil {
+			log.Println("ERROR BACKFILLING REGISTRATION SNAPSHOTS: ", err)
+		} else if synced > 0 {
+			log.Printf("backfilled %d registration snapshots", synced)
+		}
+	}
+
+	helper.Consume(ctx, s.config.Consumer, s.HandleEvent)
+}
+
+// Backfill snapshots the registrations referenced here that no event has synced yet, fetching them
+// from the registration management service. A registration it cannot fetch is skipped until the next run.
+func (s *registrationSyncService) Backfill(ctx context.Context) (int, error) {
+	synced := 0
+	after := ""
+	for {
+		registrationIDs, err := s.snapshotRepo.FindUnsyncedIDs(ctx, after, s.config.BackfillBatchSize, nil)
+		if err != nil {
+			return synced, err
+		}
+		if len(registrationIDs) == 0 {
+			return synced, nil
+		}
+		after = registrationIDs[len(registrationIDs)-1]
+
+		// stored as of when it was requested, so an event published meanwhile still wins
+		fetchedAt := time.Now()
+		registrations, err := s.registrationService.GetRegistrationsByIDs(ctx, "GET", registrationIDs, s.config.BackfillToken)
+		if err != nil {
+			return synced, err
+		}
+
+		for registrationID, registration := range registrations.Registrations {
+			if registration.ID == "" {
+				registration.ID = registrationID
+			}
+
+			applied, err := s.snapshotRepo.Sync(ctx, registrationSnapshot(registration, fetchedAt), nil)
+			if err != nil {
+				return synced, err
+			}
+			if applied {
+				synced++
+			}
+		}
+		for registrationID, err := range registrations.Errors {
+			log.Printf("ERROR BACKFILLING REGISTRATION %s: %v", registrationID, err)
+		}
+	}
+}
+
+// HandleEvent applies one registration event to the snapshots. Events are applied in the order
+// they occurred, so a late redelivery never overwrites a newer snapshot.
+func (s *registrationSyncService) HandleEvent(ctx context.Context, body []byte) error {
+	var event dto.RegistrationEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: %v", helper.ErrMalformedMessage, err)
+	}
+	if event.Data.ID == "" {
+		return fmt.Errorf("%w: registration event without registration id", helper.ErrMalformedMessage)
+	}
+
+	occurredAt := event.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	switch event.Event {
+	case dto.REGISTRATION_EVENT_CREATED, dto.REGISTRATION_EVENT_UPDATED, dto.REGISTRATION_EVENT_APPROVED:
+		applied, err := s.snapshotRepo.Sync(ctx, registrationSnapshot(event.Data, occurredAt), nil)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			log.Printf("skipping stale %s event of registration %s", event.Event, event.Data.ID)
+			break
+		}
+
+		// the event carries the current student and advisor, cached lookups of them may be outdated
+		if event.Data.UserID != "" {
+			s.userManagementService.InvalidateUser(event.Data.UserID)
+		}
+		if event.Data.AcademicAdvisorEmail != "" {
+			s.userManagementService.InvalidateEmail(event.Data.AcademicAdvisorEmail)
+		}
+	case dto.REGISTRATION_EVENT_DELETED:
+		if _, err := s.snapshotRepo.Destroy(ctx, event.Data.ID, occurredAt, nil); err != nil {
+			return err
+		}
+	default:
+		log.Printf("ignoring unknown registration event %q", event.Event)
+	}
+
+	return nil
+}
+
+func registrationSnapshot(registration dto.Registration, syncedAt time.Time) entity.RegistrationSnapshot {
+	return entity.RegistrationSnapshot{
+		RegistrationID:       registration.ID,
+		UserID:               registration.UserID,
+		UserNRP:              registration.UserNRP,
+		UserName:             registration.UserName,
+		AcademicAdvisorID:    registration.AcademicAdvisor,
+		AcademicAdvisorEmail: registration.AcademicAdvisorEmail,
+		ActivityName:         registration.ActivityName,
+		ApprovalStatus:       registration.ApprovalStatus,
+		SyncedAt:             &syncedAt,
+	}
+}
+
+func registrationFromSnapshot(snapshot entity.RegistrationSnapshot) dto.Registration {
+	return dto.Registration{
+		ID:                   snapshot.RegistrationID,
+		UserID:               snapshot.UserID,
+		UserNRP:              snapshot.UserNRP,
+		UserName:             snapshot.UserName,
+		AcademicAdvisor:      snapshot.AcademicAdvisorID,
+		AcademicAdvisorEmail: snapshot.AcademicAdvisorEmail,
+		ActivityName:         snapshot.ActivityName,
+		ApprovalStatus:       snapshot.ApprovalStatus,
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const REMINDER_LOCK_KEY = "monitoring-service:report-reminders"
+
+type reminderService struct {
+	reportReminderRepo    repository.ReportReminderRepository
+	userManagementService UserManagementService
+	brokerService         BrokerService
+	templateService       NotificationTemplateService
+	config                helper.ReminderConfig
+}
+
+type ReminderService interface {
+	Start(ctx context.Context)
+	SendDueReminders(ctx context.Context) (int, error)
+}
+
+func NewReminderService(reportReminderRepo repository.ReportReminderRepository, userManagementService UserManagementService, brokerService BrokerService, templateService NotificationTemplateService, config helper.ReminderConfig) ReminderService {
+	return &reminderService{
+		reportReminderRepo:    reportReminderRepo,
+		userManagementService: userManagementService,
+		brokerService:         brokerService,
+		templateService:       templateService,
+		config:                config,
+	}
+}
+
+// Start sends due reminders on every interval until ctx is cancelled
+func (s *reminderService) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if s.config.Interval <= 0 {
+		log.Println("ERROR STARTING REPORT REMINDERS: interval must be positive")
+		return
+	}
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.SendDueReminders(ctx)
+		if err != nil {
+			log.Println("ERROR SENDING REPORT REMINDERS: ", err)
+		} else if sent > 0 {
+			log.Printf("sent %d report reminders", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders sends every reminder that is due now. Only one replica runs at a time;
+// the others skip the run when the advisory lock is taken.
+func (s *reminderService) SendDueReminders(ctx context.Context) (int, error) {
+	if s.config.ServiceToken == "" {
+		return 0, errors.New("reminder service token is not configured")
+	}
+
+	sent := 0
+	_, err := s.reportReminderRepo.WithLock(ctx, REMINDER_LOCK_KEY, func(tx *gorm.DB) error {
+		for _, window := range helper.ReminderWindows(s.config, time.Now()) {
+			reportSchedules, err := s.reportReminderRepo.FindPendingSchedules(ctx, window.Kind, window.From, window.To, tx)
+			if err != nil {
+				return err
+			}
+
+			for _, reportSchedule := range reportSchedules {
+				ok, err := s.sendReminder(ctx, reportSchedule, window)
+				if err != nil {
+					// keep going, the failed reminder is retried on the next run
+					log.Println("ERROR SENDING REPORT REMINDER: ", err)
+					continue
+				}
+				if ok {
+					sent++
+				}
+			}
+		}
+
+		return nil
+	})
+
+	return sent, err
+}
+
+// sendReminder claims the (schedule, kind) pair first so a reminder is never sent twice,
+// and releases the claim when the notification could not be delivered
+func (s *reminderService) sendReminder(ctx context.Context, reportSchedule entity.ReportSchedule, window helper.ReminderWindow) (bool, error) {
+	student, err := s.userManagementService.GetUserByID(ctx, "GET", s.config.ServiceToken, reportSchedule.UserID)
+	if err != nil {
+		return false, err
+	}
+
+	studentEmail := student.Email
+	if studentEmail == "" {
+		return false, fmt.Errorf("email of user %s not found", reportSchedule.UserID)
+	}
+
+	notification, err := s.templateService.Render(ctx, dto.NotificationRequest{
+		SenderName:    "Monitoring Service",
+		SenderEmail:   reportSchedule.AcademicAdvisorEmail,
+		ReceiverEmail: studentEmail,
+		Type:          window.Type,
+	}, dto.NotificationTemplateData{
+		StudentName:  student.Name,
+		StudentNRP:   reportSchedule.UserNRP,
+		ActivityName: reportSchedule.ActivityName,
+		ReportType:   reportSchedule.ReportType,
+		Week:         reportSchedule.Week,
+		Deadline:     reportSchedule.EndDate.Format("02 Jan 2006 15:04"),
+		Link:         "/report-schedules/" + reportSchedule.ID.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	reportReminder := entity.ReportReminder{
+		ID:               uuid.New(),
+		ReportScheduleID: reportSchedule.ID.String(),
+		Kind:             window.Kind,
+		Type:             window.Type,
+		ReceiverEmail:    studentEmail,
+		SentAt:           &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+
+	claimed, err := s.reportReminderRepo.Create(ctx, reportReminder, nil)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	_, err = s.brokerService.SendNotification(ctx, notification, "POST", s.config.ServiceToken)
+	if err != nil {
+		if destroyErr := s.reportReminderRepo.Destroy(ctx, reportReminder.ID.String(), nil); destroyErr != nil {
+			log.Println("ERROR RELEASING REPORT REMINDER: ", destroyErr)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
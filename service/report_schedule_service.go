@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"monitoring-service/dto"
 	"monitoring-service/entity"
@@ -12,12 +13,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type reportScheduleService struct {
 	reportScheduleRepo    repository.ReportScheduleReposiotry
-	userManagementService *UserManagementService
-	registrationService   *RegistrationManagementService
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	outboxService         OutboxService
+	auditService          AuditService
+	advisorAssignments    AdvisorAssignmentService
+	config                helper.ReportScheduleConfig
 }
 
 type ReportScheduleService interface {
@@ -26,26 +32,34 @@ type ReportScheduleService interface {
 	Update(ctx context.Context, id string, subject dto.ReportScheduleRequest, token string) error
 	FindByID(ctx context.Context, id string, token string) (dto.ReportScheduleResponse, error)
 	Destroy(ctx context.Context, id string, token string) error
-	FindByRegistrationID(ctx context.Context, registrationID string) ([]dto.ReportScheduleResponse, error)
-	ReportScheduleAccess(ctx context.Context, reportSchedule dto.ReportScheduleRequest, token string) (bool, error)
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.ReportScheduleResponse, error)
 	FindByUserID(ctx context.Context, token string) ([]dto.ReportScheduleResponse, error)
 	FindByAdvisorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error)
+	FindByMonitorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error)
 	FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.ReportScheduleByStudentResponse, error)
+	Generate(ctx context.Context, registrationID string, request dto.ReportScheduleGenerateRequest, token string) (dto.ReportScheduleGenerateResponse, error)
 }
 
-func NewReportScheduleService(reportScheduleRepo repository.ReportScheduleReposiotry, userManagementbaseURI string, registrationManagementbaseURI string, asyncURIs []string) ReportScheduleService {
+func NewReportScheduleService(reportScheduleRepo repository.ReportScheduleReposiotry, userManagementService UserManagementService, registrationService RegistrationManagementService, outboxService OutboxService, auditService AuditService, advisorAssignments AdvisorAssignmentService, config helper.ReportScheduleConfig) ReportScheduleService {
 	return &reportScheduleService{
 		reportScheduleRepo:    reportScheduleRepo,
-		userManagementService: NewUserManagementService(userManagementbaseURI, asyncURIs),
-		registrationService:   NewRegistrationManagementService(registrationManagementbaseURI, asyncURIs),
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		outboxService:         outboxService,
+		auditService:          auditService,
+		advisorAssignments:    advisorAssignments,
+		config:                config,
 	}
 }
 
 func (s *reportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.ReportScheduleByStudentResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.ReportScheduleByStudentResponse{}, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return dto.ReportScheduleByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -55,24 +69,33 @@ func (s *reportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx contex
 		return dto.ReportScheduleByStudentResponse{}, err
 	}
 
+	listed := make(map[string]entity.ListedRegistration, len(reportSchedules))
+	for registrationID, schedules := range reportSchedules {
+		listed[registrationID] = schedules[0].ListedRegistration
+	}
+
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.ReportScheduleByStudentResponse{}, err
+	}
+
 	reportScheduleResponses := make(map[string][]dto.ReportScheduleResponse)
+	var warnings helper.Warnings
 
 	for registrationID, reportSchedules := range reportSchedules {
-		registration := s.registrationService.GetRegistrationByID("GET", registrationID, token)
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			log.Println("ERROR GETTING REGISTRATION ACTIVITY NAME: ", registration)
-			return dto.ReportScheduleByStudentResponse{}, errors.New("registration activity name not found")
-		}
-
-		// skil if registration['approval_status'] is false
-		approvalStatus, ok := registration["approval_status"].(bool)
-		if !ok {
-			return dto.ReportScheduleByStudentResponse{}, errors.New("registration approval status not found")
+		// when the registration cannot be loaded the schedules are still listed, keyed by registration ID
+		registration, err := registrations.Get(registrationID)
+		if err != nil {
+			warnRegistration(&warnings, registrationID, err)
+		} else if !registration.ApprovalStatus {
+			// skip registrations that are not approved
+			continue
 		}
 
-		if !approvalStatus {
-			continue
+		registrationActivityName := registration.ActivityName
+		groupKey := registrationActivityName
+		if groupKey == "" {
+			groupKey = registrationID
 		}
 
 		var reportScheduleResponse []dto.ReportScheduleResponse
@@ -90,34 +113,28 @@ func (s *reportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx contex
 				Week:                 reportSchedule.Week,
 				StartDate:            reportSchedule.StartDate.Format(time.RFC3339),
 				EndDate:              reportSchedule.EndDate.Format(time.RFC3339),
+				SubmissionStatus:     resolveSubmissionStatus(reportSchedule),
 			}
 
 			if len(reportSchedule.Report) > 0 {
-				response.Report = &dto.ReportResponse{
-					ID:                    reportSchedule.Report[0].ID.String(),
-					ReportScheduleID:      reportSchedule.ID.String(),
-					Title:                 reportSchedule.Report[0].Title,
-					Content:               reportSchedule.Report[0].Content,
-					ReportType:            reportSchedule.Report[0].ReportType,
-					Feedback:              reportSchedule.Report[0].Feedback,
-					AcademicAdvisorStatus: reportSchedule.Report[0].AcademicAdvisorStatus,
-					FileStorageID:         reportSchedule.Report[0].FileStorageID,
-				}
+				reportResponse := toReportResponse(reportSchedule.Report[0])
+				response.Report = &reportResponse
 			}
 
 			reportScheduleResponse = append(reportScheduleResponse, response)
 		}
 
-		reportScheduleResponses[registrationActivityName] = reportScheduleResponse
+		reportScheduleResponses[groupKey] = reportScheduleResponse
 	}
 
 	return dto.ReportScheduleByStudentResponse{
-		Reports: reportScheduleResponses,
+		Reports:  reportScheduleResponses,
+		Warnings: warnings.List(),
 	}, nil
 }
 
 // func (s *reportScheduleService) FindByAdvisorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
-// 	user := s.userManagementService.GetUserData("GET", token)
+// 	user := s.userManagementService.CurrentUser(ctx, token)
 // 	advisorEmail, ok := user["email"].(string)
 // 	if !ok {
 // 		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
@@ -197,72 +214,74 @@ func (s *reportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx contex
 // }
 
 func (s *reportScheduleService) FindByAdvisorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
-	reportSchedules, totalCount, err := s.reportScheduleRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, &pagReq, reportScheduleRequest.UserNRP)
+	reportSchedules, totalCount, err := s.reportScheduleRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, &pagReq, reportScheduleRequest.UserNRP, reportScheduleRequest.SubmissionStatus)
 	if err != nil {
 		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
 	}
 
-	// OPTIMIZATION: Collect all unique registration IDs
-	registrationIDSet := make(map[string]bool)
-	for _, schedules := range reportSchedules {
-		for _, schedule := range schedules {
-			registrationIDSet[schedule.RegistrationID] = true
-		}
+	return s.groupedResponse(ctx, token, pagReq, reportSchedules, totalCount)
+}
+
+// FindByMonitorEmail lists the schedules of the registrations the monitoring lecturer monitors,
+// grouped by student like FindByAdvisorEmail
+func (s *reportScheduleService) FindByMonitorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
 	}
 
-	// Convert to slice
-	var registrationIDs []string
-	for id := range registrationIDSet {
-		registrationIDs = append(registrationIDs, id)
+	monitorEmail := user.Email
+	if monitorEmail == "" {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("monitor email not found")
 	}
 
-	// BATCH CALL: Get all registrations at once
-	var registrationMap map[string]map[string]interface{}
-	if len(registrationIDs) > 0 {
-		registrationMap, err = s.registrationService.GetRegistrationsByIDs("GET", registrationIDs, token)
-		if err != nil {
-			log.Printf("Error getting registrations in batch: %v", err)
-			// Fallback to empty map if batch fails
-			registrationMap = make(map[string]map[string]interface{})
+	reportSchedules, totalCount, err := s.reportScheduleRepo.FindByMonitorEmailAndGroupByUserID(ctx, monitorEmail, nil, &pagReq, reportScheduleRequest.UserNRP, reportScheduleRequest.SubmissionStatus)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	return s.groupedResponse(ctx, token, pagReq, reportSchedules, totalCount)
+}
+
+// groupedResponse builds the response of the schedules grouped by student NRP, with the activity
+// name of their registrations
+func (s *reportScheduleService) groupedResponse(ctx context.Context, token string, pagReq dto.PaginationRequest, reportSchedules map[string][]entity.ReportSchedule, totalCount int64) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
+	listed := make(map[string]entity.ListedRegistration)
+	for _, schedules := range reportSchedules {
+		for _, schedule := range schedules {
+			listed[schedule.RegistrationID] = schedule.ListedRegistration
 		}
-	} else {
-		registrationMap = make(map[string]map[string]interface{})
 	}
 
-	// Build response using cached registrations
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	// Build response using the looked up registrations
 	reportScheduleResponses := make(map[string][]dto.ReportScheduleResponse)
+	var warnings helper.Warnings
 	for userNRP, reportScheduleAdvisors := range reportSchedules {
 		var reportSchedule []dto.ReportScheduleResponse
 		for _, reportScheduleAdvisor := range reportScheduleAdvisors {
-			// Get activity name from batch result (O(1) lookup)
-			var activityName string
-			if registration, exists := registrationMap[reportScheduleAdvisor.RegistrationID]; exists {
-				if name, ok := registration["activity_name"].(string); ok {
-					activityName = name
-				} else {
-					activityName = "Unknown Activity" // fallback
-				}
-			} else {
-				// Fallback: call individual API if not found in batch
-				log.Printf("Registration ID %s not found in batch, calling individual API", reportScheduleAdvisor.RegistrationID)
-				registration := s.registrationService.GetRegistrationByID("GET", reportScheduleAdvisor.RegistrationID, token)
-				if registration != nil {
-					if name, ok := registration["activity_name"].(string); ok {
-						activityName = name
-					} else {
-						activityName = "Unknown Activity"
-					}
-				} else {
-					activityName = "Unknown Activity"
-				}
+			// the activity name stays empty when the registration cannot be loaded
+			registration, err := registrations.Get(reportScheduleAdvisor.RegistrationID)
+			if err != nil {
+				warnRegistration(&warnings, reportScheduleAdvisor.RegistrationID, err)
 			}
 
+			activityName := registration.ActivityName
+
 			response := dto.ReportScheduleResponse{
 				ID:                   reportScheduleAdvisor.ID.String(),
 				UserID:               reportScheduleAdvisor.UserID,
@@ -275,20 +294,13 @@ func (s *reportScheduleService) FindByAdvisorEmail(ctx context.Context, token st
 				Week:                 reportScheduleAdvisor.Week,
 				StartDate:            reportScheduleAdvisor.StartDate.Format(time.RFC3339),
 				EndDate:              reportScheduleAdvisor.EndDate.Format(time.RFC3339),
+				SubmissionStatus:     resolveSubmissionStatus(reportScheduleAdvisor),
 			}
 
 			// Only set Report if there are reports
 			if len(reportScheduleAdvisor.Report) > 0 {
-				response.Report = &dto.ReportResponse{
-					ID:                    reportScheduleAdvisor.Report[0].ID.String(),
-					ReportScheduleID:      reportScheduleAdvisor.ID.String(),
-					Title:                 reportScheduleAdvisor.Report[0].Title,
-					Content:               reportScheduleAdvisor.Report[0].Content,
-					ReportType:            reportScheduleAdvisor.Report[0].ReportType,
-					Feedback:              reportScheduleAdvisor.Report[0].Feedback,
-					AcademicAdvisorStatus: reportScheduleAdvisor.Report[0].AcademicAdvisorStatus,
-					FileStorageID:         reportScheduleAdvisor.Report[0].FileStorageID,
-				}
+				reportResponse := toReportResponse(reportScheduleAdvisor.Report[0])
+				response.Report = &reportResponse
 			}
 			reportSchedule = append(reportSchedule, response)
 		}
@@ -297,7 +309,8 @@ func (s *reportScheduleService) FindByAdvisorEmail(ctx context.Context, token st
 
 	paginationResponse := helper.MetaDataPagination(totalCount, pagReq)
 	return dto.ReportScheduleByAdvisorResponse{
-		Reports: reportScheduleResponses,
+		Reports:  reportScheduleResponses,
+		Warnings: warnings.List(),
 	}, paginationResponse, nil
 }
 
@@ -311,10 +324,13 @@ func getMapKeys[K comparable, V any](m map[K]V) []K {
 }
 
 func (s *reportScheduleService) FindByUserID(ctx context.Context, token string) ([]dto.ReportScheduleResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return nil, errors.New("user NRP not found")
 	}
 
@@ -336,19 +352,12 @@ func (s *reportScheduleService) FindByUserID(ctx context.Context, token string)
 			Week:                 reportSchedule.Week,
 			StartDate:            reportSchedule.StartDate.Format(time.RFC3339),
 			EndDate:              reportSchedule.EndDate.Format(time.RFC3339),
+			SubmissionStatus:     resolveSubmissionStatus(reportSchedule),
 		}
 
 		if len(reportSchedule.Report) > 0 {
-			reportScheduleResponse.Report = &dto.ReportResponse{
-				ID:                    reportSchedule.Report[0].ID.String(),
-				ReportScheduleID:      reportSchedule.ID.String(),
-				Title:                 reportSchedule.Report[0].Title,
-				Content:               reportSchedule.Report[0].Content,
-				ReportType:            reportSchedule.Report[0].ReportType,
-				Feedback:              reportSchedule.Report[0].Feedback,
-				AcademicAdvisorStatus: reportSchedule.Report[0].AcademicAdvisorStatus,
-				FileStorageID:         reportSchedule.Report[0].FileStorageID,
-			}
+			reportResponse := toReportResponse(reportSchedule.Report[0])
+			reportScheduleResponse.Report = &reportResponse
 		}
 		reportScheduleResponses = append(reportScheduleResponses, reportScheduleResponse)
 	}
@@ -367,9 +376,13 @@ func (s *reportScheduleService) Index(ctx context.Context, token string) (dto.Re
 
 	for userID, reportScheduleAdvisors := range reportSchedules {
 		// get user by user id
-		user := s.userManagementService.GetUserByID("GET", token, userID)
-		userName, ok := user["name"].(string)
-		if !ok {
+		user, err := s.userManagementService.GetUserByID(ctx, "GET", token, userID)
+		if err != nil {
+			return dto.ReportScheduleByAdvisorResponse{}, err
+		}
+
+		userName := user.Name
+		if userName == "" {
 			return dto.ReportScheduleByAdvisorResponse{}, errors.New("user name not found")
 		}
 
@@ -387,20 +400,13 @@ func (s *reportScheduleService) Index(ctx context.Context, token string) (dto.Re
 				Week:                 reportScheduleAdvisor.Week,
 				StartDate:            reportScheduleAdvisor.StartDate.Format(time.RFC3339),
 				EndDate:              reportScheduleAdvisor.EndDate.Format(time.RFC3339),
+				SubmissionStatus:     resolveSubmissionStatus(reportScheduleAdvisor),
 			}
 
 			// Only set Report if there are reports
 			if len(reportScheduleAdvisor.Report) > 0 {
-				response.Report = &dto.ReportResponse{
-					ID:                    reportScheduleAdvisor.Report[0].ID.String(),
-					ReportScheduleID:      reportScheduleAdvisor.ID.String(),
-					Title:                 reportScheduleAdvisor.Report[0].Title,
-					Content:               reportScheduleAdvisor.Report[0].Content,
-					ReportType:            reportScheduleAdvisor.Report[0].ReportType,
-					Feedback:              reportScheduleAdvisor.Report[0].Feedback,
-					AcademicAdvisorStatus: reportScheduleAdvisor.Report[0].AcademicAdvisorStatus,
-					FileStorageID:         reportScheduleAdvisor.Report[0].FileStorageID,
-				}
+				reportResponse := toReportResponse(reportScheduleAdvisor.Report[0])
+				response.Report = &reportResponse
 			}
 
 			reportSchedule = append(reportSchedule, response)
@@ -413,50 +419,66 @@ func (s *reportScheduleService) Index(ctx context.Context, token string) (dto.Re
 	}, nil
 }
 
-func (s *reportScheduleService) ReportScheduleAccess(ctx context.Context, reportSchedule dto.ReportScheduleRequest, token string) (bool, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+// authorize checks the current user against the policy for action on resource and returns that user
+func (s *reportScheduleService) authorize(ctx context.Context, token string, action string, resource helper.PolicyResource) (dto.User, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.User{}, err
+	}
 
-	// convert userRole to string
-	userRole, ok := user["role"].(string)
-	if !ok {
-		return false, errors.New("user role not found")
+	err = s.advisorAssignments.Authorize(ctx, user, action, resource)
+	if err != nil {
+		return dto.User{}, err
 	}
 
-	if userRole == "DOSEN PEMBIMBING" {
-		userEmail, ok := user["email"].(string)
-		if !ok {
-			return false, errors.New("user email not found")
-		}
+	return user, nil
+}
 
-		if userEmail != reportSchedule.AcademicAdvisorEmail {
-			return false, errors.New("user email not match")
-		}
-	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
-		return false, errors.New("user role not allowed")
-	}
+// reportScheduleSnapshot is a report schedule as the audit trail records it, without the report preloaded with it
+func reportScheduleSnapshot(reportSchedule entity.ReportSchedule) entity.ReportSchedule {
+	reportSchedule.Report = nil
+	return reportSchedule
+}
 
-	return true, nil
+// reportSchedulePolicyResource describes a report schedule, or what hangs off it, to the policy
+func reportSchedulePolicyResource(kind string, reportSchedule entity.ReportSchedule) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           kind,
+		RegistrationID: reportSchedule.RegistrationID,
+		OwnerID:        reportSchedule.UserID,
+		OwnerNRP:       reportSchedule.UserNRP,
+		AdvisorEmail:   reportSchedule.AcademicAdvisorEmail,
+	}
 }
 
-// Create creates a new report schedule
+// Create creates a new report schedule. The student, advisor and activity are the registration's,
+// whatever the request says, and the caller is checked against that registration.
 func (s *reportScheduleService) Create(ctx context.Context, reportSchedule dto.ReportScheduleRequest, token string) (dto.ReportScheduleResponse, error) {
-	// first we need to get user email and user id
-	access, err := s.ReportScheduleAccess(ctx, reportSchedule, token)
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", reportSchedule.RegistrationID, token)
 	if err != nil {
+		log.Println("ERROR GETTING REGISTRATION: ", err)
 		return dto.ReportScheduleResponse{}, err
 	}
 
-	if !access {
-		return dto.ReportScheduleResponse{}, errors.New("user role not allowed")
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_CREATE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_REPORT_SCHEDULE,
+		RegistrationID: reportSchedule.RegistrationID,
+		OwnerID:        registration.UserID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	})
+	if err != nil {
+		return dto.ReportScheduleResponse{}, err
 	}
 
 	var reportScheduleEntity entity.ReportSchedule
 	reportScheduleEntity.ID = uuid.New()
-	reportScheduleEntity.UserID = reportSchedule.UserID
-	reportScheduleEntity.UserNRP = reportSchedule.UserNRP
+	reportScheduleEntity.UserID = registration.UserID
+	reportScheduleEntity.UserNRP = registration.UserNRP
 	reportScheduleEntity.RegistrationID = reportSchedule.RegistrationID
-	reportScheduleEntity.AcademicAdvisorID = reportSchedule.AcademicAdvisorID
-	reportScheduleEntity.AcademicAdvisorEmail = reportSchedule.AcademicAdvisorEmail
+	reportScheduleEntity.AcademicAdvisorID = registration.AcademicAdvisor
+	reportScheduleEntity.AcademicAdvisorEmail = registration.AcademicAdvisorEmail
+	reportScheduleEntity.ActivityName = registration.ActivityName
 	reportScheduleEntity.ReportType = reportSchedule.ReportType
 	reportScheduleEntity.Week = reportSchedule.Week
 	// convert string to time.Time
@@ -478,7 +500,19 @@ func (s *reportScheduleService) Create(ctx context.Context, reportSchedule dto.R
 	reportScheduleEntity.CreatedAt = &now
 	reportScheduleEntity.UpdatedAt = &now
 
-	_, err = s.reportScheduleRepo.Create(ctx, reportScheduleEntity, nil)
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, err := s.reportScheduleRepo.Create(ctx, reportScheduleEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_REPORT_SCHEDULE, created.ID.String(), nil, reportScheduleSnapshot(created))
+		if err != nil {
+			return err
+		}
+
+		return recordReportSchedulesCreated(ctx, s.outboxService, tx, []entity.ReportSchedule{created})
+	})
 	if err != nil {
 		log.Println("ERROR CREATING REPORT SCHEDULE: ", err)
 		return dto.ReportScheduleResponse{}, err
@@ -488,31 +522,213 @@ func (s *reportScheduleService) Create(ctx context.Context, reportSchedule dto.R
 		ID:                   reportScheduleEntity.ID.String(),
 		UserID:               reportScheduleEntity.UserID,
 		RegistrationID:       reportScheduleEntity.RegistrationID,
+		ActivityName:         reportScheduleEntity.ActivityName,
 		AcademicAdvisorID:    reportScheduleEntity.AcademicAdvisorID,
 		AcademicAdvisorEmail: reportScheduleEntity.AcademicAdvisorEmail,
 		ReportType:           reportScheduleEntity.ReportType,
 		Week:                 reportScheduleEntity.Week,
 		StartDate:            reportScheduleEntity.StartDate.Format(time.RFC3339),
 		EndDate:              reportScheduleEntity.EndDate.Format(time.RFC3339),
+		SubmissionStatus:     resolveSubmissionStatus(reportScheduleEntity),
 	}, nil
 }
 
-func (s *reportScheduleService) Update(ctx context.Context, id string, subject dto.ReportScheduleRequest, token string) error {
+// Generate creates every weekly (and optionally the final) report schedule of a registration's activity window
+func (s *reportScheduleService) Generate(ctx context.Context, registrationID string, request dto.ReportScheduleGenerateRequest, token string) (dto.ReportScheduleGenerateResponse, error) {
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+	if err != nil {
+		log.Println("ERROR GETTING REGISTRATION: ", err)
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration not found")
+	}
+
+	if registration.UserID == "" {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration user id not found")
+	}
 
-	access, err := s.ReportScheduleAccess(ctx, subject, token)
+	if registration.UserNRP == "" {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration user nrp not found")
+	}
+
+	if registration.AcademicAdvisorEmail == "" {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration academic advisor email not found")
+	}
+
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_CREATE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_REPORT_SCHEDULE,
+		RegistrationID: registrationID,
+		OwnerID:        registration.UserID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	})
 	if err != nil {
-		return err
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	startDate, err := time.Parse(time.RFC3339, request.StartDate)
+	if err != nil {
+		log.Println("ERROR CONVERTING START DATE: ", err)
+		return dto.ReportScheduleGenerateResponse{}, fmt.Errorf("%w: start date must be RFC3339", helper.ErrInvalidDateRange)
+	}
+
+	endDate, err := time.Parse(time.RFC3339, request.EndDate)
+	if err != nil {
+		log.Println("ERROR CONVERTING END DATE: ", err)
+		return dto.ReportScheduleGenerateResponse{}, fmt.Errorf("%w: end date must be RFC3339", helper.ErrInvalidDateRange)
+	}
+
+	reportSchedules, err := newRegistrationReportSchedules(registrationID, registration, startDate, endDate, request.Cadence, request.IncludeFinalReport, s.config.MaxWeeks)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	var created, skipped []entity.ReportSchedule
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, skipped, err = s.reportScheduleRepo.CreateForRegistration(ctx, registrationID, reportSchedules, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, reportSchedule := range created {
+			err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_REPORT_SCHEDULE, reportSchedule.ID.String(), nil, reportScheduleSnapshot(reportSchedule))
+			if err != nil {
+				return err
+			}
+		}
+
+		return recordReportSchedulesCreated(ctx, s.outboxService, tx, created)
+	})
+	if err != nil {
+		log.Println("ERROR GENERATING REPORT SCHEDULES: ", err)
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	response := dto.ReportScheduleGenerateResponse{
+		Created: []dto.ReportScheduleResponse{},
+		Skipped: []dto.ReportScheduleResponse{},
+	}
+	for _, reportSchedule := range created {
+		response.Created = append(response.Created, toReportScheduleResponse(reportSchedule))
+	}
+	for _, reportSchedule := range skipped {
+		response.Skipped = append(response.Skipped, toReportScheduleResponse(reportSchedule))
+	}
+
+	return response, nil
+}
+
+// newRegistrationReportSchedules builds the weekly (and optionally the final) report schedules of a
+// registration's activity window, which may be at most maxWeeks long
+func newRegistrationReportSchedules(registrationID string, registration dto.Registration, startDate time.Time, endDate time.Time, cadence string, includeFinalReport bool, maxWeeks int) ([]entity.ReportSchedule, error) {
+	err := helper.ValidateReportWindow(startDate, endDate, maxWeeks)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := helper.GenerateReportPeriods(startDate, endDate, cadence)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	newReportSchedule := func(reportType string, week int, periodStart time.Time, periodEnd time.Time) entity.ReportSchedule {
+		return entity.ReportSchedule{
+			ID:                   uuid.New(),
+			UserID:               registration.UserID,
+			UserNRP:              registration.UserNRP,
+			RegistrationID:       registrationID,
+			ActivityName:         registration.ActivityName,
+			AcademicAdvisorID:    registration.AcademicAdvisor,
+			AcademicAdvisorEmail: registration.AcademicAdvisorEmail,
+			ReportType:           reportType,
+			Week:                 week,
+			StartDate:            &periodStart,
+			EndDate:              &periodEnd,
+			BaseModel: entity.BaseModel{
+				CreatedAt: &now,
+				UpdatedAt: &now,
+			},
+		}
+	}
+
+	var reportSchedules []entity.ReportSchedule
+	for _, period := range periods {
+		reportSchedules = append(reportSchedules, newReportSchedule("WEEKLY_REPORT", period.Week, period.StartDate, period.EndDate))
+	}
+
+	// the final report covers the whole activity and is due when it ends
+	if includeFinalReport {
+		reportSchedules = append(reportSchedules, newReportSchedule("FINAL_REPORT", helper.ActivityWeeks(startDate, endDate), startDate, endDate))
+	}
+
+	return reportSchedules, nil
+}
+
+func toReportScheduleResponse(reportSchedule entity.ReportSchedule) dto.ReportScheduleResponse {
+	response := dto.ReportScheduleResponse{
+		ID:                   reportSchedule.ID.String(),
+		UserID:               reportSchedule.UserID,
+		UserNRP:              reportSchedule.UserNRP,
+		RegistrationID:       reportSchedule.RegistrationID,
+		ActivityName:         reportSchedule.ActivityName,
+		AcademicAdvisorID:    reportSchedule.AcademicAdvisorID,
+		AcademicAdvisorEmail: reportSchedule.AcademicAdvisorEmail,
+		ReportType:           reportSchedule.ReportType,
+		Week:                 reportSchedule.Week,
+		SubmissionStatus:     resolveSubmissionStatus(reportSchedule),
+	}
+
+	if reportSchedule.StartDate != nil {
+		response.StartDate = reportSchedule.StartDate.Format(time.RFC3339)
+	}
+	if reportSchedule.EndDate != nil {
+		response.EndDate = reportSchedule.EndDate.Format(time.RFC3339)
+	}
+
+	if len(reportSchedule.Report) > 0 {
+		reportResponse := toReportResponse(reportSchedule.Report[0])
+		response.Report = &reportResponse
 	}
 
-	if !access {
-		return errors.New("user role not allowed")
+	return response
+}
+
+// resolveSubmissionStatus classifies a schedule as on time, late, missing or still open from its latest report
+func resolveSubmissionStatus(reportSchedule entity.ReportSchedule) string {
+	submitted, isLate := false, false
+	if len(reportSchedule.Report) > 0 && reportSchedule.Report[0].AcademicAdvisorStatus != helper.REPORT_STATUS_DRAFT {
+		submitted = true
+		isLate = reportSchedule.Report[0].IsLate
 	}
 
+	return helper.ResolveSubmissionStatus(reportSchedule.EndDate, submitted, isLate, time.Now())
+}
+
+// Update changes a report schedule. An advisor may only update the schedules assigned to them, and
+// may not hand one over to another advisor.
+func (s *reportScheduleService) Update(ctx context.Context, id string, subject dto.ReportScheduleRequest, token string) error {
 	res, err := s.reportScheduleRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_UPDATE, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT_SCHEDULE, res))
+	if err != nil {
+		return err
+	}
+
+	if subject.AcademicAdvisorEmail != "" && subject.AcademicAdvisorEmail != res.AcademicAdvisorEmail {
+		_, err = s.authorize(ctx, token, helper.POLICY_ACTION_UPDATE, helper.PolicyResource{
+			Kind:           helper.POLICY_RESOURCE_REPORT_SCHEDULE,
+			RegistrationID: res.RegistrationID,
+			OwnerID:        res.UserID,
+			OwnerNRP:       res.UserNRP,
+			AdvisorEmail:   subject.AcademicAdvisorEmail,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create programTypeEntity with original ID
 	reportScheduleEntity := entity.ReportSchedule{
 		ID: res.ID,
@@ -546,13 +762,25 @@ func (s *reportScheduleService) Update(ctx context.Context, id string, subject d
 		}
 	}
 
+	// A schedule never moves to another registration or student, only its advisor may change
+	reportScheduleEntity.RegistrationID = res.RegistrationID
+	reportScheduleEntity.UserID = res.UserID
+	reportScheduleEntity.UserNRP = res.UserNRP
+
 	// Perform the update
-	err = s.reportScheduleRepo.Update(ctx, id, reportScheduleEntity, nil)
-	if err != nil {
-		return err
-	}
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.reportScheduleRepo.Update(ctx, id, reportScheduleEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		updated, err := s.reportScheduleRepo.FindByID(ctx, id, tx)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_UPDATE, helper.POLICY_RESOURCE_REPORT_SCHEDULE, id, reportScheduleSnapshot(res), reportScheduleSnapshot(updated))
+	})
 }
 
 // FindByID retrieves a report schedule by its ID
@@ -563,19 +791,11 @@ func (s *reportScheduleService) FindByID(ctx context.Context, id string, token s
 		return dto.ReportScheduleResponse{}, err
 	}
 
-	reportScheduleRequest := dto.ReportScheduleRequest{
-		AcademicAdvisorEmail: reportSchedule.AcademicAdvisorEmail,
-	}
-
-	access, err := s.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT_SCHEDULE, reportSchedule))
 	if err != nil {
 		return dto.ReportScheduleResponse{}, err
 	}
 
-	if !access {
-		return dto.ReportScheduleResponse{}, errors.New("user role not allowed")
-	}
-
 	var reportScheduleResponse dto.ReportScheduleResponse
 	reportScheduleResponse.ID = reportSchedule.ID.String()
 	reportScheduleResponse.UserID = reportSchedule.UserID
@@ -586,18 +806,11 @@ func (s *reportScheduleService) FindByID(ctx context.Context, id string, token s
 	reportScheduleResponse.Week = reportSchedule.Week
 	reportScheduleResponse.StartDate = reportSchedule.StartDate.Format(time.RFC3339)
 	reportScheduleResponse.EndDate = reportSchedule.EndDate.Format(time.RFC3339)
+	reportScheduleResponse.SubmissionStatus = resolveSubmissionStatus(reportSchedule)
 
 	if len(reportSchedule.Report) > 0 {
-		reportScheduleResponse.Report = &dto.ReportResponse{
-			ID:                    reportSchedule.Report[0].ID.String(),
-			ReportScheduleID:      reportSchedule.ID.String(),
-			FileStorageID:         reportSchedule.Report[0].FileStorageID,
-			Title:                 reportSchedule.Report[0].Title,
-			Content:               reportSchedule.Report[0].Content,
-			ReportType:            reportSchedule.Report[0].ReportType,
-			Feedback:              reportSchedule.Report[0].Feedback,
-			AcademicAdvisorStatus: reportSchedule.Report[0].AcademicAdvisorStatus,
-		}
+		reportResponse := toReportResponse(reportSchedule.Report[0])
+		reportScheduleResponse.Report = &reportResponse
 	}
 
 	return reportScheduleResponse, nil
@@ -605,59 +818,44 @@ func (s *reportScheduleService) FindByID(ctx context.Context, id string, token s
 
 // Destroy deletes a report schedule
 func (s *reportScheduleService) Destroy(ctx context.Context, id string, token string) error {
-
-	access, err := s.ReportScheduleAccess(ctx, dto.ReportScheduleRequest{}, token)
+	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
-	if !access {
-		return errors.New("user role not allowed")
-	}
-
-	err = s.reportScheduleRepo.Destroy(ctx, id, nil)
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_DELETE, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT_SCHEDULE, reportSchedule))
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.reportScheduleRepo.Destroy(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_REPORT_SCHEDULE, id, reportScheduleSnapshot(reportSchedule), nil)
+	})
 }
 
-// FindByRegistrationID retrieves report schedules by registration ID
-func (s *reportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string) ([]dto.ReportScheduleResponse, error) {
+// FindByRegistrationID retrieves report schedules by registration ID. Every schedule of a registration
+// shares its student and advisor, so the first one stands for the registration.
+func (s *reportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.ReportScheduleResponse, error) {
 	reportSchedules, err := s.reportScheduleRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	if len(reportSchedules) > 0 {
+		_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REGISTRATION, reportSchedules[0]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var reportScheduleResponses []dto.ReportScheduleResponse
 	for _, reportSchedule := range reportSchedules {
-		var reportScheduleData dto.ReportScheduleResponse
-		reportScheduleData.ID = reportSchedule.ID.String()
-		reportScheduleData.UserID = reportSchedule.UserID
-		reportScheduleData.RegistrationID = reportSchedule.RegistrationID
-		reportScheduleData.AcademicAdvisorID = reportSchedule.AcademicAdvisorID
-		reportScheduleData.AcademicAdvisorEmail = reportSchedule.AcademicAdvisorEmail
-		reportScheduleData.ReportType = reportSchedule.ReportType
-		reportScheduleData.Week = reportSchedule.Week
-		reportScheduleData.StartDate = reportSchedule.StartDate.Format(time.RFC3339)
-		reportScheduleData.EndDate = reportSchedule.EndDate.Format(time.RFC3339)
-
-		if len(reportSchedule.Report) > 0 {
-			reportScheduleData.Report = &dto.ReportResponse{
-				ID:                    reportSchedule.Report[0].ID.String(),
-				ReportScheduleID:      reportSchedule.ID.String(),
-				FileStorageID:         reportSchedule.Report[0].FileStorageID,
-				Title:                 reportSchedule.Report[0].Title,
-				Content:               reportSchedule.Report[0].Content,
-				ReportType:            reportSchedule.Report[0].ReportType,
-				Feedback:              reportSchedule.Report[0].Feedback,
-				AcademicAdvisorStatus: reportSchedule.Report[0].AcademicAdvisorStatus,
-			}
-		} else {
-			reportScheduleData.Report = nil // Ensure Report is nil if no reports exist
-		}
-		reportScheduleResponses = append(reportScheduleResponses, reportScheduleData)
+		reportScheduleResponses = append(reportScheduleResponses, toReportScheduleResponse(reportSchedule))
 	}
 
 	return reportScheduleResponses, nil
@@ -4,42 +4,61 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"mime/multipart"
 	"monitoring-service/dto"
 	"monitoring-service/entity"
+	"monitoring-service/helper"
 	"monitoring-service/repository"
 	"reflect"
 	"time"
 
 	storageService "github.com/SIM-MBKM/filestorage/storage"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type reportService struct {
 	reportRepo            repository.ReportRepository
 	reportScheduleRepo    repository.ReportScheduleReposiotry
+	reportRevisionRepo    repository.ReportRevisionRepository
 	fileService           *FileService
-	userManagementService *UserManagementService
-	brokerService         *BrokerService
+	userManagementService UserManagementService
+	notificationService   NotificationService
+	advisorNotifications  AdvisorNotificationService
+	templateService       NotificationTemplateService
+	outboxService         OutboxService
+	auditService          AuditService
+	advisorAssignments    AdvisorAssignmentService
+	latePolicies          helper.LatePolicies
 }
 
 type ReportService interface {
 	Index(ctx context.Context) ([]dto.ReportResponse, error)
 	Create(ctx context.Context, report dto.ReportRequest, file *multipart.FileHeader, token string) (dto.ReportResponse, error)
-	Update(ctx context.Context, id string, report dto.ReportRequest) error
+	Update(ctx context.Context, id string, report dto.ReportRequest, token string) error
 	FindByID(ctx context.Context, id string, token string) (dto.ReportResponse, error)
-	Destroy(ctx context.Context, id string) error
-	FindByReportScheduleID(ctx context.Context, reportScheduleID string) ([]dto.ReportResponse, error)
+	Destroy(ctx context.Context, id string, token string) error
+	FindByReportScheduleID(ctx context.Context, reportScheduleID string, token string) ([]dto.ReportResponse, error)
 	Approval(ctx context.Context, token string, report dto.ReportApprovalRequest) error
+	FindRevisions(ctx context.Context, reportID string, token string) ([]dto.ReportRevisionResponse, error)
+	DiffRevisions(ctx context.Context, reportID string, fromRevision int, toRevision int, token string) (dto.ReportRevisionDiffResponse, error)
 }
 
-func NewReportService(reportRepo repository.ReportRepository, reportScheduleRepo repository.ReportScheduleReposiotry, userManagementBaseURI string, brokerBaseURI string, asyncURIs []string, config *storageService.Config, tokenManager *storageService.CacheTokenManager) ReportService {
+func NewReportService(reportRepo repository.ReportRepository, reportScheduleRepo repository.ReportScheduleReposiotry, reportRevisionRepo repository.ReportRevisionRepository, userManagementService UserManagementService, notificationService NotificationService, advisorNotifications AdvisorNotificationService, templateService NotificationTemplateService, outboxService OutboxService, auditService AuditService, advisorAssignments AdvisorAssignmentService, fileService *FileService, latePolicies helper.LatePolicies) ReportService {
 	return &reportService{
 		reportRepo:            reportRepo,
 		reportScheduleRepo:    reportScheduleRepo,
-		fileService:           NewFileService(config, tokenManager),
-		userManagementService: NewUserManagementService(userManagementBaseURI, asyncURIs),
-		brokerService:         NewBrokerService(brokerBaseURI, asyncURIs),
+		reportRevisionRepo:    reportRevisionRepo,
+		fileService:           fileService,
+		userManagementService: userManagementService,
+		notificationService:   notificationService,
+		advisorNotifications:  advisorNotifications,
+		templateService:       templateService,
+		outboxService:         outboxService,
+		auditService:          auditService,
+		advisorAssignments:    advisorAssignments,
+		latePolicies:          latePolicies,
 	}
 }
 
@@ -49,13 +68,23 @@ func (s *reportService) Approval(ctx context.Context, token string, report dto.R
 		return errors.New("at least one report ID is required")
 	}
 
-	advisor := s.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := advisor["email"].(string)
-	advisorName := advisor["name"]
-	if !ok {
+	advisor, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	advisorEmail := advisor.Email
+	if advisorEmail == "" {
 		return errors.New("advisor email not found")
 	}
 
+	if !helper.ValidateReviewStatus(report.Status) {
+		return fmt.Errorf("%w: a review can only request a revision, approve or reject", helper.ErrInvalidReportStatus)
+	}
+
+	// check every report before writing so a bad transition does not leave a partial approval behind
+	reportEntities := make([]entity.Report, 0, len(report.IDs))
+	reportSchedules := make([]entity.ReportSchedule, 0, len(report.IDs))
 	for _, reportID := range report.IDs {
 		reportEntity, err := s.reportRepo.FindByID(ctx, reportID, nil)
 		if err != nil {
@@ -67,40 +96,90 @@ func (s *reportService) Approval(ctx context.Context, token string, report dto.R
 			return err
 		}
 
-		if advisorEmail != reportSchedule.AcademicAdvisorEmail {
-			return errors.New("unauthorized")
+		err = s.advisorAssignments.Authorize(ctx, advisor, helper.POLICY_ACTION_REVIEW, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT, reportSchedule))
+		if err != nil {
+			return err
 		}
 
-		reportEntity.AcademicAdvisorStatus = report.Status
-		reportEntity.Feedback = report.Feedback
-
-		err = s.reportRepo.Approval(ctx, reportID, reportEntity, nil)
+		err = helper.ValidateReportStatusTransition(reportEntity.AcademicAdvisorStatus, report.Status)
 		if err != nil {
 			return err
 		}
 
-		// get mahasiswa data
-		mahasiswaData := s.userManagementService.GetUserByFilter(map[string]interface{}{
+		reportEntities = append(reportEntities, reportEntity)
+		reportSchedules = append(reportSchedules, reportSchedule)
+	}
+
+	// get mahasiswa data, the approval stands even when a student cannot be notified
+	var notifications []dto.NotificationRequest
+	for i, reportSchedule := range reportSchedules {
+		mahasiswaData, err := s.userManagementService.GetUserByFilter(ctx, map[string]interface{}{
 			"user_nrp": reportSchedule.UserNRP,
 		}, "POST", token)
+		if err != nil {
+			log.Println("ERROR GETTING REPORT STUDENT: ", err)
+			continue
+		}
 
-		if mahasiswaData != nil || len(mahasiswaData) != 0 {
-			message := fmt.Sprintf("report week %d %s has been %s by %s", reportSchedule.Week, reportSchedule.ReportType, report.Status, advisorName)
-			mahasiswaEmail := mahasiswaData[0]["email"]
-
-			err = s.brokerService.SendNotification(map[string]interface{}{
-				"sender_name":    advisorName,
-				"sender_email":   advisorEmail,
-				"receiver_email": mahasiswaEmail,
-				"type":           "APPROVAL REPORT",
-				"message":        message,
-			}, "POST", token)
+		if len(mahasiswaData) != 0 {
+			notification, err := s.templateService.Render(ctx, dto.NotificationRequest{
+				SenderName:    advisor.Name,
+				SenderEmail:   advisorEmail,
+				ReceiverEmail: mahasiswaData[0].Email,
+				Type:          helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+			}, dto.NotificationTemplateData{
+				StudentName:  mahasiswaData[0].Name,
+				StudentNRP:   reportSchedule.UserNRP,
+				AdvisorName:  advisor.Name,
+				ActivityName: reportSchedule.ActivityName,
+				ReportType:   reportSchedule.ReportType,
+				Week:         reportSchedule.Week,
+				Status:       report.Status,
+				Feedback:     report.Feedback,
+				Link:         "/reports/" + reportEntities[i].ID.String(),
+			})
+			if err != nil {
+				log.Println("ERROR RENDERING APPROVAL NOTIFICATION: ", err)
+				continue
+			}
 
+			notifications = append(notifications, notification)
 		}
-
 	}
 
-	return nil
+	// the reviews, their events and notifications are committed together, the notifications
+	// are delivered by the notification worker once the whole approval is stored
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		reviewedAt := time.Now()
+		for i, reportEntity := range reportEntities {
+			before := reportEntity
+			reportEntity.AcademicAdvisorStatus = report.Status
+			reportEntity.Feedback = report.Feedback
+			reportEntity.ReviewedAt = &reviewedAt
+
+			err := s.reportRepo.Approval(ctx, reportEntity.ID.String(), reportEntity, tx)
+			if err != nil {
+				return err
+			}
+
+			err = s.recordRevision(ctx, reportEntity, advisor, tx)
+			if err != nil {
+				return err
+			}
+
+			err = s.auditService.Record(ctx, tx, advisor, helper.AUDIT_ACTION_REVIEW, helper.POLICY_RESOURCE_REPORT, reportEntity.ID.String(), before, reportEntity)
+			if err != nil {
+				return err
+			}
+
+			err = s.recordReportReviewed(ctx, tx, reportEntity, reportSchedules[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.notificationService.Enqueue(ctx, tx, notifications...)
+	})
 }
 
 // Index retrieves all reports
@@ -112,16 +191,7 @@ func (s *reportService) Index(ctx context.Context) ([]dto.ReportResponse, error)
 
 	var reportResponses []dto.ReportResponse
 	for _, report := range reports {
-		reportResponses = append(reportResponses, dto.ReportResponse{
-			ID:                    report.ID.String(),
-			ReportScheduleID:      report.ReportScheduleID,
-			FileStorageID:         report.FileStorageID,
-			Title:                 report.Title,
-			Content:               report.Content,
-			ReportType:            report.ReportType,
-			Feedback:              report.Feedback,
-			AcademicAdvisorStatus: report.AcademicAdvisorStatus,
-		})
+		reportResponses = append(reportResponses, toReportResponse(report))
 	}
 
 	return reportResponses, nil
@@ -129,28 +199,46 @@ func (s *reportService) Index(ctx context.Context) ([]dto.ReportResponse, error)
 
 // Create creates a new report
 func (s *reportService) Create(ctx context.Context, report dto.ReportRequest, file *multipart.FileHeader, token string) (dto.ReportResponse, error) {
-	// Generate new UUID for the report
-	var result *storageService.FileResponse
-	if file != nil {
-		var err error
-		result, err = s.fileService.storage.GcsUpload(file, "sim_mbkm", "", "")
-		if err != nil {
-			return dto.ReportResponse{}, err
-		}
+	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, report.ReportScheduleID, nil)
+	if err != nil {
+		return dto.ReportResponse{}, err
 	}
 
-	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, report.ReportScheduleID, nil)
+	user, err := s.userManagementService.CurrentUser(ctx, token)
 	if err != nil {
 		return dto.ReportResponse{}, err
 	}
 
-	user := s.userManagementService.GetUserData("GET", token)
+	err = s.advisorAssignments.Authorize(ctx, user, helper.POLICY_ACTION_CREATE, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT, reportSchedule))
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
 
-	if user["id"] != reportSchedule.UserID {
-		return dto.ReportResponse{}, errors.New("unauthorized")
+	status, err := helper.ValidateInitialReportStatus(report.Status)
+	if err != nil {
+		return dto.ReportResponse{}, err
 	}
 
 	var reportEntity entity.Report
+
+	// Drafts are not submissions yet, their lateness is checked once they are submitted
+	now := time.Now()
+	if status != helper.REPORT_STATUS_DRAFT {
+		err = s.markSubmitted(&reportEntity, reportSchedule, now)
+		if err != nil {
+			return dto.ReportResponse{}, err
+		}
+	}
+
+	// Upload only once the report is accepted so rejected submissions leave no orphan files
+	var result *storageService.FileResponse
+	if file != nil {
+		result, err = s.fileService.Upload(ctx, file)
+		if err != nil {
+			return dto.ReportResponse{}, err
+		}
+	}
+
 	reportEntity.ID = uuid.New()
 	reportEntity.ReportScheduleID = report.ReportScheduleID
 	if result != nil {
@@ -159,40 +247,95 @@ func (s *reportService) Create(ctx context.Context, report dto.ReportRequest, fi
 	reportEntity.Title = report.Title
 	reportEntity.Content = report.Content
 	reportEntity.ReportType = report.ReportType
-	reportEntity.AcademicAdvisorStatus = "PENDING"
+	reportEntity.AcademicAdvisorStatus = status
 
 	// Set timestamps
-	now := time.Now()
 	reportEntity.CreatedAt = &now
 	reportEntity.UpdatedAt = &now
 
-	reportResponse, err := s.reportRepo.Create(ctx, reportEntity, nil)
+	var reportResponse entity.Report
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		reportResponse, err = s.reportRepo.Create(ctx, reportEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordRevision(ctx, reportResponse, user, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_REPORT, reportResponse.ID.String(), nil, reportResponse)
+		if err != nil {
+			return err
+		}
+
+		if status == helper.REPORT_STATUS_DRAFT {
+			return nil
+		}
+
+		err = s.outboxService.Record(ctx, tx, dto.DOMAIN_EVENT_REPORT_SUBMITTED, "report", reportResponse.ID.String(), reportEventData(reportResponse, reportSchedule))
+		if err != nil {
+			return err
+		}
+
+		notification, data := reportSubmittedNotification(user, reportSchedule, reportResponse, status)
+		return s.advisorNotifications.NotifySubmission(ctx, tx, reportSchedule.RegistrationID, notification, data)
+	})
 	if err != nil {
 		return dto.ReportResponse{}, err
 	}
 
-	return dto.ReportResponse{
-		ID:                    reportResponse.ID.String(),
-		ReportScheduleID:      reportResponse.ReportScheduleID,
-		FileStorageID:         reportResponse.FileStorageID,
-		Title:                 reportResponse.Title,
-		Content:               reportResponse.Content,
-		ReportType:            reportResponse.ReportType,
-		Feedback:              reportResponse.Feedback,
-		AcademicAdvisorStatus: reportResponse.AcademicAdvisorStatus,
-	}, nil
+	return toReportResponse(reportResponse), nil
 }
 
 // Update updates an existing report
-func (s *reportService) Update(ctx context.Context, id string, subject dto.ReportRequest) error {
+func (s *reportService) Update(ctx context.Context, id string, subject dto.ReportRequest, token string) error {
 	res, err := s.reportRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_UPDATE, res)
+	if err != nil {
+		return err
+	}
+
+	// Authors move the report along its lifecycle by editing it; other roles keep the current status
+	status := res.AcademicAdvisorStatus
+	if user.Role == helper.ROLE_STUDENT {
+		status, err = helper.ResolveEditedReportStatus(res.AcademicAdvisorStatus, subject.Status)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create programTypeEntity with original ID
 	reportEntity := entity.Report{
-		ID: res.ID,
+		ID:                    res.ID,
+		AcademicAdvisorStatus: status,
+	}
+
+	// Submitting a draft or a revision is what other services hear about
+	submitted := status != res.AcademicAdvisorStatus &&
+		(status == helper.REPORT_STATUS_PENDING || status == helper.REPORT_STATUS_RESUBMITTED)
+
+	var reportSchedule entity.ReportSchedule
+	if submitted {
+		reportSchedule, err = s.reportScheduleRepo.FindByID(ctx, res.ReportScheduleID, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Submitting a draft is when its deadline is checked. A resubmission keeps the submission time and
+	// lateness of the first submission, the deadline was met or missed then, and a revision the advisor
+	// asked for after the deadline is never rejected by the late policy.
+	if res.AcademicAdvisorStatus == helper.REPORT_STATUS_DRAFT && status == helper.REPORT_STATUS_PENDING {
+		err = s.markSubmitted(&reportEntity, reportSchedule, time.Now())
+		if err != nil {
+			return err
+		}
 	}
 
 	// Get reflection values
@@ -223,73 +366,217 @@ func (s *reportService) Update(ctx context.Context, id string, subject dto.Repor
 		}
 	}
 
+	// A report stays on the schedule it was authorized against
+	reportEntity.ReportScheduleID = res.ReportScheduleID
+
 	// Perform the update
-	err = s.reportRepo.Update(ctx, id, reportEntity, nil)
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.reportRepo.Update(ctx, id, reportEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		updatedReport, err := s.reportRepo.FindByID(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordRevision(ctx, updatedReport, user, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_UPDATE, helper.POLICY_RESOURCE_REPORT, updatedReport.ID.String(), res, updatedReport)
+		if err != nil {
+			return err
+		}
+
+		if !submitted {
+			return nil
+		}
+
+		err = s.outboxService.Record(ctx, tx, dto.DOMAIN_EVENT_REPORT_SUBMITTED, "report", updatedReport.ID.String(), reportEventData(updatedReport, reportSchedule))
+		if err != nil {
+			return err
+		}
+
+		notification, data := reportSubmittedNotification(user, reportSchedule, updatedReport, status)
+		return s.advisorNotifications.NotifySubmission(ctx, tx, reportSchedule.RegistrationID, notification, data)
+	})
+}
+
+// reportSubmittedNotification tells the academic advisors of the schedule that a report awaits review
+func reportSubmittedNotification(student dto.User, reportSchedule entity.ReportSchedule, report entity.Report, status string) (dto.NotificationRequest, dto.NotificationTemplateData) {
+	notificationType := helper.ADVISOR_NOTIFICATION_REPORT_SUBMITTED
+	if status == helper.REPORT_STATUS_RESUBMITTED {
+		notificationType = helper.ADVISOR_NOTIFICATION_REPORT_RESUBMITTED
+	}
+
+	return dto.NotificationRequest{
+		SenderName:    student.Name,
+		SenderEmail:   student.Email,
+		ReceiverEmail: reportSchedule.AcademicAdvisorEmail,
+		Type:          notificationType,
+	}, dto.NotificationTemplateData{
+		StudentName:  student.Name,
+		StudentNRP:   reportSchedule.UserNRP,
+		ActivityName: reportSchedule.ActivityName,
+		ReportType:   reportSchedule.ReportType,
+		Week:         reportSchedule.Week,
+		Status:       status,
+		Title:        report.Title,
+		Link:         "/reports/" + report.ID.String(),
+	}
+}
+
+// markSubmitted stamps the submission time of a report and whether it missed its schedule deadline
+func (s *reportService) markSubmitted(report *entity.Report, reportSchedule entity.ReportSchedule, submittedAt time.Time) error {
+	submission, err := helper.EvaluateSubmission(submittedAt, reportSchedule.EndDate, s.latePolicies.ForReportType(reportSchedule.ReportType))
 	if err != nil {
 		return err
 	}
 
+	report.SubmittedAt = &submittedAt
+	report.IsLate = submission.IsLate
+	report.LateSeconds = int64(submission.LateBy.Seconds())
+	report.LateFlagged = submission.Flagged
+
 	return nil
 }
 
-func (s *reportService) ReportAccess(ctx context.Context, report dto.ReportRequest, token string) (bool, error) {
-	user := s.userManagementService.GetUserData("GET", token)
-
-	// get report schedule
-	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, report.ReportScheduleID, nil)
+// recordRevision snapshots the current head of a report so earlier submissions are never lost
+func (s *reportService) recordRevision(ctx context.Context, report entity.Report, actor dto.User, tx *gorm.DB) error {
+	now := time.Now()
+	_, err := s.reportRevisionRepo.Create(ctx, entity.ReportRevision{
+		ID:                    uuid.New(),
+		ReportID:              report.ID.String(),
+		Title:                 report.Title,
+		Content:               report.Content,
+		ReportType:            report.ReportType,
+		FileStorageID:         report.FileStorageID,
+		Feedback:              report.Feedback,
+		AcademicAdvisorStatus: report.AcademicAdvisorStatus,
+		ActorID:               actor.ID,
+		ActorEmail:            actor.Email,
+		ActorRole:             actor.Role,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}, tx)
 	if err != nil {
-		return false, err
+		log.Println("ERROR RECORDING REPORT REVISION: ", err)
+		return err
 	}
 
-	// convert userRole to string
-	userRole, ok := user["role"].(string)
-	if !ok {
-		return false, errors.New("user role not found")
+	return nil
+}
+
+// recordReportReviewed records the event of an approval or rejection, other reviews are not published
+func (s *reportService) recordReportReviewed(ctx context.Context, tx *gorm.DB, report entity.Report, reportSchedule entity.ReportSchedule) error {
+	var eventType string
+	switch report.AcademicAdvisorStatus {
+	case helper.REPORT_STATUS_APPROVED:
+		eventType = dto.DOMAIN_EVENT_REPORT_APPROVED
+	case helper.REPORT_STATUS_REJECTED:
+		eventType = dto.DOMAIN_EVENT_REPORT_REJECTED
+	default:
+		return nil
 	}
 
-	if userRole == "DOSEN PEMBIMBING" {
-		userEmail, ok := user["email"].(string)
-		if !ok {
-			return false, errors.New("user email not found")
-		}
+	return s.outboxService.Record(ctx, tx, eventType, "report", report.ID.String(), reportEventData(report, reportSchedule))
+}
 
-		if userEmail != reportSchedule.AcademicAdvisorEmail {
-			return false, errors.New("user email not match")
-		}
-	} else if userRole == "MAHASISWA" {
-		userId, ok := user["id"].(string)
-		if !ok {
-			return false, errors.New("user id not found")
-		}
+// FindRevisions retrieves every stored version of a report, oldest first
+func (s *reportService) FindRevisions(ctx context.Context, reportID string, token string) ([]dto.ReportRevisionResponse, error) {
+	report, err := s.reportRepo.FindByID(ctx, reportID, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if userId != reportSchedule.UserID {
-			return false, errors.New("user id not match")
-		}
-	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
-		return false, errors.New("user role not allowed")
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, report)
+	if err != nil {
+		return nil, err
 	}
 
-	return true, nil
+	reportRevisions, err := s.reportRevisionRepo.FindByReportID(ctx, reportID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reportRevisionResponses := []dto.ReportRevisionResponse{}
+	for _, reportRevision := range reportRevisions {
+		reportRevisionResponses = append(reportRevisionResponses, toReportRevisionResponse(reportRevision))
+	}
+
+	return reportRevisionResponses, nil
 }
 
-// FindByID retrieves a report by its ID
-func (s *reportService) FindByID(ctx context.Context, id string, token string) (dto.ReportResponse, error) {
+// DiffRevisions compares two revisions of a report field by field
+func (s *reportService) DiffRevisions(ctx context.Context, reportID string, fromRevision int, toRevision int, token string) (dto.ReportRevisionDiffResponse, error) {
+	report, err := s.reportRepo.FindByID(ctx, reportID, nil)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
+	}
 
-	report, err := s.reportRepo.FindByID(ctx, id, nil)
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, report)
 	if err != nil {
-		return dto.ReportResponse{}, err
+		return dto.ReportRevisionDiffResponse{}, err
 	}
 
-	access, err := s.ReportAccess(ctx, dto.ReportRequest{ReportScheduleID: report.ReportScheduleID}, token)
+	from, err := s.reportRevisionRepo.FindByReportIDAndRevisionNumber(ctx, reportID, fromRevision, nil)
 	if err != nil {
-		return dto.ReportResponse{}, err
+		return dto.ReportRevisionDiffResponse{}, err
 	}
 
-	if !access {
-		return dto.ReportResponse{}, errors.New("unauthorized")
+	to, err := s.reportRevisionRepo.FindByReportIDAndRevisionNumber(ctx, reportID, toRevision, nil)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
 	}
 
-	return dto.ReportResponse{
+	fields := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{"title", from.Title, to.Title},
+		{"content", from.Content, to.Content},
+		{"report_type", from.ReportType, to.ReportType},
+		{"file_storage_id", from.FileStorageID, to.FileStorageID},
+		{"feedback", from.Feedback, to.Feedback},
+		{"academic_advisor_status", from.AcademicAdvisorStatus, to.AcademicAdvisorStatus},
+	}
+
+	changes := []dto.ReportRevisionFieldChange{}
+	for _, field := range fields {
+		if field.from == field.to {
+			continue
+		}
+
+		change := dto.ReportRevisionFieldChange{
+			Field: field.name,
+			From:  field.from,
+			To:    field.to,
+		}
+
+		// free text fields also get a line diff
+		if field.name == "content" || field.name == "feedback" {
+			change.Lines = helper.DiffLines(field.from, field.to)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return dto.ReportRevisionDiffResponse{
+		ReportID: reportID,
+		From:     toReportRevisionResponse(from),
+		To:       toReportRevisionResponse(to),
+		Changes:  changes,
+	}, nil
+}
+
+func toReportResponse(report entity.Report) dto.ReportResponse {
+	response := dto.ReportResponse{
 		ID:                    report.ID.String(),
 		ReportScheduleID:      report.ReportScheduleID,
 		FileStorageID:         report.FileStorageID,
@@ -298,21 +585,122 @@ func (s *reportService) FindByID(ctx context.Context, id string, token string) (
 		ReportType:            report.ReportType,
 		Feedback:              report.Feedback,
 		AcademicAdvisorStatus: report.AcademicAdvisorStatus,
-	}, nil
+		IsLate:                report.IsLate,
+		LateSeconds:           report.LateSeconds,
+		LateFlagged:           report.LateFlagged,
+	}
+
+	if report.SubmittedAt != nil {
+		response.SubmittedAt = report.SubmittedAt.Format(time.RFC3339)
+	}
+
+	if report.ReviewedAt != nil {
+		response.ReviewedAt = report.ReviewedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+func toReportRevisionResponse(reportRevision entity.ReportRevision) dto.ReportRevisionResponse {
+	response := dto.ReportRevisionResponse{
+		ID:                    reportRevision.ID.String(),
+		ReportID:              reportRevision.ReportID,
+		RevisionNumber:        reportRevision.RevisionNumber,
+		Title:                 reportRevision.Title,
+		Content:               reportRevision.Content,
+		ReportType:            reportRevision.ReportType,
+		FileStorageID:         reportRevision.FileStorageID,
+		Feedback:              reportRevision.Feedback,
+		AcademicAdvisorStatus: reportRevision.AcademicAdvisorStatus,
+		ActorID:               reportRevision.ActorID,
+		ActorEmail:            reportRevision.ActorEmail,
+		ActorRole:             reportRevision.ActorRole,
+	}
+
+	if reportRevision.CreatedAt != nil {
+		response.CreatedAt = reportRevision.CreatedAt.Format(time.RFC3339)
+	}
+
+	return response
+}
+
+// authorize checks the current user against the policy for action on report, through the schedule
+// it was submitted for, and returns that user
+func (s *reportService) authorize(ctx context.Context, token string, action string, report entity.Report) (dto.User, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, report.ReportScheduleID, nil)
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, action, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT, reportSchedule))
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	return user, nil
+}
+
+// FindByID retrieves a report by its ID
+func (s *reportService) FindByID(ctx context.Context, id string, token string) (dto.ReportResponse, error) {
+
+	report, err := s.reportRepo.FindByID(ctx, id, nil)
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
+
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, report)
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
+
+	return toReportResponse(report), nil
 }
 
 // Destroy deletes a report
-func (s *reportService) Destroy(ctx context.Context, id string) error {
-	err := s.reportRepo.Destroy(ctx, id, nil)
+func (s *reportService) Destroy(ctx context.Context, id string, token string) error {
+	report, err := s.reportRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_DELETE, report)
+	if err != nil {
+		return err
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.reportRepo.Destroy(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_REPORT, id, report, nil)
+	})
 }
 
 // FindByReportScheduleID retrieves reports by report schedule ID
-func (s *reportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string) ([]dto.ReportResponse, error) {
+func (s *reportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string, token string) ([]dto.ReportResponse, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	reportSchedule, err := s.reportScheduleRepo.FindByID(ctx, reportScheduleID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// read as reports, the delegates reviewing them may list them too
+	err = s.advisorAssignments.Authorize(ctx, user, helper.POLICY_ACTION_VIEW, reportSchedulePolicyResource(helper.POLICY_RESOURCE_REPORT, reportSchedule))
+	if err != nil {
+		return nil, err
+	}
+
 	reports, err := s.reportRepo.FindByReportScheduleID(ctx, reportScheduleID, nil)
 	if err != nil {
 		return nil, err
@@ -320,16 +708,7 @@ func (s *reportService) FindByReportScheduleID(ctx context.Context, reportSchedu
 
 	var reportResponses []dto.ReportResponse
 	for _, report := range reports {
-		reportResponses = append(reportResponses, dto.ReportResponse{
-			ID:                    report.ID.String(),
-			ReportScheduleID:      report.ReportScheduleID,
-			FileStorageID:         report.FileStorageID,
-			Title:                 report.Title,
-			Content:               report.Content,
-			ReportType:            report.ReportType,
-			Feedback:              report.Feedback,
-			AcademicAdvisorStatus: report.AcademicAdvisorStatus,
-		})
+		reportResponses = append(reportResponses, toReportResponse(report))
 	}
 
 	return reportResponses, nil
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	"monitoring-service/repository"
+
+	"gorm.io/gorm"
+)
+
+type scheduleProvisioningService struct {
+	reportScheduleRepo repository.ReportScheduleReposiotry
+	outboxService      OutboxService
+	config             helper.ScheduleProvisioningConfig
+}
+
+type ScheduleProvisioningService interface {
+	Start(ctx context.Context)
+	HandleEvent(ctx context.Context, body []byte) error
+}
+
+func NewScheduleProvisioningService(reportScheduleRepo repository.ReportScheduleReposiotry, outboxService OutboxService, config helper.ScheduleProvisioningConfig) ScheduleProvisioningService {
+	return &scheduleProvisioningService{
+		reportScheduleRepo: reportScheduleRepo,
+		outboxService:      outboxService,
+		config:             config,
+	}
+}
+
+// Start consumes registration approved events until ctx is cancelled
+func (s *scheduleProvisioningService) Start(ctx context.Context) {
+	if !s.config.Enabled {
+		return
+	}
+
+	if _, err := helper.ResolveCadenceDays(s.config.Cadence); err != nil {
+		log.Printf("ERROR STARTING SCHEDULE PROVISIONING: invalid cadence %q", s.config.Cadence)
+		return
+	}
+
+	helper.Consume(ctx, s.config.Consumer, s.HandleEvent)
+}
+
+// HandleEvent creates the report schedules of an approved registration. Events are delivered at least
+// once; schedules that already exist for the registration are skipped, so a redelivery creates nothing.
+func (s *scheduleProvisioningService) HandleEvent(ctx context.Context, body []byte) error {
+	var event dto.RegistrationApprovedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: %v", helper.ErrMalformedMessage, err)
+	}
+
+	if event.Event != dto.REGISTRATION_EVENT_APPROVED {
+		log.Printf("ignoring %q event in schedule provisioning", event.Event)
+		return nil
+	}
+
+	approval := event.Data
+	if err := validateRegistrationApproval(approval); err != nil {
+		return fmt.Errorf("%w: registration %s: %v", helper.ErrMalformedMessage, approval.ID, err)
+	}
+
+	reportSchedules, err := newRegistrationReportSchedules(approval.ID, approval.Registration, approval.ActivityStartDate, approval.ActivityEndDate, s.config.Cadence, s.config.IncludeFinalReport, s.config.MaxWeeks)
+	if err != nil {
+		return fmt.Errorf("%w: registration %s: %v", helper.ErrMalformedMessage, approval.ID, err)
+	}
+
+	var created, skipped []entity.ReportSchedule
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		created, skipped, err = s.reportScheduleRepo.CreateForRegistration(ctx, approval.ID, reportSchedules, tx)
+		if err != nil {
+			return err
+		}
+
+		return recordReportSchedulesCreated(ctx, s.outboxService, tx, created)
+	})
+	if err != nil {
+		log.Println("ERROR PROVISIONING REPORT SCHEDULES: ", err)
+		return err
+	}
+
+	log.Printf("provisioned %d report schedules for registration %s, %d already existed", len(created), approval.ID, len(skipped))
+	return nil
+}
+
+func validateRegistrationApproval(approval dto.RegistrationApproval) error {
+	switch {
+	case approval.ID == "":
+		return errors.New("registration id not found")
+	case approval.UserID == "":
+		return errors.New("registration user id not found")
+	case approval.UserNRP == "":
+		return errors.New("registration user nrp not found")
+	case approval.AcademicAdvisorEmail == "":
+		return errors.New("registration academic advisor email not found")
+	case approval.ActivityStartDate.IsZero() || approval.ActivityEndDate.IsZero():
+		return errors.New("registration activity window not found")
+	}
+
+	return nil
+}
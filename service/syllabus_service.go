@@ -12,49 +12,63 @@ import (
 	"reflect"
 	"time"
 
-	storageService "github.com/SIM-MBKM/filestorage/storage"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type syllabusService struct {
 	syllabusRepo          repository.SyllabusRepository
 	fileService           *FileService
-	userManagementService *UserManagementService
-	registrationService   *RegistrationManagementService
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	outboxService         OutboxService
+	advisorNotifications  AdvisorNotificationService
+	auditService          AuditService
+	advisorAssignments    AdvisorAssignmentService
 }
 
 type SyllabusService interface {
 	Index(ctx context.Context) ([]dto.SyllabusResponse, error)
 	Create(ctx context.Context, syllabus dto.SyllabusRequest, file *multipart.FileHeader, token string) (dto.SyllabusResponse, error)
-	Update(ctx context.Context, id string, syllabus dto.SyllabusRequest) error
+	Update(ctx context.Context, id string, syllabus dto.SyllabusRequest, token string) error
 	FindByID(ctx context.Context, id string, token string) (dto.SyllabusResponse, error)
-	Destroy(ctx context.Context, id string) error
-	FindByRegistrationID(ctx context.Context, registrationID string) (dto.SyllabusResponse, error)
-	FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.SyllabusResponse, error)
+	Destroy(ctx context.Context, id string, token string) error
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.SyllabusResponse, error)
+	FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.SyllabusResponse, error)
 	FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.SyllabusAdvisorFilterRequest) (dto.SyllabusAdvisorResponse, dto.PaginationResponse, error)
 	FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.SyllabusByStudentResponse, error)
 }
 
 func NewSyllabusService(
 	syllabusRepo repository.SyllabusRepository,
-	userManagementBaseURI string,
-	registrationBaseURI string,
-	asyncURIs []string,
-	config *storageService.Config,
-	tokenManager *storageService.CacheTokenManager,
+	userManagementService UserManagementService,
+	registrationService RegistrationManagementService,
+	fileService *FileService,
+	outboxService OutboxService,
+	advisorNotifications AdvisorNotificationService,
+	auditService AuditService,
+	advisorAssignments AdvisorAssignmentService,
 ) SyllabusService {
 	return &syllabusService{
 		syllabusRepo:          syllabusRepo,
-		fileService:           NewFileService(config, tokenManager),
-		userManagementService: NewUserManagementService(userManagementBaseURI, asyncURIs),
-		registrationService:   NewRegistrationManagementService(registrationBaseURI, asyncURIs),
+		fileService:           fileService,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		outboxService:         outboxService,
+		advisorNotifications:  advisorNotifications,
+		auditService:          auditService,
+		advisorAssignments:    advisorAssignments,
 	}
 }
 
 func (s *syllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.SyllabusAdvisorFilterRequest) (dto.SyllabusAdvisorResponse, dto.PaginationResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
@@ -63,18 +77,25 @@ func (s *syllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Contex
 		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, err
 	}
 
+	listed := make(map[string]entity.ListedRegistration, len(syllabuses))
+	for _, syllabus := range syllabuses {
+		listed[syllabus.RegistrationID] = syllabus.ListedRegistration
+	}
+
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
 	syllabusResponses := make(map[string][]dto.SyllabusResponse)
+	var warnings helper.Warnings
 	for userNRP, syllabus := range syllabuses {
 		// Get registration details to add activity name
-		registration := s.registrationService.GetRegistrationByID("GET", syllabus.RegistrationID, token)
-
-		var activityName string
-		if registration != nil {
-			activityNameVal, ok := registration["activity_name"].(string)
-			if ok {
-				activityName = activityNameVal
-			}
+		registration, err := registrations.Get(syllabus.RegistrationID)
+		if err != nil {
+			warnRegistration(&warnings, syllabus.RegistrationID, err)
 		}
+		activityName := registration.ActivityName
 
 		syllabusResponses[userNRP] = append(syllabusResponses[userNRP], dto.SyllabusResponse{
 			ID:                   syllabus.ID.String(),
@@ -94,6 +115,7 @@ func (s *syllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Contex
 
 	return dto.SyllabusAdvisorResponse{
 		Syllabuses: syllabusResponses,
+		Warnings:   warnings.List(),
 	}, paginationResponse, nil
 }
 
@@ -138,42 +160,48 @@ func (s *syllabusService) Create(ctx context.Context, syllabus dto.SyllabusReque
 		return dto.SyllabusResponse{}, errors.New("file is required")
 	}
 
-	// Upload file to storage
-	result, err := s.fileService.storage.GcsUpload(file, "sim_mbkm", "", "")
+	// Verify user has access to this registration before anything is uploaded
+	user, err := s.userManagementService.CurrentUser(ctx, token)
 	if err != nil {
-		return dto.SyllabusResponse{}, err
-	}
-
-	// Verify user has access to this registration
-	user := s.userManagementService.GetUserData("GET", token)
-	if user == nil {
 		log.Println("ERROR GETTING USER DATA: ", err)
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	registration := s.registrationService.GetRegistrationByID("GET", syllabus.RegistrationID, token)
-	if registration == nil {
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", syllabus.RegistrationID, token)
+	if err != nil {
 		log.Println("ERROR GETTING REGISTRATION: ", err)
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	userID, ok := registration["user_id"].(string)
-	if !ok {
+	userID := registration.UserID
+	if userID == "" {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	if userID != user["id"] {
-		log.Println("USER ID DOES NOT MATCH: ", userID, user["id"])
-		return dto.SyllabusResponse{}, errors.New("unauthorized")
+	err = helper.Authorize(user, helper.POLICY_ACTION_CREATE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_SYLLABUS,
+		RegistrationID: syllabus.RegistrationID,
+		OwnerID:        userID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	})
+	if err != nil {
+		return dto.SyllabusResponse{}, err
+	}
+
+	// Upload file to storage
+	result, err := s.fileService.Upload(ctx, file)
+	if err != nil {
+		return dto.SyllabusResponse{}, err
 	}
 
 	// Create syllabus entity
 	var syllabusEntity entity.Syllabus
 	syllabusEntity.ID = uuid.New()
 	syllabusEntity.UserID = userID
-	syllabusEntity.UserNRP = registration["user_nrp"].(string)
-	syllabusEntity.AcademicAdvisorID = registration["academic_advisor"].(string)
-	syllabusEntity.AcademicAdvisorEmail = registration["academic_advisor_email"].(string)
+	syllabusEntity.UserNRP = registration.UserNRP
+	syllabusEntity.AcademicAdvisorID = registration.AcademicAdvisor
+	syllabusEntity.AcademicAdvisorEmail = registration.AcademicAdvisorEmail
 	syllabusEntity.RegistrationID = syllabus.RegistrationID
 	syllabusEntity.Title = syllabus.Title
 	syllabusEntity.FileStorageID = result.FileID
@@ -184,7 +212,44 @@ func (s *syllabusService) Create(ctx context.Context, syllabus dto.SyllabusReque
 	syllabusEntity.UpdatedAt = &now
 
 	// Save to database
-	syllabusResponse, err := s.syllabusRepo.Create(ctx, syllabusEntity, nil)
+	var syllabusResponse entity.Syllabus
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		syllabusResponse, err = s.syllabusRepo.Create(ctx, syllabusEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_SYLLABUS, syllabusResponse.ID.String(), nil, syllabusResponse)
+		if err != nil {
+			return err
+		}
+
+		err = s.outboxService.Record(ctx, tx, dto.DOMAIN_EVENT_SYLLABUS_UPLOADED, "syllabus", syllabusResponse.ID.String(), dto.DocumentEventData{
+			ID:                   syllabusResponse.ID.String(),
+			RegistrationID:       syllabusResponse.RegistrationID,
+			UserID:               syllabusResponse.UserID,
+			UserNRP:              syllabusResponse.UserNRP,
+			AcademicAdvisorEmail: syllabusResponse.AcademicAdvisorEmail,
+			Title:                syllabusResponse.Title,
+			FileStorageID:        syllabusResponse.FileStorageID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.advisorNotifications.NotifySubmission(ctx, tx, syllabusResponse.RegistrationID, dto.NotificationRequest{
+			SenderName:    user.Name,
+			SenderEmail:   user.Email,
+			ReceiverEmail: syllabusResponse.AcademicAdvisorEmail,
+			Type:          helper.ADVISOR_NOTIFICATION_SYLLABUS_UPLOADED,
+		}, dto.NotificationTemplateData{
+			StudentName:  user.Name,
+			StudentNRP:   syllabusResponse.UserNRP,
+			ActivityName: registration.ActivityName,
+			Title:        syllabusResponse.Title,
+			Link:         "/syllabuses/" + syllabusResponse.ID.String(),
+		})
+	})
 	if err != nil {
 		return dto.SyllabusResponse{}, err
 	}
@@ -202,12 +267,17 @@ func (s *syllabusService) Create(ctx context.Context, syllabus dto.SyllabusReque
 }
 
 // Update updates an existing syllabus
-func (s *syllabusService) Update(ctx context.Context, id string, subject dto.SyllabusRequest) error {
+func (s *syllabusService) Update(ctx context.Context, id string, subject dto.SyllabusRequest, token string) error {
 	res, err := s.syllabusRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_UPDATE, syllabusPolicyResource(res))
+	if err != nil {
+		return err
+	}
+
 	// Create syllabusEntity with original ID
 	syllabusEntity := entity.Syllabus{
 		ID: res.ID,
@@ -246,12 +316,44 @@ func (s *syllabusService) Update(ctx context.Context, id string, subject dto.Syl
 	syllabusEntity.UpdatedAt = &now
 
 	// Perform the update
-	err = s.syllabusRepo.Update(ctx, id, syllabusEntity, nil)
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.syllabusRepo.Update(ctx, id, syllabusEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		updated, err := s.syllabusRepo.FindByID(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_UPDATE, helper.POLICY_RESOURCE_SYLLABUS, id, res, updated)
+	})
+}
+
+// authorize checks the current user against the policy for action on resource and returns that user
+func (s *syllabusService) authorize(ctx context.Context, token string, action string, resource helper.PolicyResource) (dto.User, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
 	if err != nil {
-		return err
+		return dto.User{}, err
+	}
+
+	err = s.advisorAssignments.Authorize(ctx, user, action, resource)
+	if err != nil {
+		return dto.User{}, err
 	}
 
-	return nil
+	return user, nil
+}
+
+func syllabusPolicyResource(syllabus entity.Syllabus) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_SYLLABUS,
+		RegistrationID: syllabus.RegistrationID,
+		OwnerID:        syllabus.UserID,
+		OwnerNRP:       syllabus.UserNRP,
+		AdvisorEmail:   syllabus.AcademicAdvisorEmail,
+	}
 }
 
 // FindByID retrieves a syllabus by its ID
@@ -261,26 +363,9 @@ func (s *syllabusService) FindByID(ctx context.Context, id string, token string)
 		return dto.SyllabusResponse{}, err
 	}
 
-	user := s.userManagementService.GetUserData("GET", token)
-	if user == nil {
-		return dto.SyllabusResponse{}, errors.New("unauthorized")
-	}
-
-	userRole, ok := user["role"].(string)
-	if !ok {
-		return dto.SyllabusResponse{}, errors.New("unauthorized")
-	}
-
-	if userRole == "DOSEN PEMBIMBING" {
-		if syllabus.AcademicAdvisorEmail != user["email"] {
-			return dto.SyllabusResponse{}, errors.New("unauthorized")
-		}
-	} else if userRole == "MAHASISWA" {
-		if syllabus.UserNRP != user["nrp"] {
-			return dto.SyllabusResponse{}, errors.New("unauthorized")
-		}
-	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
-		return dto.SyllabusResponse{}, errors.New("unauthorized")
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, syllabusPolicyResource(syllabus))
+	if err != nil {
+		return dto.SyllabusResponse{}, err
 	}
 
 	return dto.SyllabusResponse{
@@ -296,22 +381,39 @@ func (s *syllabusService) FindByID(ctx context.Context, id string, token string)
 }
 
 // Destroy deletes a syllabus
-func (s *syllabusService) Destroy(ctx context.Context, id string) error {
-	err := s.syllabusRepo.Destroy(ctx, id, nil)
+func (s *syllabusService) Destroy(ctx context.Context, id string, token string) error {
+	syllabus, err := s.syllabusRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_DELETE, syllabusPolicyResource(syllabus))
+	if err != nil {
+		return err
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.syllabusRepo.Destroy(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_SYLLABUS, id, syllabus, nil)
+	})
 }
 
 // FindByRegistrationID retrieves syllabuses by registration ID
-func (s *syllabusService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.SyllabusResponse, error) {
+func (s *syllabusService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.SyllabusResponse, error) {
 	syllabus, err := s.syllabusRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return dto.SyllabusResponse{}, err
 	}
 
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, syllabusPolicyResource(syllabus))
+	if err != nil {
+		return dto.SyllabusResponse{}, err
+	}
+
 	var syllabusResponses dto.SyllabusResponse
 
 	syllabusResponses = dto.SyllabusResponse{
@@ -329,12 +431,20 @@ func (s *syllabusService) FindByRegistrationID(ctx context.Context, registration
 }
 
 // FindAllByRegistrationID retrieves all syllabuses by registration ID
-func (s *syllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.SyllabusResponse, error) {
+func (s *syllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.SyllabusResponse, error) {
 	syllabuses, err := s.syllabusRepo.FindAllByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// every syllabus of a registration shares its student and advisor
+	if len(syllabuses) > 0 {
+		_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, syllabusPolicyResource(syllabuses[0]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var syllabusResponses []dto.SyllabusResponse
 	for _, syllabus := range syllabuses {
 		syllabusResponses = append(syllabusResponses, dto.SyllabusResponse{
@@ -353,10 +463,13 @@ func (s *syllabusService) FindAllByRegistrationID(ctx context.Context, registrat
 }
 
 func (s *syllabusService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.SyllabusByStudentResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.SyllabusByStudentResponse{}, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return dto.SyllabusByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -366,26 +479,35 @@ func (s *syllabusService) FindByUserNRPAndGroupByRegistrationID(ctx context.Cont
 		return dto.SyllabusByStudentResponse{}, err
 	}
 
-	syllabusResponses := make(map[string][]dto.SyllabusResponse)
-
+	listed := make(map[string]entity.ListedRegistration, len(syllabuses))
 	for registrationID, syllabusList := range syllabuses {
-		registration := s.registrationService.GetRegistrationByID("GET", registrationID, token)
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			log.Println("REGISTRATION ACTIVITY NAME NOT FOUND FOR REGISTRATION ID: ", registration)
-			return dto.SyllabusByStudentResponse{}, errors.New("registration activity name not found")
-		}
+		listed[registrationID] = syllabusList[0].ListedRegistration
+	}
 
-		approvalStatus, ok := registration["approval_status"].(bool)
-		if !ok {
-			return dto.SyllabusByStudentResponse{}, errors.New("registration approval status not found")
-		}
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.SyllabusByStudentResponse{}, err
+	}
 
-		if !approvalStatus {
+	syllabusResponses := make(map[string][]dto.SyllabusResponse)
+	var warnings helper.Warnings
+
+	for registrationID, syllabusList := range syllabuses {
+		// when the registration cannot be loaded the syllabuses are still listed, keyed by registration ID
+		registration, err := registrations.Get(registrationID)
+		if err != nil {
+			warnRegistration(&warnings, registrationID, err)
+		} else if !registration.ApprovalStatus {
+			// skip registrations that are not approved
 			log.Println("REGISTRATION APPROVAL STATUS IS FALSE: ", registrationID)
 			continue
 		}
 
+		groupKey := registration.ActivityName
+		if groupKey == "" {
+			groupKey = registrationID
+		}
+
 		var syllabusResponseList []dto.SyllabusResponse
 		for _, syllabus := range syllabusList {
 			response := dto.SyllabusResponse{
@@ -402,10 +524,11 @@ func (s *syllabusService) FindByUserNRPAndGroupByRegistrationID(ctx context.Cont
 			syllabusResponseList = append(syllabusResponseList, response)
 		}
 
-		syllabusResponses[registrationActivityName] = syllabusResponseList
+		syllabusResponses[groupKey] = syllabusResponseList
 	}
 
 	return dto.SyllabusByStudentResponse{
 		Syllabuses: syllabusResponses,
+		Warnings:   warnings.List(),
 	}, nil
 }
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// PRINCIPAL_CONTEXT_KEY is the gin context key holding the principal of a locally verified token
+const PRINCIPAL_CONTEXT_KEY = "principal"
+
+// TokenVerifier resolves the principal of a bearer token without calling user management
+type TokenVerifier interface {
+	// Verify returns the principal of token. It returns helper.ErrRemoteLookupRequired when the
+	// token has to be resolved by user management instead, and an error wrapping
+	// helper.ErrInvalidToken when it must be rejected.
+	Verify(ctx context.Context, token string) (dto.Principal, error)
+}
+
+type tokenVerifier struct {
+	config    helper.JWTConfig
+	staticKey crypto.PublicKey
+	client    *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+}
+
+// NewTokenVerifier fails when a public key is configured but cannot be read or parsed, a verifier
+// silently falling back to user management would hide the misconfiguration
+func NewTokenVerifier(config helper.JWTConfig, downstreamConfig helper.DownstreamConfig) (TokenVerifier, error) {
+	verifier := &tokenVerifier{
+		config: config,
+		client: &http.Client{Timeout: downstreamConfig.Timeout},
+	}
+
+	publicKey := config.PublicKey
+	if publicKey == "" && config.PublicKeyFile != "" {
+		data, err := os.ReadFile(config.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading JWT public key file: %w", err)
+		}
+		publicKey = string(data)
+	}
+
+	if publicKey != "" {
+		key, err := helper.ParsePublicKeyPEM(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("parsing JWT public key: %w", err)
+		}
+		verifier.staticKey = key
+	}
+
+	return verifier, nil
+}
+
+func (v *tokenVerifier) enabled() bool {
+	return v.staticKey != nil || v.config.JWKSURL != ""
+}
+
+func (v *tokenVerifier) Verify(ctx context.Context, token string) (dto.Principal, error) {
+	token, err := bearerToken(token)
+	if err != nil {
+		return dto.Principal{}, err
+	}
+
+	if !v.enabled() {
+		return dto.Principal{}, helper.ErrRemoteLookupRequired
+	}
+
+	header, err := helper.ParseJWTHeader(token)
+	if err != nil {
+		return dto.Principal{}, v.unresolved(err)
+	}
+
+	key, err := v.signingKey(ctx, header.KeyID)
+	if err != nil {
+		return dto.Principal{}, err
+	}
+
+	claims, err := helper.VerifyJWT(token, key, v.config, time.Now())
+	if err != nil {
+		return dto.Principal{}, err
+	}
+
+	principal := dto.Principal{
+		UserID: claims.String(v.config.Claims.UserID),
+		Email:  claims.String(v.config.Claims.Email),
+		NRP:    claims.String(v.config.Claims.NRP),
+		Role:   claims.String(v.config.Claims.Role),
+		Name:   claims.String(v.config.Claims.Name),
+	}
+
+	// services match advisors by email and everyone else by ID, a principal needs all three
+	if principal.UserID == "" || principal.Role == "" || principal.Email == "" {
+		return dto.Principal{}, v.unresolved(helper.ErrTokenClaimsMissing)
+	}
+
+	return principal, nil
+}
+
+// unresolved hands the token to user management when the fallback is on and rejects it otherwise
+func (v *tokenVerifier) unresolved(err error) error {
+	if v.config.RemoteFallback {
+		return fmt.Errorf("%w: %v", helper.ErrRemoteLookupRequired, err)
+	}
+
+	return fmt.Errorf("%w: %v", helper.ErrInvalidToken, err)
+}
+
+// signingKey returns the key a token signed with keyID is verified against. The JWKS is refetched
+// once its refresh interval has passed, or early when a token names a key it does not have yet,
+// which is how rotated keys are picked up. Early refetches are throttled so tokens with made up
+// key IDs cannot hammer the issuer, and a failed refetch keeps the keys fetched before.
+func (v *tokenVerifier) signingKey(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	if v.staticKey != nil {
+		return v.staticKey, nil
+	}
+
+	v.mu.Lock()
+	now := time.Now()
+	key, known := v.lookup(keyID)
+	fetched := v.keys != nil
+	stale := !fetched || now.Sub(v.fetchedAt) >= v.config.JWKSRefreshInterval
+	throttled := !v.attemptedAt.IsZero() && now.Sub(v.attemptedAt) < v.config.JWKSMinRefreshInterval
+	refetch := (stale || !known) && !throttled
+	if refetch {
+		v.attemptedAt = now
+	}
+	v.mu.Unlock()
+
+	// the lock is not held across the request, a slow issuer must not block tokens with known keys
+	if refetch {
+		keys, err := v.fetchJWKS(ctx)
+		if err != nil {
+			log.Println("ERROR FETCHING JWKS: ", err)
+		}
+
+		v.mu.Lock()
+		if err == nil {
+			v.keys = keys
+			v.fetchedAt = now
+		}
+		key, known = v.lookup(keyID)
+		fetched = v.keys != nil
+		v.mu.Unlock()
+	}
+
+	if !fetched {
+		// the issuer cannot be reached and nothing was fetched before, user management may still answer
+		if v.config.RemoteFallback {
+			return nil, fmt.Errorf("%w: no JWKS available", helper.ErrRemoteLookupRequired)
+		}
+		return nil, fmt.Errorf("%w: no JWKS available", helper.ErrInvalidToken)
+	}
+
+	if !known {
+		return nil, fmt.Errorf("%w: %w %q", helper.ErrInvalidToken, helper.ErrUnknownSigningKey, keyID)
+	}
+
+	return key, nil
+}
+
+// lookup finds the key for keyID, a token without key ID is accepted when the JWKS has one key only
+func (v *tokenVerifier) lookup(keyID string) (crypto.PublicKey, bool) {
+	if key, ok := v.keys[keyID]; ok {
+		return key, true
+	}
+
+	if keyID == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, true
+		}
+	}
+
+	return nil, false
+}
+
+func (v *tokenVerifier) fetchJWKS(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(context.WithoutCancel(ctx), http.MethodGet, v.config.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New("JWKS request failed with status " + res.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.ParseJWKS(body)
+}
@@ -12,49 +12,63 @@ import (
 	"reflect"
 	"time"
 
-	storageService "github.com/SIM-MBKM/filestorage/storage"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type transcriptService struct {
 	transcriptRepo        repository.TranscriptRepository
 	fileService           *FileService
-	userManagementService *UserManagementService
-	registrationService   *RegistrationManagementService
+	userManagementService UserManagementService
+	registrationService   RegistrationManagementService
+	outboxService         OutboxService
+	advisorNotifications  AdvisorNotificationService
+	auditService          AuditService
+	advisorAssignments    AdvisorAssignmentService
 }
 
 type TranscriptService interface {
 	Index(ctx context.Context) ([]dto.TranscriptResponse, error)
 	Create(ctx context.Context, transcript dto.TranscriptRequest, file *multipart.FileHeader, token string) (dto.TranscriptResponse, error)
-	Update(ctx context.Context, id string, transcript dto.TranscriptRequest) error
+	Update(ctx context.Context, id string, transcript dto.TranscriptRequest, token string) error
 	FindByID(ctx context.Context, id string, token string) (dto.TranscriptResponse, error)
-	Destroy(ctx context.Context, id string) error
-	FindByRegistrationID(ctx context.Context, registrationID string) (dto.TranscriptResponse, error)
-	FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.TranscriptResponse, error)
+	Destroy(ctx context.Context, id string, token string) error
+	FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.TranscriptResponse, error)
+	FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.TranscriptResponse, error)
 	FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.TranscriptAdvisorFilterRequest) (dto.TranscriptAdvisorResponse, dto.PaginationResponse, error)
 	FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.TranscriptByStudentResponse, error)
 }
 
 func NewTranscriptService(
 	transcriptRepo repository.TranscriptRepository,
-	userManagementBaseURI string,
-	registrationBaseURI string,
-	asyncURIs []string,
-	config *storageService.Config,
-	tokenManager *storageService.CacheTokenManager,
+	userManagementService UserManagementService,
+	registrationService RegistrationManagementService,
+	fileService *FileService,
+	outboxService OutboxService,
+	advisorNotifications AdvisorNotificationService,
+	auditService AuditService,
+	advisorAssignments AdvisorAssignmentService,
 ) TranscriptService {
 	return &transcriptService{
 		transcriptRepo:        transcriptRepo,
-		fileService:           NewFileService(config, tokenManager),
-		userManagementService: NewUserManagementService(userManagementBaseURI, asyncURIs),
-		registrationService:   NewRegistrationManagementService(registrationBaseURI, asyncURIs),
+		fileService:           fileService,
+		userManagementService: userManagementService,
+		registrationService:   registrationService,
+		outboxService:         outboxService,
+		advisorNotifications:  advisorNotifications,
+		auditService:          auditService,
+		advisorAssignments:    advisorAssignments,
 	}
 }
 
 func (s *transcriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.TranscriptAdvisorFilterRequest) (dto.TranscriptAdvisorResponse, dto.PaginationResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
@@ -63,19 +77,25 @@ func (s *transcriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Cont
 		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, err
 	}
 
+	listed := make(map[string]entity.ListedRegistration, len(transcripts))
+	for _, transcript := range transcripts {
+		listed[transcript.RegistrationID] = transcript.ListedRegistration
+	}
+
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
 	transcriptResponses := make(map[string][]dto.TranscriptResponse)
+	var warnings helper.Warnings
 	for userNRP, transcript := range transcripts {
-		// get registration by registration id
-		registration := s.registrationService.GetRegistrationByID("GET", transcript.RegistrationID, token)
-		if registration == nil {
-			return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("registration not found")
-		}
-
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			log.Println("ERROR GETTING REGISTRATION ACTIVITY NAME: ", registration)
-			return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("registration activity name not found")
+		// the activity name stays empty when the registration cannot be loaded
+		registration, err := registrations.Get(transcript.RegistrationID)
+		if err != nil {
+			warnRegistration(&warnings, transcript.RegistrationID, err)
 		}
+		registrationActivityName := registration.ActivityName
 
 		transcriptResponses[userNRP] = append(transcriptResponses[userNRP], dto.TranscriptResponse{
 			ID:                   transcript.ID.String(),
@@ -95,6 +115,7 @@ func (s *transcriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Cont
 
 	return dto.TranscriptAdvisorResponse{
 		Transcripts: transcriptResponses,
+		Warnings:    warnings.List(),
 	}, paginationResponse, nil
 }
 
@@ -139,41 +160,48 @@ func (s *transcriptService) Create(ctx context.Context, transcript dto.Transcrip
 		return dto.TranscriptResponse{}, errors.New("file is required")
 	}
 
-	// Upload file to storage
-	result, err := s.fileService.storage.GcsUpload(file, "sim_mbkm", "", "")
+	// Verify user has access to this registration before anything is uploaded
+	user, err := s.userManagementService.CurrentUser(ctx, token)
 	if err != nil {
-		return dto.TranscriptResponse{}, err
-	}
-
-	// Verify user has access to this registration
-	user := s.userManagementService.GetUserData("GET", token)
-	if user == nil {
 		log.Println("ERROR GETTING USER DATA: ", err)
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
-	registration := s.registrationService.GetRegistrationByID("GET", transcript.RegistrationID, token)
-	if registration == nil {
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", transcript.RegistrationID, token)
+	if err != nil {
 		log.Println("ERROR GETTING REGISTRATION: ", err)
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
-	userID, ok := registration["user_id"].(string)
-	if !ok {
+	userID := registration.UserID
+	if userID == "" {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
-	if userID != user["id"] {
-		log.Println("USER ID DOES NOT MATCH: ", userID, user["id"])
-		return dto.TranscriptResponse{}, errors.New("unauthorized")
+	err = helper.Authorize(user, helper.POLICY_ACTION_CREATE, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_TRANSCRIPT,
+		RegistrationID: transcript.RegistrationID,
+		OwnerID:        userID,
+		OwnerNRP:       registration.UserNRP,
+		AdvisorEmail:   registration.AcademicAdvisorEmail,
+	})
+	if err != nil {
+		return dto.TranscriptResponse{}, err
 	}
+
+	// Upload file to storage
+	result, err := s.fileService.Upload(ctx, file)
+	if err != nil {
+		return dto.TranscriptResponse{}, err
+	}
+
 	// Create transcript entity
 	var transcriptEntity entity.Transcript
 	transcriptEntity.ID = uuid.New()
 	transcriptEntity.UserID = userID
-	transcriptEntity.UserNRP = registration["user_nrp"].(string)
-	transcriptEntity.AcademicAdvisorID = registration["academic_advisor"].(string)
-	transcriptEntity.AcademicAdvisorEmail = registration["academic_advisor_email"].(string)
+	transcriptEntity.UserNRP = registration.UserNRP
+	transcriptEntity.AcademicAdvisorID = registration.AcademicAdvisor
+	transcriptEntity.AcademicAdvisorEmail = registration.AcademicAdvisorEmail
 	transcriptEntity.RegistrationID = transcript.RegistrationID
 	transcriptEntity.Title = transcript.Title
 	transcriptEntity.FileStorageID = result.FileID
@@ -184,7 +212,44 @@ func (s *transcriptService) Create(ctx context.Context, transcript dto.Transcrip
 	transcriptEntity.UpdatedAt = &now
 
 	// Save to database
-	transcriptResponse, err := s.transcriptRepo.Create(ctx, transcriptEntity, nil)
+	var transcriptResponse entity.Transcript
+	err = s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		transcriptResponse, err = s.transcriptRepo.Create(ctx, transcriptEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		err = s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_TRANSCRIPT, transcriptResponse.ID.String(), nil, transcriptResponse)
+		if err != nil {
+			return err
+		}
+
+		err = s.outboxService.Record(ctx, tx, dto.DOMAIN_EVENT_TRANSCRIPT_UPLOADED, "transcript", transcriptResponse.ID.String(), dto.DocumentEventData{
+			ID:                   transcriptResponse.ID.String(),
+			RegistrationID:       transcriptResponse.RegistrationID,
+			UserID:               transcriptResponse.UserID,
+			UserNRP:              transcriptResponse.UserNRP,
+			AcademicAdvisorEmail: transcriptResponse.AcademicAdvisorEmail,
+			Title:                transcriptResponse.Title,
+			FileStorageID:        transcriptResponse.FileStorageID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.advisorNotifications.NotifySubmission(ctx, tx, transcriptResponse.RegistrationID, dto.NotificationRequest{
+			SenderName:    user.Name,
+			SenderEmail:   user.Email,
+			ReceiverEmail: transcriptResponse.AcademicAdvisorEmail,
+			Type:          helper.ADVISOR_NOTIFICATION_TRANSCRIPT_UPLOADED,
+		}, dto.NotificationTemplateData{
+			StudentName:  user.Name,
+			StudentNRP:   transcriptResponse.UserNRP,
+			ActivityName: registration.ActivityName,
+			Title:        transcriptResponse.Title,
+			Link:         "/transcripts/" + transcriptResponse.ID.String(),
+		})
+	})
 	if err != nil {
 		return dto.TranscriptResponse{}, err
 	}
@@ -202,12 +267,17 @@ func (s *transcriptService) Create(ctx context.Context, transcript dto.Transcrip
 }
 
 // Update updates an existing transcript
-func (s *transcriptService) Update(ctx context.Context, id string, subject dto.TranscriptRequest) error {
+func (s *transcriptService) Update(ctx context.Context, id string, subject dto.TranscriptRequest, token string) error {
 	res, err := s.transcriptRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_UPDATE, transcriptPolicyResource(res))
+	if err != nil {
+		return err
+	}
+
 	// Create transcriptEntity with original ID
 	transcriptEntity := entity.Transcript{
 		ID: res.ID,
@@ -246,12 +316,44 @@ func (s *transcriptService) Update(ctx context.Context, id string, subject dto.T
 	transcriptEntity.UpdatedAt = &now
 
 	// Perform the update
-	err = s.transcriptRepo.Update(ctx, id, transcriptEntity, nil)
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.transcriptRepo.Update(ctx, id, transcriptEntity, tx)
+		if err != nil {
+			return err
+		}
+
+		updated, err := s.transcriptRepo.FindByID(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_UPDATE, helper.POLICY_RESOURCE_TRANSCRIPT, id, res, updated)
+	})
+}
+
+// authorize checks the current user against the policy for action on resource and returns that user
+func (s *transcriptService) authorize(ctx context.Context, token string, action string, resource helper.PolicyResource) (dto.User, error) {
+	user, err := s.userManagementService.CurrentUser(ctx, token)
 	if err != nil {
-		return err
+		return dto.User{}, err
 	}
 
-	return nil
+	err = s.advisorAssignments.Authorize(ctx, user, action, resource)
+	if err != nil {
+		return dto.User{}, err
+	}
+
+	return user, nil
+}
+
+func transcriptPolicyResource(transcript entity.Transcript) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_TRANSCRIPT,
+		RegistrationID: transcript.RegistrationID,
+		OwnerID:        transcript.UserID,
+		OwnerNRP:       transcript.UserNRP,
+		AdvisorEmail:   transcript.AcademicAdvisorEmail,
+	}
 }
 
 // FindByID retrieves a transcript by its ID
@@ -261,26 +363,9 @@ func (s *transcriptService) FindByID(ctx context.Context, id string, token strin
 		return dto.TranscriptResponse{}, err
 	}
 
-	user := s.userManagementService.GetUserData("GET", token)
-	if user == nil {
-		return dto.TranscriptResponse{}, errors.New("unauthorized")
-	}
-
-	userRole, ok := user["role"].(string)
-	if !ok {
-		return dto.TranscriptResponse{}, errors.New("unauthorized")
-	}
-
-	if userRole == "DOSEN PEMBIMBING" {
-		if transcript.AcademicAdvisorEmail != user["email"] {
-			return dto.TranscriptResponse{}, errors.New("unauthorized")
-		}
-	} else if userRole == "MAHASISWA" {
-		if transcript.UserNRP != user["nrp"] {
-			return dto.TranscriptResponse{}, errors.New("unauthorized")
-		}
-	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
-		return dto.TranscriptResponse{}, errors.New("unauthorized")
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, transcriptPolicyResource(transcript))
+	if err != nil {
+		return dto.TranscriptResponse{}, err
 	}
 
 	return dto.TranscriptResponse{
@@ -296,22 +381,39 @@ func (s *transcriptService) FindByID(ctx context.Context, id string, token strin
 }
 
 // Destroy deletes a transcript
-func (s *transcriptService) Destroy(ctx context.Context, id string) error {
-	err := s.transcriptRepo.Destroy(ctx, id, nil)
+func (s *transcriptService) Destroy(ctx context.Context, id string, token string) error {
+	transcript, err := s.transcriptRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	user, err := s.authorize(ctx, token, helper.POLICY_ACTION_DELETE, transcriptPolicyResource(transcript))
+	if err != nil {
+		return err
+	}
+
+	return s.outboxService.Transaction(ctx, func(tx *gorm.DB) error {
+		err := s.transcriptRepo.Destroy(ctx, id, tx)
+		if err != nil {
+			return err
+		}
+
+		return s.auditService.Record(ctx, tx, user, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_TRANSCRIPT, id, transcript, nil)
+	})
 }
 
 // FindByRegistrationID retrieves transcripts by registration ID
-func (s *transcriptService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.TranscriptResponse, error) {
+func (s *transcriptService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.TranscriptResponse, error) {
 	transcript, err := s.transcriptRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return dto.TranscriptResponse{}, err
 	}
 
+	_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, transcriptPolicyResource(transcript))
+	if err != nil {
+		return dto.TranscriptResponse{}, err
+	}
+
 	var transcriptResponses dto.TranscriptResponse
 
 	transcriptResponses = dto.TranscriptResponse{
@@ -329,10 +431,13 @@ func (s *transcriptService) FindByRegistrationID(ctx context.Context, registrati
 }
 
 func (s *transcriptService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.TranscriptByStudentResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.CurrentUser(ctx, token)
+	if err != nil {
+		return dto.TranscriptByStudentResponse{}, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return dto.TranscriptByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -342,25 +447,34 @@ func (s *transcriptService) FindByUserNRPAndGroupByRegistrationID(ctx context.Co
 		return dto.TranscriptByStudentResponse{}, err
 	}
 
-	transcriptResponses := make(map[string][]dto.TranscriptResponse)
-
+	listed := make(map[string]entity.ListedRegistration, len(transcripts))
 	for registrationID, transcriptList := range transcripts {
-		registration := s.registrationService.GetRegistrationByID("GET", registrationID, token)
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			return dto.TranscriptByStudentResponse{}, errors.New("registration activity name not found")
-		}
+		listed[registrationID] = transcriptList[0].ListedRegistration
+	}
 
-		// skip if registration['approval_status'] is false
-		approvalStatus, ok := registration["approval_status"].(bool)
-		if !ok {
-			return dto.TranscriptByStudentResponse{}, errors.New("registration approval status not found")
-		}
+	registrations, err := listedRegistrations(ctx, s.registrationService, listed, token)
+	if err != nil {
+		return dto.TranscriptByStudentResponse{}, err
+	}
 
-		if !approvalStatus {
+	transcriptResponses := make(map[string][]dto.TranscriptResponse)
+	var warnings helper.Warnings
+
+	for registrationID, transcriptList := range transcripts {
+		// when the registration cannot be loaded the transcripts are still listed, keyed by registration ID
+		registration, err := registrations.Get(registrationID)
+		if err != nil {
+			warnRegistration(&warnings, registrationID, err)
+		} else if !registration.ApprovalStatus {
+			// skip registrations that are not approved
 			log.Println("REGISTRATION APPROVAL STATUS IS FALSE: ", registrationID)
 			continue
 		}
+
+		groupKey := registration.ActivityName
+		if groupKey == "" {
+			groupKey = registrationID
+		}
 		var transcriptResponseList []dto.TranscriptResponse
 		for _, transcript := range transcriptList {
 			response := dto.TranscriptResponse{
@@ -377,21 +491,30 @@ func (s *transcriptService) FindByUserNRPAndGroupByRegistrationID(ctx context.Co
 			transcriptResponseList = append(transcriptResponseList, response)
 		}
 
-		transcriptResponses[registrationActivityName] = transcriptResponseList
+		transcriptResponses[groupKey] = transcriptResponseList
 	}
 
 	return dto.TranscriptByStudentResponse{
 		Transcripts: transcriptResponses,
+		Warnings:    warnings.List(),
 	}, nil
 }
 
 // FindAllByRegistrationID retrieves all transcripts by registration ID
-func (s *transcriptService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.TranscriptResponse, error) {
+func (s *transcriptService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.TranscriptResponse, error) {
 	transcripts, err := s.transcriptRepo.FindAllByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// every transcript of a registration shares its student and advisor
+	if len(transcripts) > 0 {
+		_, err = s.authorize(ctx, token, helper.POLICY_ACTION_VIEW, transcriptPolicyResource(transcripts[0]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var transcriptResponses []dto.TranscriptResponse
 	for _, transcript := range transcripts {
 		transcriptResponses = append(transcriptResponses, dto.TranscriptResponse{
@@ -1,14 +1,30 @@
 package service
 
 import (
-	"log"
-	"strings"
-
-	baseService "github.com/SIM-MBKM/mod-service/src/service"
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
 )
 
-type UserManagementService struct {
-	baseService *baseService.Service
+// USER_CONTEXT_KEY is the gin context key holding the user resolved by the authorization middleware
+const USER_CONTEXT_KEY = "user"
+
+type userManagementService struct {
+	client *downstreamClient
+	users  *helper.TTLCache[dto.User]
+	roles  *helper.TTLCache[string]
+	dosens *helper.TTLCache[dto.Dosen]
+}
+
+type UserManagementService interface {
+	CurrentUser(ctx context.Context, token string) (dto.User, error)
+	GetUserByID(ctx context.Context, method string, token string, id string) (dto.User, error)
+	GetUserData(ctx context.Context, method string, token string) (dto.User, error)
+	GetUserByFilter(ctx context.Context, data map[string]interface{}, method string, token string) ([]dto.User, error)
+	GetUserRole(ctx context.Context, method string, token string) (string, error)
+	GetDosenDataByEmail(ctx context.Context, email string, method string, token string) (dto.Dosen, error)
+	InvalidateUser(id string)
+	InvalidateEmail(email string)
 }
 
 const (
@@ -19,165 +35,163 @@ const (
 	GET_DOSEN_DATA_BY_EMAIL_ENDPOINT = "api/v1/user/service/by-email/"
 )
 
-func NewUserManagementService(baseURI string, asyncURIs []string) *UserManagementService {
-	return &UserManagementService{
-		baseService: baseService.NewService(baseURI, asyncURIs),
+func NewUserManagementService(baseURI string, asyncURIs []string, cacheConfig helper.UserCacheConfig, downstreamConfig helper.DownstreamConfig) UserManagementService {
+	return &userManagementService{
+		client: newDownstreamClient("user-management", baseURI, asyncURIs, downstreamConfig),
+		users:  helper.NewTTLCache[dto.User](cacheConfig.TTL),
+		roles:  helper.NewTTLCache[string](cacheConfig.TTL),
+		dosens: helper.NewTTLCache[dto.Dosen](cacheConfig.TTL),
 	}
 }
 
-func (s *UserManagementService) GetUserByID(method string, token string, id string) map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
-	}
+// sharedContext is the context of a lookup other callers may be waiting on, it must not end when
+// the caller that started it goes away
+func sharedContext(ctx context.Context) context.Context {
+	return context.WithoutCancel(ctx)
+}
 
-	token = tokenParts[1]
+func tokenCacheKey(token string) string {
+	return "token:" + helper.HashToken(token)
+}
 
-	res, err := s.baseService.Request(method, GET_USER_DATA_ENDPOINT+"/"+id, nil, token)
-	if err != nil {
-		return nil
+// CurrentUser returns the user of the request, reusing the one resolved by the authorization
+// middleware when ctx is the gin context of the request
+func (s *userManagementService) CurrentUser(ctx context.Context, token string) (dto.User, error) {
+	if user, ok := ctx.Value(USER_CONTEXT_KEY).(dto.User); ok {
+		return user, nil
 	}
 
-	users, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil
-	}
+	return s.GetUserData(ctx, "GET", token)
+}
 
-	var userData map[string]interface{}
-	userData = map[string]interface{}{
-		"id":    users["auth_user_id"],
-		"nrp":   users["nrp"],
-		"name":  users["name"],
-		"role":  users["role"],
-		"email": users["email"],
-	}
-	return userData
+// InvalidateUser drops every cached entry describing the user with the given ID
+func (s *userManagementService) InvalidateUser(id string) {
+	s.users.DeleteFunc(func(_ string, user dto.User) bool {
+		return user.ID == id
+	})
+	s.dosens.DeleteFunc(func(_ string, dosen dto.Dosen) bool {
+		return dosen.ID == id
+	})
 }
 
-// create function to get user by id
-func (s *UserManagementService) GetUserData(method string, token string) map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
-	}
+// InvalidateEmail drops every cached entry describing the user with the given email
+func (s *userManagementService) InvalidateEmail(email string) {
+	s.users.DeleteFunc(func(_ string, user dto.User) bool {
+		return user.Email == email
+	})
+	s.dosens.Delete(email)
+}
 
-	token = tokenParts[1]
+func (s *userManagementService) GetUserByID(ctx context.Context, method string, token string, id string) (dto.User, error) {
+	return s.users.Fetch("id:"+id, func() (dto.User, error) {
+		ctx := sharedContext(ctx)
+		token, err := bearerToken(token)
+		if err != nil {
+			return dto.User{}, err
+		}
 
-	res, err := s.baseService.Request(method, GET_USER_DATA_ENDPOINT, nil, token)
-	if err != nil {
-		return nil
-	}
+		res, err := s.client.request(ctx, method, GET_USER_DATA_ENDPOINT+"/"+id, nil, token)
+		if err != nil {
+			return dto.User{}, err
+		}
 
-	users, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil
-	}
+		var user dto.User
+		if err := decodeResponseData(res, &user); err != nil {
+			return dto.User{}, err
+		}
 
-	var usersData map[string]interface{}
-	usersData = map[string]interface{}{
-		"id":    users["auth_user_id"],
-		"nrp":   users["nrp"],
-		"name":  users["name"],
-		"role":  users["role"],
-		"email": users["email"],
-	}
-	return usersData
+		return user, nil
+	})
 }
 
-func (s *UserManagementService) GetUserByFilter(data map[string]interface{}, method string, token string) []map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
-	}
-
-	token = tokenParts[1]
-
-	res, err := s.baseService.Request(method, GET_USER_BY_FILTER_ENDPOINT, data, token)
-	if err != nil {
-		return nil
-	}
+// create function to get user by id
+func (s *userManagementService) GetUserData(ctx context.Context, method string, token string) (dto.User, error) {
+	return s.users.Fetch(tokenCacheKey(token), func() (dto.User, error) {
+		ctx := sharedContext(ctx)
+		token, err := bearerToken(token)
+		if err != nil {
+			return dto.User{}, err
+		}
 
-	// First, get the data as []interface{}
-	usersInterface, ok := res["data"].([]interface{})
-	if !ok {
-		log.Println("Failed to convert data to []interface{}")
-		return nil
-	}
+		res, err := s.client.request(ctx, method, GET_USER_DATA_ENDPOINT, nil, token)
+		if err != nil {
+			return dto.User{}, err
+		}
 
-	var usersData []map[string]interface{}
-	for _, userInterface := range usersInterface {
-		user, ok := userInterface.(map[string]interface{})
-		if !ok {
-			log.Println("Failed to convert user to map[string]interface{}")
-			continue
+		var user dto.User
+		if err := decodeResponseData(res, &user); err != nil {
+			return dto.User{}, err
 		}
 
-		usersData = append(usersData, map[string]interface{}{
-			"id":   user["auth_user_id"],
-			"nrp":  user["nrp"],
-			"name": user["name"],
-		})
-	}
-	return usersData
+		return user, nil
+	})
 }
 
-func (s *UserManagementService) GetUserRole(method string, token string) map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
+func (s *userManagementService) GetUserByFilter(ctx context.Context, data map[string]interface{}, method string, token string) ([]dto.User, error) {
+	token, err := bearerToken(token)
+	if err != nil {
+		return nil, err
 	}
 
-	token = tokenParts[1]
-
-	res, err := s.baseService.Request(method, GET_USER_ROLE_ENDPOINT, nil, token)
+	res, err := s.client.request(ctx, method, GET_USER_BY_FILTER_ENDPOINT, data, token)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	roles, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil
+	var users []dto.User
+	if err := decodeResponseData(res, &users); err != nil {
+		return nil, err
 	}
 
-	var rolesData map[string]interface{}
-	rolesData = map[string]interface{}{
-		"role": roles["role"],
-	}
-	return rolesData
+	return users, nil
 }
 
-func (s *UserManagementService) GetDosenDataByEmail(email string, method string, token string) map[string]interface{} {
-	// split token
-	tokenParts := strings.Split(token, " ")
-	if len(tokenParts) != 2 {
-		return nil
-	}
+func (s *userManagementService) GetUserRole(ctx context.Context, method string, token string) (string, error) {
+	return s.roles.Fetch(tokenCacheKey(token), func() (string, error) {
+		ctx := sharedContext(ctx)
+		token, err := bearerToken(token)
+		if err != nil {
+			return "", err
+		}
 
-	token = tokenParts[1]
+		res, err := s.client.request(ctx, method, GET_USER_ROLE_ENDPOINT, nil, token)
+		if err != nil {
+			return "", err
+		}
 
-	endpoint := GET_DOSEN_DATA_BY_EMAIL_ENDPOINT + email
+		var role struct {
+			Role string `json:"role"`
+		}
+		if err := decodeResponseData(res, &role); err != nil {
+			return "", err
+		}
 
-	res, err := s.baseService.Request(method, endpoint, nil, token)
-	if err != nil {
-		return nil
-	}
+		if role.Role == "" {
+			return "", ErrUnexpectedResponse
+		}
 
-	dosen, ok := res["data"].(map[string]interface{})
-	if !ok {
-		return nil
-	}
+		return role.Role, nil
+	})
+}
 
-	var dosenData map[string]interface{}
-	dosenData = map[string]interface{}{
-		"id":           dosen["auth_user_id"],
-		"auth_user_id": dosen["auth_user_id"],
-		"nip":          dosen["nrp"],
-		"name":         dosen["name"],
-		"email":        dosen["email"],
-	}
-	return dosenData
+func (s *userManagementService) GetDosenDataByEmail(ctx context.Context, email string, method string, token string) (dto.Dosen, error) {
+	return s.dosens.Fetch(email, func() (dto.Dosen, error) {
+		ctx := sharedContext(ctx)
+		token, err := bearerToken(token)
+		if err != nil {
+			return dto.Dosen{}, err
+		}
+
+		res, err := s.client.request(ctx, method, GET_DOSEN_DATA_BY_EMAIL_ENDPOINT+email, nil, token)
+		if err != nil {
+			return dto.Dosen{}, err
+		}
+
+		var dosen dto.Dosen
+		if err := decodeResponseData(res, &dosen); err != nil {
+			return dto.Dosen{}, err
+		}
+
+		return dosen, nil
+	})
 }
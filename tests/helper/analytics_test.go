@@ -0,0 +1,41 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateRange_Empty(t *testing.T) {
+	from, to, err := helper.ParseDateRange("", "")
+
+	assert.NoError(t, err)
+	assert.Nil(t, from)
+	assert.Nil(t, to)
+}
+
+func TestParseDateRange_DateOnlyIncludesWholeEndDay(t *testing.T) {
+	from, to, err := helper.ParseDateRange("2025-02-01", "2025-02-28")
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), *from)
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), *to)
+}
+
+func TestParseDateRange_RFC3339(t *testing.T) {
+	from, to, err := helper.ParseDateRange("2025-02-01T08:00:00Z", "2025-02-01T17:00:00Z")
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 2, 1, 8, 0, 0, 0, time.UTC), *from)
+	assert.Equal(t, time.Date(2025, 2, 1, 17, 0, 0, 0, time.UTC), *to)
+}
+
+func TestParseDateRange_Invalid(t *testing.T) {
+	_, _, err := helper.ParseDateRange("01/02/2025", "")
+	assert.ErrorIs(t, err, helper.ErrInvalidDateRange)
+
+	_, _, err = helper.ParseDateRange("2025-03-01", "2025-02-01")
+	assert.ErrorIs(t, err, helper.ErrInvalidDateRange)
+}
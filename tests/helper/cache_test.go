@@ -0,0 +1,131 @@
+package helper_test
+
+import (
+	"errors"
+	"monitoring-service/helper"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCacheFetch(t *testing.T) {
+	cache := helper.NewTTLCache[string](time.Minute)
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "value", nil
+	}
+
+	value, err := cache.Fetch("key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", value)
+
+	value, err = cache.Fetch("key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", value)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTTLCacheFetchError(t *testing.T) {
+	cache := helper.NewTTLCache[string](time.Minute)
+	fetchErr := errors.New("user management unavailable")
+	calls := 0
+	fetch := func() (string, error) {
+		calls++
+		return "", fetchErr
+	}
+
+	_, err := cache.Fetch("key", fetch)
+	assert.ErrorIs(t, err, fetchErr)
+
+	_, err = cache.Fetch("key", fetch)
+	assert.ErrorIs(t, err, fetchErr)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestTTLCacheExpiry(t *testing.T) {
+	cache := helper.NewTTLCache[string](20 * time.Millisecond)
+	cache.Set("key", "value")
+
+	value, ok := cache.Get("key")
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	time.Sleep(30 * time.Millisecond)
+
+	_, ok = cache.Get("key")
+	assert.False(t, ok)
+}
+
+func TestTTLCacheDisabled(t *testing.T) {
+	cache := helper.NewTTLCache[string](0)
+	cache.Set("key", "value")
+
+	_, ok := cache.Get("key")
+	assert.False(t, ok)
+}
+
+func TestTTLCacheFetchDeduplicatesConcurrentLookups(t *testing.T) {
+	cache := helper.NewTTLCache[string](time.Minute)
+	var calls int32
+	release := make(chan struct{})
+
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cache.Fetch("key", func() (string, error) {
+				atomic.AddInt32(&calls, 1)
+				<-release
+				return "value", nil
+			})
+		}(i)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, result := range results {
+		assert.Equal(t, "value", result)
+	}
+}
+
+func TestTTLCacheInvalidation(t *testing.T) {
+	cache := helper.NewTTLCache[string](time.Minute)
+	cache.Set("token:abc:user", "user")
+	cache.Set("token:abc:role", "role")
+	cache.Set("user:1", "user")
+	cache.Set("email:a@example.com", "dosen")
+
+	cache.DeletePrefix("token:abc:")
+	assert.Equal(t, 2, cache.Len())
+
+	cache.DeleteFunc(func(key string, value string) bool {
+		return value == "dosen"
+	})
+	assert.Equal(t, 1, cache.Len())
+
+	cache.Delete("user:1")
+	assert.Equal(t, 0, cache.Len())
+
+	cache.Set("user:2", "user")
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestHashToken(t *testing.T) {
+	hash := helper.HashToken("Bearer token")
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, helper.HashToken("Bearer token"))
+	assert.NotEqual(t, hash, helper.HashToken("Bearer other"))
+	assert.NotContains(t, hash, "token")
+}
@@ -0,0 +1,55 @@
+package helper_test
+
+import (
+	"fmt"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffLines_Identical(t *testing.T) {
+	lines := helper.DiffLines("a\nb", "a\nb")
+
+	assert.Equal(t, []dto.DiffLine{
+		{Operation: helper.DIFF_EQUAL, Text: "a"},
+		{Operation: helper.DIFF_EQUAL, Text: "b"},
+	}, lines)
+}
+
+func TestDiffLines_InsertAndDelete(t *testing.T) {
+	lines := helper.DiffLines("a\nb\nc", "a\nc\nd")
+
+	assert.Equal(t, []dto.DiffLine{
+		{Operation: helper.DIFF_EQUAL, Text: "a"},
+		{Operation: helper.DIFF_DELETE, Text: "b"},
+		{Operation: helper.DIFF_EQUAL, Text: "c"},
+		{Operation: helper.DIFF_INSERT, Text: "d"},
+	}, lines)
+}
+
+func TestDiffLines_FromEmpty(t *testing.T) {
+	lines := helper.DiffLines("", "first line")
+
+	assert.Equal(t, []dto.DiffLine{
+		{Operation: helper.DIFF_INSERT, Text: "first line"},
+	}, lines)
+}
+
+func TestDiffLines_LargeChangeStaysBounded(t *testing.T) {
+	var from, to []string
+	for i := 0; i < 20000; i++ {
+		from = append(from, fmt.Sprintf("old %d", i))
+		to = append(to, fmt.Sprintf("new %d", i))
+	}
+
+	lines := helper.DiffLines("title\n"+strings.Join(from, "\n")+"\nend", "title\n"+strings.Join(to, "\n")+"\nend")
+
+	assert.Len(t, lines, 40002)
+	assert.Equal(t, dto.DiffLine{Operation: helper.DIFF_EQUAL, Text: "title"}, lines[0])
+	assert.Equal(t, dto.DiffLine{Operation: helper.DIFF_DELETE, Text: "old 0"}, lines[1])
+	assert.Equal(t, dto.DiffLine{Operation: helper.DIFF_INSERT, Text: "new 0"}, lines[20001])
+	assert.Equal(t, dto.DiffLine{Operation: helper.DIFF_EQUAL, Text: "end"}, lines[40001])
+}
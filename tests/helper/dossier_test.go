@@ -0,0 +1,56 @@
+package helper_test
+
+import (
+	"bytes"
+	"io"
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderDossierPDF(t *testing.T) {
+	start := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	submitted := end.Add(-time.Hour)
+
+	document := helper.DossierDocument{
+		RegistrationID: "9c2fc428-3cca-4c76-a690-e6ba24d135b5",
+		StudentName:    "Budi Santoso",
+		StudentNRP:     "5025211001",
+		ActivityName:   "Kampus Mengajar",
+		AdvisorEmail:   "advisor@example.com",
+		GeneratedAt:    end,
+		Reports: []helper.DossierReport{
+			{ReportType: "WEEKLY_REPORT", Week: 1, StartDate: &start, EndDate: &end, Submitted: true, Title: "Minggu pertama", Content: "Observasi kelas — catatan harian", Status: "APPROVED", Feedback: "Bagus", SubmittedAt: &submitted},
+			{ReportType: "FINAL_REPORT", Week: 16, StartDate: &start, EndDate: &end},
+		},
+		Syllabus: &helper.DossierAttachment{Title: "Syllabus", FileStorageID: "file-1", UploadedAt: &start},
+	}
+
+	var out bytes.Buffer
+	err := helper.RenderDossierPDF(document, &out)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out.Bytes(), []byte("%PDF-")))
+}
+
+func TestNewMultipartFileHeader(t *testing.T) {
+	content := []byte("%PDF-1.3 test")
+
+	file, err := helper.NewMultipartFileHeader("dossier.pdf", "application/pdf", content)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "dossier.pdf", file.Filename)
+	assert.Equal(t, "application/pdf", file.Header.Get("Content-Type"))
+	assert.Equal(t, int64(len(content)), file.Size)
+
+	opened, err := file.Open()
+	assert.NoError(t, err)
+	defer opened.Close()
+
+	read, err := io.ReadAll(opened)
+	assert.NoError(t, err)
+	assert.Equal(t, content, read)
+}
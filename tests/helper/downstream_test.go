@@ -0,0 +1,105 @@
+package helper_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errDownstream = errors.New("downstream failed")
+
+func retryConfig() helper.DownstreamConfig {
+	return helper.DownstreamConfig{
+		Timeout:        time.Second,
+		MaxRetries:     2,
+		RetryBaseDelay: time.Millisecond,
+		RetryMaxDelay:  5 * time.Millisecond,
+	}
+}
+
+func TestBackoffStaysWithinCap(t *testing.T) {
+	for attempt := 1; attempt <= 10; attempt++ {
+		delay := helper.Backoff(attempt, 10*time.Millisecond, 50*time.Millisecond)
+		assert.GreaterOrEqual(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, 50*time.Millisecond)
+	}
+
+	assert.Equal(t, time.Duration(0), helper.Backoff(0, 10*time.Millisecond, 50*time.Millisecond))
+}
+
+func TestRetryRetriesUntilSuccess(t *testing.T) {
+	calls := 0
+	err := helper.Retry(context.Background(), retryConfig(), func(error) bool { return true }, func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return errDownstream
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	err := helper.Retry(context.Background(), retryConfig(), func(error) bool { return true }, func(ctx context.Context) error {
+		calls++
+		return errDownstream
+	})
+
+	assert.ErrorIs(t, err, errDownstream)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetrySkipsNonRetryableErrors(t *testing.T) {
+	calls := 0
+	err := helper.Retry(context.Background(), retryConfig(), func(error) bool { return false }, func(ctx context.Context) error {
+		calls++
+		return errDownstream
+	})
+
+	assert.ErrorIs(t, err, errDownstream)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryBoundsEachAttempt(t *testing.T) {
+	config := retryConfig()
+	config.Timeout = 10 * time.Millisecond
+	config.MaxRetries = 0
+
+	err := helper.Retry(context.Background(), config, func(error) bool { return true }, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRetryStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := helper.Retry(ctx, retryConfig(), func(error) bool { return true }, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return errDownstream
+	})
+
+	assert.ErrorIs(t, err, errDownstream)
+	assert.Equal(t, 1, calls)
+}
+
+func TestWarningsAreDistinct(t *testing.T) {
+	var warnings helper.Warnings
+	assert.Nil(t, warnings.List())
+
+	warnings.Add("registration %s is unavailable", "a")
+	warnings.Add("registration %s is unavailable", "b")
+	warnings.Add("registration %s is unavailable", "a")
+
+	assert.Equal(t, []string{"registration a is unavailable", "registration b is unavailable"}, warnings.List())
+}
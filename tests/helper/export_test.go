@@ -0,0 +1,48 @@
+package helper_test
+
+import (
+	"bytes"
+	"monitoring-service/helper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestNewTableWriter_CSV(t *testing.T) {
+	var out bytes.Buffer
+
+	writer, err := helper.NewTableWriter(helper.EXPORT_FORMAT_CSV, &out, "reports")
+	assert.NoError(t, err)
+
+	assert.NoError(t, writer.WriteRow([]string{"NRP", "Feedback"}))
+	assert.NoError(t, writer.WriteRow([]string{"5025211001", "needs more detail, see week 2"}))
+	assert.NoError(t, writer.Close())
+
+	assert.Equal(t, "NRP,Feedback\n5025211001,\"needs more detail, see week 2\"\n", out.String())
+}
+
+func TestNewTableWriter_XLSX(t *testing.T) {
+	var out bytes.Buffer
+
+	writer, err := helper.NewTableWriter(helper.EXPORT_FORMAT_XLSX, &out, "reports")
+	assert.NoError(t, err)
+
+	assert.NoError(t, writer.WriteRow([]string{"NRP", "Week"}))
+	assert.NoError(t, writer.WriteRow([]string{"5025211001", "1"}))
+	assert.NoError(t, writer.Close())
+
+	file, err := excelize.OpenReader(&out)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	rows, err := file.GetRows("reports")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"NRP", "Week"}, {"5025211001", "1"}}, rows)
+}
+
+func TestNewTableWriter_InvalidFormat(t *testing.T) {
+	_, err := helper.NewTableWriter("pdf", &bytes.Buffer{}, "reports")
+
+	assert.ErrorIs(t, err, helper.ErrInvalidExportFormat)
+}
@@ -0,0 +1,182 @@
+package helper_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var jwtNow = time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+func jwtSegment(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, header map[string]interface{}, claims map[string]interface{}) string {
+	input := jwtSegment(header) + "." + jwtSegment(claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, claims map[string]interface{}) string {
+	input := jwtSegment(map[string]interface{}{"alg": "ES256"}) + "." + jwtSegment(claims)
+	digest := sha256.Sum256([]byte(input))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.NoError(t, err)
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func jwtClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		"email": "student@its.ac.id",
+		"nrp":   5025211111,
+		"role":  helper.ROLE_STUDENT,
+		"iss":   "user-management",
+		"aud":   []string{"monitoring-service"},
+		"exp":   jwtNow.Add(time.Hour).Unix(),
+		"nbf":   jwtNow.Add(-time.Minute).Unix(),
+	}
+}
+
+func jwtConfig() helper.JWTConfig {
+	return helper.JWTConfig{Issuer: "user-management", Audience: "monitoring-service", Leeway: 30 * time.Second}
+}
+
+func TestVerifyJWT_RS256(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	token := signRS256(t, key, map[string]interface{}{"alg": "RS256", "kid": "k1"}, jwtClaims())
+
+	claims, err := helper.VerifyJWT(token, &key.PublicKey, jwtConfig(), jwtNow)
+
+	assert.NoError(t, err)
+	assert.Equal(t, helper.ROLE_STUDENT, claims.String("role"))
+	assert.Equal(t, "5025211111", claims.String("nrp"))
+
+	header, err := helper.ParseJWTHeader(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "k1", header.KeyID)
+}
+
+func TestVerifyJWT_ES256(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	token := signES256(t, key, jwtClaims())
+
+	_, err := helper.VerifyJWT(token, &key.PublicKey, jwtConfig(), jwtNow)
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyJWT_Rejected(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rs256 := map[string]interface{}{"alg": "RS256"}
+
+	expired := jwtClaims()
+	expired["exp"] = jwtNow.Add(-time.Minute).Unix()
+	withoutExpiry := jwtClaims()
+	delete(withoutExpiry, "exp")
+	notYetValid := jwtClaims()
+	notYetValid["nbf"] = jwtNow.Add(time.Minute).Unix()
+	wrongIssuer := jwtClaims()
+	wrongIssuer["iss"] = "someone-else"
+	wrongAudience := jwtClaims()
+	wrongAudience["aud"] = "another-service"
+
+	unsigned := jwtSegment(map[string]interface{}{"alg": "none"}) + "." + jwtSegment(jwtClaims()) + "."
+
+	tests := map[string]string{
+		"expired":             signRS256(t, key, rs256, expired),
+		"without expiry":      signRS256(t, key, rs256, withoutExpiry),
+		"not valid yet":       signRS256(t, key, rs256, notYetValid),
+		"wrong issuer":        signRS256(t, key, rs256, wrongIssuer),
+		"wrong audience":      signRS256(t, key, rs256, wrongAudience),
+		"signed by other key": signRS256(t, other, rs256, jwtClaims()),
+		"alg none":            unsigned,
+		"HMAC algorithm":      signRS256(t, key, map[string]interface{}{"alg": "HS256"}, jwtClaims()),
+	}
+
+	for name, token := range tests {
+		_, err := helper.VerifyJWT(token, &key.PublicKey, jwtConfig(), jwtNow)
+		assert.True(t, errors.Is(err, helper.ErrInvalidToken), name)
+	}
+}
+
+func TestVerifyJWT_Leeway(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	claims := jwtClaims()
+	claims["exp"] = jwtNow.Add(-10 * time.Second).Unix()
+
+	_, err := helper.VerifyJWT(signRS256(t, key, map[string]interface{}{"alg": "RS256"}, claims), &key.PublicKey, jwtConfig(), jwtNow)
+
+	assert.NoError(t, err)
+}
+
+func TestVerifyJWT_NotJWT(t *testing.T) {
+	_, err := helper.VerifyJWT("4|opaque-session-token", nil, jwtConfig(), jwtNow)
+
+	assert.True(t, errors.Is(err, helper.ErrNotJWT))
+}
+
+func TestParsePublicKeyPEM(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	pkix := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	pkcs1 := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&key.PublicKey)}))
+
+	for _, data := range []string{pkix, pkcs1} {
+		parsed, err := helper.ParsePublicKeyPEM(data)
+		assert.NoError(t, err)
+		assert.True(t, key.PublicKey.Equal(parsed))
+	}
+
+	_, err := helper.ParsePublicKeyPEM("not a key")
+	assert.Error(t, err)
+}
+
+func TestParseJWKS(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	encode := func(value *big.Int) string {
+		return base64.RawURLEncoding.EncodeToString(value.Bytes())
+	}
+
+	document, _ := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": encode(rsaKey.N), "e": encode(big.NewInt(int64(rsaKey.E)))},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": encode(ecKey.X), "y": encode(ecKey.Y)},
+			{"kty": "RSA", "kid": "encryption", "use": "enc", "n": encode(rsaKey.N), "e": "AQAB"},
+			{"kty": "oct", "kid": "secret", "k": "c2VjcmV0"},
+		},
+	})
+
+	keys, err := helper.ParseJWKS(document)
+
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.True(t, rsaKey.PublicKey.Equal(keys["rsa"]))
+	assert.True(t, ecKey.PublicKey.Equal(keys["ec"]))
+
+	_, err = helper.ParseJWKS([]byte(`{"keys":[]}`))
+	assert.Error(t, err)
+}
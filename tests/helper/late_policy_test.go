@@ -0,0 +1,80 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluateSubmission_OnTime(t *testing.T) {
+	deadline := time.Date(2025, 2, 9, 23, 59, 59, 0, time.UTC)
+
+	submission, err := helper.EvaluateSubmission(deadline.Add(-time.Hour), &deadline, helper.DefaultLatePolicy)
+
+	assert.NoError(t, err)
+	assert.False(t, submission.IsLate)
+	assert.False(t, submission.Flagged)
+	assert.Equal(t, time.Duration(0), submission.LateBy)
+}
+
+func TestEvaluateSubmission_NoDeadline(t *testing.T) {
+	submission, err := helper.EvaluateSubmission(time.Now(), nil, helper.LatePolicy{Mode: helper.LATE_POLICY_REJECT})
+
+	assert.NoError(t, err)
+	assert.False(t, submission.IsLate)
+}
+
+func TestEvaluateSubmission_Policies(t *testing.T) {
+	deadline := time.Date(2025, 2, 9, 23, 59, 59, 0, time.UTC)
+	submittedAt := deadline.Add(3 * time.Hour)
+
+	tests := []struct {
+		name       string
+		policy     helper.LatePolicy
+		wantFlag   bool
+		wantLateBy time.Duration
+		wantErr    error
+	}{
+		{"accept", helper.LatePolicy{Mode: helper.LATE_POLICY_ACCEPT}, false, 3 * time.Hour, nil},
+		{"accept with flag", helper.LatePolicy{Mode: helper.LATE_POLICY_ACCEPT_WITH_FLAG}, true, 3 * time.Hour, nil},
+		{"reject within grace", helper.LatePolicy{Mode: helper.LATE_POLICY_REJECT, GracePeriod: 24 * time.Hour}, true, 3 * time.Hour, nil},
+		{"reject after grace", helper.LatePolicy{Mode: helper.LATE_POLICY_REJECT, GracePeriod: time.Hour}, false, 0, helper.ErrReportDeadlinePassed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			submission, err := helper.EvaluateSubmission(submittedAt, &deadline, tt.policy)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, submission.IsLate)
+			assert.Equal(t, tt.wantFlag, submission.Flagged)
+			assert.Equal(t, tt.wantLateBy, submission.LateBy)
+		})
+	}
+}
+
+func TestLatePolicies_ForReportType(t *testing.T) {
+	policies := helper.LatePolicies{
+		"FINAL_REPORT": {Mode: helper.LATE_POLICY_REJECT, GracePeriod: 48 * time.Hour},
+	}
+
+	assert.Equal(t, helper.LATE_POLICY_REJECT, policies.ForReportType("FINAL_REPORT").Mode)
+	assert.Equal(t, helper.DefaultLatePolicy, policies.ForReportType("WEEKLY_REPORT"))
+}
+
+func TestResolveSubmissionStatus(t *testing.T) {
+	now := time.Date(2025, 2, 12, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-48 * time.Hour)
+	future := now.Add(48 * time.Hour)
+
+	assert.Equal(t, helper.SUBMISSION_STATUS_ON_TIME, helper.ResolveSubmissionStatus(&past, true, false, now))
+	assert.Equal(t, helper.SUBMISSION_STATUS_LATE, helper.ResolveSubmissionStatus(&past, true, true, now))
+	assert.Equal(t, helper.SUBMISSION_STATUS_MISSING, helper.ResolveSubmissionStatus(&past, false, false, now))
+	assert.Equal(t, helper.SUBMISSION_STATUS_OPEN, helper.ResolveSubmissionStatus(&future, false, false, now))
+}
@@ -0,0 +1,94 @@
+package helper_test
+
+import (
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	locale, ok := helper.NormalizeLocale("en-US")
+	assert.True(t, ok)
+	assert.Equal(t, helper.LOCALE_EN, locale)
+
+	locale, ok = helper.NormalizeLocale(" ID ")
+	assert.True(t, ok)
+	assert.Equal(t, helper.LOCALE_ID, locale)
+
+	_, ok = helper.NormalizeLocale("fr")
+	assert.False(t, ok)
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	assert.Equal(t, helper.LOCALE_EN, helper.ParseAcceptLanguage("en-GB,en;q=0.9,id;q=0.8"))
+	assert.Equal(t, helper.LOCALE_ID, helper.ParseAcceptLanguage("fr-FR, en;q=0.5, id-ID;q=0.7"))
+	assert.Equal(t, "", helper.ParseAcceptLanguage("fr, de;q=0.8"))
+	assert.Equal(t, "", helper.ParseAcceptLanguage(""))
+}
+
+func TestDefaultNotificationTemplates_CoverEveryLocale(t *testing.T) {
+	types := make(map[string]bool)
+	for _, notificationTemplate := range helper.DefaultNotificationTemplates() {
+		types[notificationTemplate.Type] = true
+	}
+
+	for notificationType := range types {
+		for _, locale := range helper.SupportedLocales {
+			_, ok := helper.DefaultNotificationTemplate(notificationType, locale)
+			assert.True(t, ok, "missing %s template in %s", notificationType, locale)
+		}
+	}
+}
+
+func TestRenderNotificationTemplate_ReportApproval(t *testing.T) {
+	data := dto.NotificationTemplateData{
+		StudentName:  "Budi",
+		AdvisorName:  "Dr. Sari",
+		ActivityName: "Magang",
+		ReportType:   "WEEKLY_REPORT",
+		Week:         2,
+		Status:       "APPROVED",
+		Link:         "https://monitoring.example.com/reports/1",
+	}
+
+	indonesian, _ := helper.DefaultNotificationTemplate(helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_ID)
+	subject, body, err := helper.RenderNotificationTemplate(indonesian, data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Laporan minggu 2 disetujui", subject)
+	assert.Contains(t, body, "telah disetujui oleh Dr. Sari")
+	assert.Contains(t, body, "https://monitoring.example.com/reports/1")
+	assert.NotContains(t, body, "Catatan")
+
+	english, _ := helper.DefaultNotificationTemplate(helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_EN)
+	data.Feedback = "Add more detail"
+	_, body, err = helper.RenderNotificationTemplate(english, data)
+
+	assert.NoError(t, err)
+	assert.Contains(t, body, "Add more detail")
+}
+
+func TestValidateNotificationTemplate(t *testing.T) {
+	valid := helper.NotificationTemplate{Type: "REPORT SUBMITTED", Locale: helper.LOCALE_EN, Subject: "New report", Body: "{{.StudentName}} submitted a report"}
+	assert.NoError(t, helper.ValidateNotificationTemplate(valid))
+
+	unclosed := valid
+	unclosed.Body = "{{.StudentName"
+	assert.ErrorIs(t, helper.ValidateNotificationTemplate(unclosed), helper.ErrInvalidNotificationTemplate)
+
+	unsupported := valid
+	unsupported.Locale = "fr"
+	assert.ErrorIs(t, helper.ValidateNotificationTemplate(unsupported), helper.ErrUnsupportedLocale)
+
+	empty := valid
+	empty.Body = " "
+	assert.ErrorIs(t, helper.ValidateNotificationTemplate(empty), helper.ErrInvalidNotificationTemplate)
+}
+
+func TestNotificationLink(t *testing.T) {
+	assert.Equal(t, "https://monitoring.example.com/reports/1", helper.NotificationLink("https://monitoring.example.com/", "/reports/1"))
+	assert.Equal(t, "https://elsewhere.example.com", helper.NotificationLink("https://monitoring.example.com", "https://elsewhere.example.com"))
+	assert.Equal(t, "", helper.NotificationLink("https://monitoring.example.com", ""))
+}
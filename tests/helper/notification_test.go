@@ -0,0 +1,51 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextNotificationAttempt(t *testing.T) {
+	now := time.Date(2025, 2, 10, 8, 0, 0, 0, time.UTC)
+	config := helper.NotificationConfig{
+		MaxAttempts:    3,
+		RetryBaseDelay: time.Minute,
+		RetryMaxDelay:  3 * time.Minute,
+	}
+
+	for attempts := 1; attempts < config.MaxAttempts; attempts++ {
+		next, ok := helper.NextNotificationAttempt(config, attempts, now)
+
+		assert.True(t, ok)
+		assert.False(t, next.Before(now))
+		assert.False(t, next.After(now.Add(config.RetryMaxDelay)))
+	}
+}
+
+func TestNextNotificationAttempt_GivesUpAfterMaxAttempts(t *testing.T) {
+	config := helper.NotificationConfig{MaxAttempts: 3, RetryBaseDelay: time.Minute}
+
+	_, ok := helper.NextNotificationAttempt(config, 3, time.Now())
+
+	assert.False(t, ok)
+}
+
+func TestNotificationConfig_Validate(t *testing.T) {
+	assert.Error(t, helper.NotificationConfig{Enabled: true}.Validate())
+	assert.NoError(t, helper.NotificationConfig{Enabled: true, ServiceToken: "Bearer service-token"}.Validate())
+	assert.NoError(t, helper.NotificationConfig{Enabled: false}.Validate())
+}
+
+func TestNextDigestRun(t *testing.T) {
+	beforeHour := time.Date(2025, 2, 10, 5, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 2, 10, 7, 0, 0, 0, time.UTC), helper.NextDigestRun(beforeHour, 7))
+
+	atHour := time.Date(2025, 2, 10, 7, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 2, 11, 7, 0, 0, 0, time.UTC), helper.NextDigestRun(atHour, 7))
+
+	afterHour := time.Date(2025, 2, 28, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 1, 7, 0, 0, 0, time.UTC), helper.NextDigestRun(afterHour, 7))
+}
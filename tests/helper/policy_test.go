@@ -0,0 +1,252 @@
+package helper_test
+
+import (
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	policyAdmin   = dto.User{ID: "admin-id", Role: helper.ROLE_ADMIN, Email: "admin@its.ac.id"}
+	policyLOMBKM  = dto.User{ID: "lo-id", Role: helper.ROLE_LO_MBKM, Email: "lo@its.ac.id"}
+	policyAdvisor = dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@its.ac.id"}
+	policyMonitor = dto.User{ID: "monitor-id", Role: helper.ROLE_MONITOR, Email: "monitor@its.ac.id"}
+	policyStudent = dto.User{ID: "student-id", NRP: "5025211111", Role: helper.ROLE_STUDENT, Email: "student@its.ac.id"}
+	policyNoRole  = dto.User{ID: "someone-id", Email: "someone@its.ac.id"}
+)
+
+// policyResource belongs to policyStudent, is advised by policyAdvisor and monitored by policyMonitor
+func policyResource(kind string) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:          kind,
+		OwnerID:       policyStudent.ID,
+		OwnerNRP:      policyStudent.NRP,
+		AdvisorEmail:  policyAdvisor.Email,
+		MonitorEmails: []string{policyMonitor.Email},
+	}
+}
+
+// strangerResource belongs to another student advised and monitored by other lecturers
+func strangerResource(kind string) helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:          kind,
+		OwnerID:       "other-student-id",
+		OwnerNRP:      "5025219999",
+		AdvisorEmail:  "other-advisor@its.ac.id",
+		MonitorEmails: []string{"other-monitor@its.ac.id"},
+	}
+}
+
+func TestAuthorize_Matrix(t *testing.T) {
+	type expectation struct {
+		own      bool
+		stranger bool
+	}
+
+	allow := expectation{own: true, stranger: true}
+	related := expectation{own: true}
+	deny := expectation{}
+
+	tests := []struct {
+		kind   string
+		action string
+		roles  map[string]expectation
+	}{
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_LIST, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_DELETE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_REVIEW, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_DELETE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_SYLLABUS, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_TRANSCRIPT, helper.POLICY_ACTION_DELETE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_REGISTRATION, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: related,
+		}},
+		{helper.POLICY_RESOURCE_PROGRESS, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_DOSSIER, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_ANALYTICS, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_NOTIFICATION_TEMPLATE, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_DIGEST_PREFERENCE, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: allow, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_LOCALE_PREFERENCE, helper.POLICY_ACTION_UPDATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: allow, helper.ROLE_STUDENT: allow,
+		}},
+		{helper.POLICY_RESOURCE_AUDIT_EVENT, helper.POLICY_ACTION_LIST, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_ADVISOR_ASSIGNMENT, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_ADVISOR_DELEGATION, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: allow, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_LIST_MONITORED, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_MONITOR_ASSIGNMENT, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: deny, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_EVALUATION, helper.POLICY_ACTION_VIEW, map[string]expectation{
+			helper.ROLE_ADMIN: allow, helper.ROLE_LO_MBKM: allow, helper.ROLE_ADVISOR: related, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_REPORT_EVALUATION, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+		{helper.POLICY_RESOURCE_MONITORING_VISIT, helper.POLICY_ACTION_CREATE, map[string]expectation{
+			helper.ROLE_ADMIN: deny, helper.ROLE_LO_MBKM: deny, helper.ROLE_ADVISOR: deny, helper.ROLE_MONITOR: related, helper.ROLE_STUDENT: deny,
+		}},
+	}
+
+	actors := map[string]dto.User{
+		helper.ROLE_ADMIN:   policyAdmin,
+		helper.ROLE_LO_MBKM: policyLOMBKM,
+		helper.ROLE_ADVISOR: policyAdvisor,
+		helper.ROLE_MONITOR: policyMonitor,
+		helper.ROLE_STUDENT: policyStudent,
+	}
+
+	for _, tt := range tests {
+		for role, expected := range tt.roles {
+			actor := actors[role]
+
+			err := helper.Authorize(actor, tt.action, policyResource(tt.kind))
+			if expected.own {
+				assert.NoError(t, err, "%s %s own %s", role, tt.action, tt.kind)
+			} else {
+				assert.ErrorIs(t, err, helper.ErrForbidden, "%s %s own %s", role, tt.action, tt.kind)
+			}
+
+			err = helper.Authorize(actor, tt.action, strangerResource(tt.kind))
+			if expected.stranger {
+				assert.NoError(t, err, "%s %s other %s", role, tt.action, tt.kind)
+			} else {
+				assert.ErrorIs(t, err, helper.ErrForbidden, "%s %s other %s", role, tt.action, tt.kind)
+			}
+		}
+	}
+}
+
+func TestAuthorize_WithoutRole(t *testing.T) {
+	err := helper.Authorize(policyNoRole, helper.POLICY_ACTION_VIEW, policyResource(helper.POLICY_RESOURCE_REPORT))
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+	assert.Contains(t, err.Error(), "user without role")
+}
+
+func TestAuthorize_UnknownAction(t *testing.T) {
+	err := helper.Authorize(policyAdmin, "ARCHIVE", policyResource(helper.POLICY_RESOURCE_REPORT))
+	assert.True(t, errors.Is(err, helper.ErrForbidden))
+}
+
+func TestAuthorize_OwnerByNRP(t *testing.T) {
+	// records synced from registrations may only carry the NRP of the student
+	resource := helper.PolicyResource{Kind: helper.POLICY_RESOURCE_SYLLABUS, OwnerNRP: policyStudent.NRP}
+	assert.NoError(t, helper.Authorize(policyStudent, helper.POLICY_ACTION_VIEW, resource))
+
+	resource.OwnerNRP = "5025219999"
+	assert.ErrorIs(t, helper.Authorize(policyStudent, helper.POLICY_ACTION_VIEW, resource), helper.ErrForbidden)
+}
+
+func TestAuthorize_AdvisorWithoutEmail(t *testing.T) {
+	advisor := policyAdvisor
+	advisor.Email = ""
+
+	resource := policyResource(helper.POLICY_RESOURCE_REPORT)
+	resource.AdvisorEmail = ""
+	assert.ErrorIs(t, helper.Authorize(advisor, helper.POLICY_ACTION_REVIEW, resource), helper.ErrForbidden)
+}
+
+func TestAuthorize_CoAdvisor(t *testing.T) {
+	resource := strangerResource(helper.POLICY_RESOURCE_REPORT)
+	assert.ErrorIs(t, helper.Authorize(policyAdvisor, helper.POLICY_ACTION_REVIEW, resource), helper.ErrForbidden)
+
+	resource.AdvisorEmails = []string{"other-advisor@its.ac.id", policyAdvisor.Email}
+	assert.NoError(t, helper.Authorize(policyAdvisor, helper.POLICY_ACTION_REVIEW, resource))
+}
+
+func TestAuthorize_MonitorWithoutEmail(t *testing.T) {
+	monitor := policyMonitor
+	monitor.Email = ""
+
+	resource := policyResource(helper.POLICY_RESOURCE_REPORT)
+	resource.MonitorEmails = []string{""}
+	assert.ErrorIs(t, helper.Authorize(monitor, helper.POLICY_ACTION_VIEW, resource), helper.ErrForbidden)
+}
+
+func TestPolicyRoles(t *testing.T) {
+	assert.Equal(t, []string{helper.ROLE_ADMIN, helper.ROLE_LO_MBKM, helper.ROLE_ADVISOR, helper.ROLE_MONITOR, helper.ROLE_STUDENT}, helper.PolicyRoles(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_VIEW))
+	assert.Equal(t, []string{helper.ROLE_ADVISOR}, helper.PolicyRoles(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_REVIEW))
+	assert.Empty(t, helper.PolicyRoles(helper.POLICY_RESOURCE_REPORT, "ARCHIVE"))
+}
+
+func TestPolicyScope(t *testing.T) {
+	scope, ok := helper.PolicyScope(policyAdvisor, helper.POLICY_RESOURCE_EXPORT, helper.POLICY_ACTION_LIST)
+	assert.True(t, ok)
+	assert.Equal(t, helper.POLICY_SCOPE_ASSIGNED, scope)
+
+	scope, ok = helper.PolicyScope(policyLOMBKM, helper.POLICY_RESOURCE_EXPORT, helper.POLICY_ACTION_LIST)
+	assert.True(t, ok)
+	assert.Equal(t, helper.POLICY_SCOPE_ANY, scope)
+
+	_, ok = helper.PolicyScope(policyStudent, helper.POLICY_RESOURCE_EXPORT, helper.POLICY_ACTION_LIST)
+	assert.False(t, ok)
+}
+
+func TestPolicyDelegated(t *testing.T) {
+	assert.True(t, helper.PolicyDelegated(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_VIEW))
+	assert.True(t, helper.PolicyDelegated(helper.POLICY_RESOURCE_REPORT, helper.POLICY_ACTION_REVIEW))
+	assert.False(t, helper.PolicyDelegated(helper.POLICY_RESOURCE_REPORT_SCHEDULE, helper.POLICY_ACTION_UPDATE))
+	assert.False(t, helper.PolicyDelegated(helper.POLICY_RESOURCE_DOSSIER, helper.POLICY_ACTION_VIEW))
+}
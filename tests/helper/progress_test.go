@@ -0,0 +1,36 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentage(t *testing.T) {
+	tests := []struct {
+		name     string
+		approved int64
+		total    int64
+		expected float64
+	}{
+		{name: "no schedules", approved: 0, total: 0, expected: 0},
+		{name: "none approved", approved: 0, total: 8, expected: 0},
+		{name: "half approved", approved: 4, total: 8, expected: 50},
+		{name: "all approved", approved: 8, total: 8, expected: 100},
+		{name: "rounded to two decimals", approved: 1, total: 3, expected: 33.33},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, helper.Percentage(tt.approved, tt.total))
+		})
+	}
+}
+
+func TestValidateDashboardSort(t *testing.T) {
+	assert.True(t, helper.ValidateDashboardSort(""))
+	assert.True(t, helper.ValidateDashboardSort(helper.DASHBOARD_SORT_PENDING))
+	assert.True(t, helper.ValidateDashboardSort(helper.DASHBOARD_SORT_OVERDUE))
+	assert.False(t, helper.ValidateDashboardSort("user_nrp; DROP TABLE reports"))
+}
@@ -0,0 +1,44 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReminderWindows(t *testing.T) {
+	now := time.Date(2025, 2, 10, 8, 0, 0, 0, time.UTC)
+	config := helper.ReminderConfig{
+		DueSoonOffsets:  []time.Duration{24 * time.Hour, 72 * time.Hour, 24 * time.Hour},
+		OverdueOffsets:  []time.Duration{0},
+		OverdueLookback: 7 * 24 * time.Hour,
+	}
+
+	windows := helper.ReminderWindows(config, now)
+
+	assert.Len(t, windows, 3)
+
+	assert.Equal(t, helper.REMINDER_TYPE_DUE_SOON, windows[0].Type)
+	assert.Equal(t, "REPORT_DUE_SOON_72h0m0s", windows[0].Kind)
+	assert.Equal(t, now.Add(24*time.Hour), windows[0].From)
+	assert.Equal(t, now.Add(72*time.Hour), windows[0].To)
+
+	assert.Equal(t, "REPORT_DUE_SOON_24h0m0s", windows[1].Kind)
+	assert.Equal(t, now, windows[1].From)
+	assert.Equal(t, now.Add(24*time.Hour), windows[1].To)
+
+	assert.Equal(t, helper.REMINDER_TYPE_OVERDUE, windows[2].Type)
+	assert.Equal(t, "REPORT_OVERDUE_0s", windows[2].Kind)
+	assert.Equal(t, now.Add(-7*24*time.Hour), windows[2].From)
+	assert.Equal(t, now, windows[2].To)
+}
+
+func TestReminderWindows_IgnoresNegativeOffsets(t *testing.T) {
+	windows := helper.ReminderWindows(helper.ReminderConfig{
+		DueSoonOffsets: []time.Duration{-time.Hour},
+	}, time.Now())
+
+	assert.Empty(t, windows)
+}
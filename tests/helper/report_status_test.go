@@ -0,0 +1,89 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateReportStatusTransition(t *testing.T) {
+	tests := []struct {
+		from    string
+		to      string
+		wantErr error
+	}{
+		{helper.REPORT_STATUS_DRAFT, helper.REPORT_STATUS_PENDING, nil},
+		{helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_APPROVED, nil},
+		{helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_REVISION_REQUESTED, nil},
+		{helper.REPORT_STATUS_REVISION_REQUESTED, helper.REPORT_STATUS_RESUBMITTED, nil},
+		{helper.REPORT_STATUS_RESUBMITTED, helper.REPORT_STATUS_REJECTED, nil},
+		{helper.REPORT_STATUS_APPROVED, helper.REPORT_STATUS_PENDING, helper.ErrReportStatusConflict},
+		{helper.REPORT_STATUS_REJECTED, helper.REPORT_STATUS_APPROVED, helper.ErrReportStatusConflict},
+		{helper.REPORT_STATUS_DRAFT, helper.REPORT_STATUS_APPROVED, helper.ErrReportStatusConflict},
+		{helper.REPORT_STATUS_PENDING, "APROVED", helper.ErrInvalidReportStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			err := helper.ValidateReportStatusTransition(tt.from, tt.to)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateReviewStatus(t *testing.T) {
+	assert.True(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_REVISION_REQUESTED))
+	assert.True(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_APPROVED))
+	assert.True(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_REJECTED))
+	assert.False(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_DRAFT))
+	assert.False(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_PENDING))
+	assert.False(t, helper.ValidateReviewStatus(helper.REPORT_STATUS_RESUBMITTED))
+}
+
+func TestValidateInitialReportStatus(t *testing.T) {
+	status, err := helper.ValidateInitialReportStatus("")
+	assert.NoError(t, err)
+	assert.Equal(t, helper.REPORT_STATUS_PENDING, status)
+
+	status, err = helper.ValidateInitialReportStatus(helper.REPORT_STATUS_DRAFT)
+	assert.NoError(t, err)
+	assert.Equal(t, helper.REPORT_STATUS_DRAFT, status)
+
+	_, err = helper.ValidateInitialReportStatus(helper.REPORT_STATUS_APPROVED)
+	assert.ErrorIs(t, err, helper.ErrInvalidReportStatus)
+}
+
+func TestResolveEditedReportStatus(t *testing.T) {
+	tests := []struct {
+		current   string
+		requested string
+		want      string
+		wantErr   error
+	}{
+		{helper.REPORT_STATUS_DRAFT, "", helper.REPORT_STATUS_DRAFT, nil},
+		{helper.REPORT_STATUS_DRAFT, helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_PENDING, nil},
+		{helper.REPORT_STATUS_PENDING, "", helper.REPORT_STATUS_PENDING, nil},
+		{helper.REPORT_STATUS_REVISION_REQUESTED, "", helper.REPORT_STATUS_RESUBMITTED, nil},
+		{helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_DRAFT, "", helper.ErrReportStatusConflict},
+		{helper.REPORT_STATUS_APPROVED, "", "", helper.ErrReportLocked},
+		{helper.REPORT_STATUS_REJECTED, "", "", helper.ErrReportStatusConflict},
+		{helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_APPROVED, "", helper.ErrInvalidReportStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current+"+"+tt.requested, func(t *testing.T) {
+			status, err := helper.ResolveEditedReportStatus(tt.current, tt.requested)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, status)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package helper_test
+
+import (
+	"monitoring-service/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateReportPeriods_Weekly(t *testing.T) {
+	startDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 2, 23, 59, 59, 0, time.UTC)
+
+	periods, err := helper.GenerateReportPeriods(startDate, endDate, helper.REPORT_CADENCE_WEEKLY)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(periods))
+	assert.Equal(t, 1, periods[0].Week)
+	assert.Equal(t, startDate, periods[0].StartDate)
+	assert.Equal(t, time.Date(2025, 2, 9, 23, 59, 59, 0, time.UTC), periods[0].EndDate)
+	assert.Equal(t, 4, periods[3].Week)
+	assert.Equal(t, endDate, periods[3].EndDate)
+}
+
+func TestGenerateReportPeriods_Biweekly(t *testing.T) {
+	startDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	periods, err := helper.GenerateReportPeriods(startDate, endDate, helper.REPORT_CADENCE_BIWEEKLY)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(periods))
+	assert.Equal(t, []int{1, 3, 5}, []int{periods[0].Week, periods[1].Week, periods[2].Week})
+	assert.Equal(t, endDate, periods[2].EndDate)
+}
+
+func TestGenerateReportPeriods_InvalidWindow(t *testing.T) {
+	startDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	_, err := helper.GenerateReportPeriods(startDate, startDate, helper.REPORT_CADENCE_WEEKLY)
+	assert.ErrorIs(t, err, helper.ErrInvalidDateRange)
+
+	_, err = helper.GenerateReportPeriods(startDate, startDate.AddDate(0, 1, 0), "MONTHLY")
+	assert.Error(t, err)
+}
+
+func TestActivityWeeks(t *testing.T) {
+	startDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 4, helper.ActivityWeeks(startDate, time.Date(2025, 3, 2, 23, 59, 59, 0, time.UTC)))
+	assert.Equal(t, 1, helper.ActivityWeeks(startDate, startDate.AddDate(0, 0, 3)))
+	assert.Equal(t, 0, helper.ActivityWeeks(startDate, startDate))
+}
+
+func TestValidateReportWindow(t *testing.T) {
+	startDate := time.Date(2025, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, helper.ValidateReportWindow(startDate, startDate.AddDate(0, 0, 7*16), 16))
+	assert.ErrorIs(t, helper.ValidateReportWindow(startDate, startDate.AddDate(0, 0, 7*16+1), 16), helper.ErrInvalidDateRange)
+	assert.NoError(t, helper.ValidateReportWindow(startDate, startDate.AddDate(100, 0, 0), 0))
+}
@@ -0,0 +1,57 @@
+package repository_test
+
+import (
+	"context"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestAdvisorNotificationRepository_FindPreference_NotFound(t *testing.T) {
+	mockRepo := new(repository_mock.MockAdvisorNotificationRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindPreference", ctx, "advisor@example.com", mock.Anything).Return(entity.AdvisorNotificationPreference{}, gorm.ErrRecordNotFound)
+
+	_, err := mockRepo.FindPreference(ctx, "advisor@example.com", nil)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestAdvisorNotificationRepository_FindUndigestedEntries(t *testing.T) {
+	mockRepo := new(repository_mock.MockAdvisorNotificationRepository)
+
+	ctx := context.Background()
+	entries := []entity.AdvisorDigestEntry{{
+		ID:           uuid.New(),
+		AdvisorEmail: "advisor@example.com",
+		Type:         "REPORT SUBMITTED",
+		Message:      "Student (5025211000) submitted report week 1 WEEKLY_REPORT",
+	}}
+	mockRepo.On("FindUndigestedEntries", ctx, mock.Anything).Return(entries, nil)
+
+	result, err := mockRepo.FindUndigestedEntries(ctx, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entries, result)
+}
+
+func TestAdvisorNotificationRepository_MarkDigested(t *testing.T) {
+	mockRepo := new(repository_mock.MockAdvisorNotificationRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	ids := []string{uuid.NewString()}
+	mockRepo.On("MarkDigested", ctx, ids, now, mock.Anything).Return(nil)
+
+	err := mockRepo.MarkDigested(ctx, ids, now, nil)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
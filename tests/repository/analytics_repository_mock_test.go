@@ -0,0 +1,92 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	repository_mock "monitoring-service/mocks/repository"
+	"monitoring-service/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func createMockAnalyticsFilter() repository.AnalyticsFilter {
+	from := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	return repository.AnalyticsFilter{
+		From:         &from,
+		To:           &to,
+		ActivityName: "Kampus Mengajar",
+	}
+}
+
+func TestAnalyticsRepository_SubmissionRateByWeek(t *testing.T) {
+	mockRepo := new(repository_mock.MockAnalyticsRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	filter := createMockAnalyticsFilter()
+	rates := []repository.WeeklySubmissionRate{
+		{Week: 1, Due: 40, Submitted: 38, OnTime: 35, Late: 3},
+		{Week: 2, Due: 40, Submitted: 30, OnTime: 30},
+	}
+	mockRepo.On("SubmissionRateByWeek", ctx, filter, now, mock.Anything).Return(rates, nil)
+
+	result, err := mockRepo.SubmissionRateByWeek(ctx, filter, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rates, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAnalyticsRepository_ApprovalLatency(t *testing.T) {
+	mockRepo := new(repository_mock.MockAnalyticsRepository)
+
+	ctx := context.Background()
+	filter := createMockAnalyticsFilter()
+	latency := repository.ApprovalLatency{Reviewed: 12, AverageSeconds: 86400, MedianSeconds: 43200, P90Seconds: 259200, MaxSeconds: 604800}
+	mockRepo.On("ApprovalLatency", ctx, filter, mock.Anything).Return(latency, nil)
+
+	result, err := mockRepo.ApprovalLatency(ctx, filter, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, latency, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAnalyticsRepository_AdvisorBacklog(t *testing.T) {
+	mockRepo := new(repository_mock.MockAnalyticsRepository)
+
+	ctx := context.Background()
+	oldest := time.Now().Add(-72 * time.Hour)
+	filter := repository.AnalyticsFilter{}
+	backlogs := []repository.AdvisorBacklog{
+		{AcademicAdvisorEmail: "advisor1@example.com", PendingReviews: 9, OldestPendingAt: &oldest},
+		{AcademicAdvisorEmail: "advisor2@example.com", PendingReviews: 4, OldestPendingAt: &oldest},
+	}
+	mockRepo.On("AdvisorBacklog", ctx, filter, 10, mock.Anything).Return(backlogs, nil)
+
+	result, err := mockRepo.AdvisorBacklog(ctx, filter, 10, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, backlogs, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAnalyticsRepository_LowestActivityCompliance_Error(t *testing.T) {
+	mockRepo := new(repository_mock.MockAnalyticsRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	filter := createMockAnalyticsFilter()
+	mockRepo.On("LowestActivityCompliance", ctx, filter, now, 5, mock.Anything).Return([]repository.ActivityCompliance(nil), errors.New("database error"))
+
+	result, err := mockRepo.LowestActivityCompliance(ctx, filter, now, 5, nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
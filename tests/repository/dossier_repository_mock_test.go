@@ -0,0 +1,75 @@
+package repository_test
+
+import (
+	"context"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func createMockRegistrationDossier() entity.RegistrationDossier {
+	now := time.Now()
+
+	return entity.RegistrationDossier{
+		ID:               uuid.New(),
+		RegistrationID:   "9c2fc428-3cca-4c76-a690-e6ba24d135b5",
+		FileStorageID:    "file-123",
+		GeneratedByID:    "admin-1",
+		GeneratedByEmail: "admin@example.com",
+		GeneratedAt:      &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+}
+
+func TestDossierRepository_Save(t *testing.T) {
+	mockRepo := new(repository_mock.MockDossierRepository)
+
+	ctx := context.Background()
+	dossier := createMockRegistrationDossier()
+	mockRepo.On("Save", ctx, dossier, mock.Anything).Return(dossier, nil)
+
+	result, err := mockRepo.Save(ctx, dossier, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, dossier, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDossierRepository_FindByRegistrationID_NotFound(t *testing.T) {
+	mockRepo := new(repository_mock.MockDossierRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindByRegistrationID", ctx, "unknown", mock.Anything).Return(entity.RegistrationDossier{}, gorm.ErrRecordNotFound)
+
+	result, err := mockRepo.FindByRegistrationID(ctx, "unknown", nil)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, entity.RegistrationDossier{}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDossierRepository_FindReportSchedulesByRegistrationID(t *testing.T) {
+	mockRepo := new(repository_mock.MockDossierRepository)
+
+	ctx := context.Background()
+	reportSchedules := []entity.ReportSchedule{
+		{ID: uuid.New(), RegistrationID: "registration-1", ReportType: "WEEKLY_REPORT", Week: 1, Report: []entity.Report{{ID: uuid.New(), AcademicAdvisorStatus: "APPROVED"}}},
+		{ID: uuid.New(), RegistrationID: "registration-1", ReportType: "FINAL_REPORT", Week: 16},
+	}
+	mockRepo.On("FindReportSchedulesByRegistrationID", ctx, "registration-1", mock.Anything).Return(reportSchedules, nil)
+
+	result, err := mockRepo.FindReportSchedulesByRegistrationID(ctx, "registration-1", nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,87 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	repository_mock "monitoring-service/mocks/repository"
+	"monitoring-service/repository"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExportRepository_StreamReportSchedules(t *testing.T) {
+	mockRepo := new(repository_mock.MockExportRepository)
+
+	ctx := context.Background()
+	filter := repository.ExportFilter{AdvisorEmail: "advisor@example.com"}
+	rows := []repository.ExportScheduleRow{
+		{UserNRP: "5025211001", Week: 1},
+		{UserNRP: "5025211001", Week: 2},
+	}
+	mockRepo.On("StreamReportSchedules", ctx, filter, mock.Anything).Return(rows, nil)
+
+	var streamed []repository.ExportScheduleRow
+	err := mockRepo.StreamReportSchedules(ctx, filter, func(row repository.ExportScheduleRow) error {
+		streamed = append(streamed, row)
+		return nil
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rows, streamed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExportRepository_StreamReports_CallbackError(t *testing.T) {
+	mockRepo := new(repository_mock.MockExportRepository)
+
+	ctx := context.Background()
+	filter := repository.ExportFilter{}
+	rows := []repository.ExportScheduleRow{{UserNRP: "5025211001"}, {UserNRP: "5025211002"}}
+	mockRepo.On("StreamReports", ctx, filter, mock.Anything).Return(rows, nil)
+
+	calls := 0
+	err := mockRepo.StreamReports(ctx, filter, func(row repository.ExportScheduleRow) error {
+		calls++
+		return errors.New("write error")
+	}, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestExportRepository_StreamDocuments(t *testing.T) {
+	mockRepo := new(repository_mock.MockExportRepository)
+
+	ctx := context.Background()
+	filter := repository.ExportFilter{ActivityName: "Kampus Mengajar"}
+	rows := []repository.ExportDocumentRow{
+		{RegistrationID: "registration-1", UserNRP: "5025211001", SyllabusUploaded: true},
+	}
+	mockRepo.On("StreamDocuments", ctx, filter, mock.Anything).Return(rows, nil)
+
+	var streamed []repository.ExportDocumentRow
+	err := mockRepo.StreamDocuments(ctx, filter, func(row repository.ExportDocumentRow) error {
+		streamed = append(streamed, row)
+		return nil
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rows, streamed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExportRepository_FindUnnamedUserIDs(t *testing.T) {
+	mockRepo := new(repository_mock.MockExportRepository)
+
+	ctx := context.Background()
+	filter := repository.ExportFilter{AdvisorEmail: "advisor@example.com"}
+	mockRepo.On("FindUnnamedUserIDs", ctx, filter, mock.Anything).Return([]string{"student-1", "student-2"}, nil)
+
+	userIDs, err := mockRepo.FindUnnamedUserIDs(ctx, filter, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"student-1", "student-2"}, userIDs)
+	mockRepo.AssertExpectations(t)
+}
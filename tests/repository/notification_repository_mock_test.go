@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func createMockNotification(status string) entity.Notification {
+	now := time.Now()
+
+	return entity.Notification{
+		ID:            uuid.New(),
+		SenderName:    "Advisor",
+		SenderEmail:   "advisor@example.com",
+		ReceiverEmail: "student@example.com",
+		Type:          "APPROVAL REPORT",
+		Message:       "report week 1 WEEKLY_REPORT has been APPROVED by Advisor",
+		Status:        status,
+		NextAttemptAt: &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+}
+
+func TestNotificationRepository_FindDue(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	notifications := []entity.Notification{createMockNotification(helper.NOTIFICATION_STATUS_PENDING)}
+	mockRepo.On("FindDue", ctx, now, 50, mock.Anything).Return(notifications, nil)
+
+	result, err := mockRepo.FindDue(ctx, now, 50, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, notifications, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNotificationRepository_FindByStatus(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationRepository)
+
+	ctx := context.Background()
+	pagReq := &dto.PaginationRequest{Limit: 10, Offset: 0}
+	notifications := []entity.Notification{createMockNotification(helper.NOTIFICATION_STATUS_FAILED)}
+	mockRepo.On("FindByStatus", ctx, helper.NOTIFICATION_STATUS_FAILED, pagReq, mock.Anything).Return(notifications, int64(1), nil)
+
+	result, total, err := mockRepo.FindByStatus(ctx, helper.NOTIFICATION_STATUS_FAILED, pagReq, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, result, 1)
+}
+
+func TestNotificationRepository_Resend_NotFailed(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationRepository)
+
+	ctx := context.Background()
+	id := uuid.NewString()
+	mockRepo.On("Resend", ctx, id, mock.Anything, mock.Anything).Return(false, nil)
+
+	resent, err := mockRepo.Resend(ctx, id, time.Now(), nil)
+
+	assert.NoError(t, err)
+	assert.False(t, resent)
+}
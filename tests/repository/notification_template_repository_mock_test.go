@@ -0,0 +1,57 @@
+package repository_test
+
+import (
+	"context"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestNotificationTemplateRepository_FindByTypeAndLocale(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationTemplateRepository)
+
+	ctx := context.Background()
+	notificationTemplate := entity.NotificationTemplate{
+		ID:      uuid.New(),
+		Type:    helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+		Locale:  helper.LOCALE_EN,
+		Subject: "Report week {{.Week}} reviewed",
+		Body:    "{{.AdvisorName}} reviewed your report",
+	}
+	mockRepo.On("FindByTypeAndLocale", ctx, helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_EN, mock.Anything).Return(notificationTemplate, nil)
+
+	result, err := mockRepo.FindByTypeAndLocale(ctx, helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_EN, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, notificationTemplate, result)
+}
+
+func TestNotificationTemplateRepository_FindLocale_NotFound(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationTemplateRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindLocale", ctx, "student@example.com", mock.Anything).Return(entity.UserLocalePreference{}, gorm.ErrRecordNotFound)
+
+	_, err := mockRepo.FindLocale(ctx, "student@example.com", nil)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestNotificationTemplateRepository_SaveLocale(t *testing.T) {
+	mockRepo := new(repository_mock.MockNotificationTemplateRepository)
+
+	ctx := context.Background()
+	preference := entity.UserLocalePreference{UserEmail: "student@example.com", Locale: helper.LOCALE_EN}
+	mockRepo.On("SaveLocale", ctx, preference, mock.Anything).Return(nil)
+
+	err := mockRepo.SaveLocale(ctx, preference, nil)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,77 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func createMockOutboxEvent(eventType string) entity.OutboxEvent {
+	now := time.Now()
+
+	return entity.OutboxEvent{
+		ID:            uuid.New(),
+		EventType:     eventType,
+		SchemaVersion: 1,
+		AggregateType: "report",
+		AggregateID:   uuid.NewString(),
+		Payload:       `{"type":"` + eventType + `"}`,
+		OccurredAt:    &now,
+	}
+}
+
+func TestOutboxRepository_Create(t *testing.T) {
+	mockRepo := new(repository_mock.MockOutboxRepository)
+
+	ctx := context.Background()
+	outboxEvents := []entity.OutboxEvent{createMockOutboxEvent("report.submitted")}
+	mockRepo.On("Create", ctx, outboxEvents, mock.Anything).Return(nil)
+
+	err := mockRepo.Create(ctx, outboxEvents, nil)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestOutboxRepository_PublishPending(t *testing.T) {
+	mockRepo := new(repository_mock.MockOutboxRepository)
+
+	ctx := context.Background()
+	outboxEvents := []entity.OutboxEvent{createMockOutboxEvent("report.submitted"), createMockOutboxEvent("report.approved")}
+	mockRepo.On("PublishPending", ctx, 100, mock.Anything).Return(outboxEvents, nil)
+
+	var published []string
+	count, err := mockRepo.PublishPending(ctx, 100, func(outboxEvent entity.OutboxEvent) error {
+		published = append(published, outboxEvent.EventType)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"report.submitted", "report.approved"}, published)
+}
+
+func TestOutboxRepository_PublishPending_StopsAtFailure(t *testing.T) {
+	mockRepo := new(repository_mock.MockOutboxRepository)
+
+	ctx := context.Background()
+	outboxEvents := []entity.OutboxEvent{createMockOutboxEvent("report.submitted"), createMockOutboxEvent("report.approved")}
+	mockRepo.On("PublishPending", ctx, 100, mock.Anything).Return(outboxEvents, nil)
+
+	calls := 0
+	count, err := mockRepo.PublishPending(ctx, 100, func(outboxEvent entity.OutboxEvent) error {
+		calls++
+		return errors.New("broker unavailable")
+	})
+
+	assert.EqualError(t, err, "broker unavailable")
+	assert.Equal(t, 0, count)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,105 @@
+package repository_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	"monitoring-service/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestProgressRepository_SummarizeByRegistrationID(t *testing.T) {
+	mockRepo := new(repository_mock.MockProgressRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	nextDueWeek := 5
+	nextDueDate := now.Add(48 * time.Hour)
+	progress := repository.RegistrationProgress{
+		RegistrationID:       "9c2fc428-3cca-4c76-a690-e6ba24d135b5",
+		UserID:               "user-123",
+		UserNRP:              "5025211000",
+		AcademicAdvisorEmail: "advisor@example.com",
+		Total:                8,
+		Approved:             3,
+		Pending:              1,
+		Missing:              1,
+		Late:                 2,
+		NextDueWeek:          &nextDueWeek,
+		NextDueDate:          &nextDueDate,
+	}
+	mockRepo.On("SummarizeByRegistrationID", ctx, progress.RegistrationID, now, mock.Anything).Return(progress, nil)
+
+	result, err := mockRepo.SummarizeByRegistrationID(ctx, progress.RegistrationID, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, progress, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProgressRepository_SummarizeByRegistrationID_NotFound(t *testing.T) {
+	mockRepo := new(repository_mock.MockProgressRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	mockRepo.On("SummarizeByRegistrationID", ctx, "unknown", now, mock.Anything).Return(repository.RegistrationProgress{}, gorm.ErrRecordNotFound)
+
+	result, err := mockRepo.SummarizeByRegistrationID(ctx, "unknown", now, nil)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, repository.RegistrationProgress{}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProgressRepository_SummarizeByAdvisorEmail(t *testing.T) {
+	mockRepo := new(repository_mock.MockProgressRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	pagReq := &dto.PaginationRequest{Offset: 0, Limit: 10}
+	students := []repository.StudentProgress{
+		{UserID: "user-1", UserNRP: "5025211001", Registrations: 1, PendingReviews: 3, OverdueWeeks: 1, LastSubmittedAt: &now},
+		{UserID: "user-2", UserNRP: "5025211002", Registrations: 2, PendingReviews: 1, OverdueWeeks: 4},
+	}
+	mockRepo.On("SummarizeByAdvisorEmail", ctx, "advisor@example.com", helper.DASHBOARD_SORT_PENDING, pagReq, now, mock.Anything).Return(students, nil)
+
+	result, err := mockRepo.SummarizeByAdvisorEmail(ctx, "advisor@example.com", helper.DASHBOARD_SORT_PENDING, pagReq, now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, students, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProgressRepository_SummarizeByAdvisorEmail_InvalidSort(t *testing.T) {
+	mockRepo := new(repository_mock.MockProgressRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	mockRepo.On("SummarizeByAdvisorEmail", ctx, "advisor@example.com", "unknown", mock.Anything, now, mock.Anything).Return([]repository.StudentProgress(nil), helper.ErrInvalidDashboardSort)
+
+	result, err := mockRepo.SummarizeByAdvisorEmail(ctx, "advisor@example.com", "unknown", nil, now, nil)
+
+	assert.ErrorIs(t, err, helper.ErrInvalidDashboardSort)
+	assert.Nil(t, result)
+}
+
+func TestProgressRepository_TotalsByAdvisorEmail(t *testing.T) {
+	mockRepo := new(repository_mock.MockProgressRepository)
+
+	ctx := context.Background()
+	now := time.Now()
+	totals := repository.AdvisorProgressTotals{Students: 2, PendingReviews: 4, OverdueWeeks: 5}
+	mockRepo.On("TotalsByAdvisorEmail", ctx, "advisor@example.com", now, mock.Anything).Return(totals, nil)
+
+	result, err := mockRepo.TotalsByAdvisorEmail(ctx, "advisor@example.com", now, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, totals, result)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func createMockRegistrationSnapshot() entity.RegistrationSnapshot {
+	now := time.Now()
+
+	return entity.RegistrationSnapshot{
+		RegistrationID:       "9c2fc428-3cca-4c76-a690-e6ba24d135b5",
+		UserID:               "user-123",
+		UserNRP:              "5025211000",
+		UserName:             "Student",
+		AcademicAdvisorID:    "advisor-123",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Internship",
+		ApprovalStatus:       true,
+		SyncedAt:             &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+}
+
+func TestRegistrationSnapshotRepository_FindByIDs(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	snapshot := createMockRegistrationSnapshot()
+	ids := []string{snapshot.RegistrationID, "unknown"}
+	snapshots := map[string]entity.RegistrationSnapshot{snapshot.RegistrationID: snapshot}
+	mockRepo.On("FindByIDs", ctx, ids, mock.Anything).Return(snapshots, nil)
+
+	result, err := mockRepo.FindByIDs(ctx, ids, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, snapshots, result)
+	assert.NotContains(t, result, "unknown")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSnapshotRepository_FindByIDs_Error(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindByIDs", ctx, []string{"first"}, mock.Anything).Return(nil, errors.New("database error"))
+
+	result, err := mockRepo.FindByIDs(ctx, []string{"first"}, nil)
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSnapshotRepository_Sync(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	snapshot := createMockRegistrationSnapshot()
+	mockRepo.On("Sync", ctx, snapshot, mock.Anything).Return(true, nil)
+
+	applied, err := mockRepo.Sync(ctx, snapshot, nil)
+
+	assert.NoError(t, err)
+	assert.True(t, applied)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSnapshotRepository_Sync_Stale(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	snapshot := createMockRegistrationSnapshot()
+	mockRepo.On("Sync", ctx, snapshot, mock.Anything).Return(false, nil)
+
+	applied, err := mockRepo.Sync(ctx, snapshot, nil)
+
+	assert.NoError(t, err)
+	assert.False(t, applied)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSnapshotRepository_Destroy(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	syncedAt := time.Now()
+	mockRepo.On("Destroy", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", syncedAt, mock.Anything).Return(true, nil)
+
+	deleted, err := mockRepo.Destroy(ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", syncedAt, nil)
+
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSnapshotRepository_FindUnsyncedIDs(t *testing.T) {
+	mockRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindUnsyncedIDs", ctx, "", 100, mock.Anything).Return([]string{"9c2fc428-3cca-4c76-a690-e6ba24d135b5"}, nil)
+
+	result, err := mockRepo.FindUnsyncedIDs(ctx, "", 100, nil)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	mockRepo.AssertExpectations(t)
+}
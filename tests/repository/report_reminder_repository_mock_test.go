@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func createMockReportReminder() entity.ReportReminder {
+	now := time.Now()
+
+	return entity.ReportReminder{
+		ID:               uuid.New(),
+		ReportScheduleID: uuid.NewString(),
+		Kind:             "REPORT_DUE_SOON_24h0m0s",
+		Type:             "REPORT_DUE_SOON",
+		ReceiverEmail:    "student@example.com",
+		SentAt:           &now,
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+}
+
+func TestReportReminderRepository_WithLock_Acquired(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	mockRepo.On("WithLock", ctx, "reminders", mock.Anything).Return(true, nil)
+
+	called := false
+	acquired, err := mockRepo.WithLock(ctx, "reminders", func(tx *gorm.DB) error {
+		called = true
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	assert.True(t, called)
+}
+
+func TestReportReminderRepository_WithLock_Taken(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	mockRepo.On("WithLock", ctx, "reminders", mock.Anything).Return(false, nil)
+
+	called := false
+	acquired, err := mockRepo.WithLock(ctx, "reminders", func(tx *gorm.DB) error {
+		called = true
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+	assert.False(t, called)
+}
+
+func TestReportReminderRepository_FindPendingSchedules(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	from := time.Now()
+	to := from.Add(24 * time.Hour)
+	reportSchedules := []entity.ReportSchedule{{ID: uuid.New(), UserID: "user-123", EndDate: &to}}
+	mockRepo.On("FindPendingSchedules", ctx, "REPORT_DUE_SOON_24h0m0s", from, to, mock.Anything).Return(reportSchedules, nil)
+
+	result, err := mockRepo.FindPendingSchedules(ctx, "REPORT_DUE_SOON_24h0m0s", from, to, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reportSchedules, result)
+}
+
+func TestReportReminderRepository_Create(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	reportReminder := createMockReportReminder()
+	mockRepo.On("Create", ctx, reportReminder, mock.Anything).Return(true, nil)
+
+	claimed, err := mockRepo.Create(ctx, reportReminder, nil)
+
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestReportReminderRepository_Create_AlreadyClaimed(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	reportReminder := createMockReportReminder()
+	mockRepo.On("Create", ctx, reportReminder, mock.Anything).Return(false, nil)
+
+	claimed, err := mockRepo.Create(ctx, reportReminder, nil)
+
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestReportReminderRepository_Destroy_Error(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportReminderRepository)
+
+	ctx := context.Background()
+	mockRepo.On("Destroy", ctx, "reminder-id", mock.Anything).Return(errors.New("database error"))
+
+	err := mockRepo.Destroy(ctx, "reminder-id", nil)
+
+	assert.Error(t, err)
+}
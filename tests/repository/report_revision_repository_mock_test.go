@@ -0,0 +1,112 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	repository_mock "monitoring-service/mocks/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func createMockReportRevision(revisionNumber int) entity.ReportRevision {
+	now := time.Now()
+
+	return entity.ReportRevision{
+		ID:                    uuid.New(),
+		ReportID:              "9c2fc428-3cca-4c76-a690-e6ba24d135b5",
+		RevisionNumber:        revisionNumber,
+		Title:                 "Test Report",
+		Content:               "Test Content",
+		ReportType:            "WEEKLY_REPORT",
+		AcademicAdvisorStatus: "PENDING",
+		ActorID:               "user-123",
+		ActorEmail:            "user@example.com",
+		ActorRole:             "MAHASISWA",
+		BaseModel: entity.BaseModel{
+			CreatedAt: &now,
+			UpdatedAt: &now,
+		},
+	}
+}
+
+func TestReportRevisionRepository_Create(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	reportRevision := createMockReportRevision(1)
+	mockRepo.On("Create", ctx, reportRevision, mock.Anything).Return(reportRevision, nil)
+
+	result, err := mockRepo.Create(ctx, reportRevision, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reportRevision, result)
+}
+
+func TestReportRevisionRepository_Create_Error(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	reportRevision := createMockReportRevision(1)
+	mockRepo.On("Create", ctx, reportRevision, mock.Anything).Return(entity.ReportRevision{}, errors.New("database error"))
+
+	result, err := mockRepo.Create(ctx, reportRevision, nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, entity.ReportRevision{}, result)
+}
+
+func TestReportRevisionRepository_FindByReportID(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	reportRevisions := []entity.ReportRevision{createMockReportRevision(1), createMockReportRevision(2)}
+	mockRepo.On("FindByReportID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(reportRevisions, nil)
+
+	result, err := mockRepo.FindByReportID(ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reportRevisions, result)
+}
+
+func TestReportRevisionRepository_FindByReportID_Error(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindByReportID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return([]entity.ReportRevision{}, errors.New("database error"))
+
+	result, err := mockRepo.FindByReportID(ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", nil)
+
+	assert.Error(t, err)
+	assert.Empty(t, result)
+}
+
+func TestReportRevisionRepository_FindByReportIDAndRevisionNumber(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	reportRevision := createMockReportRevision(2)
+	mockRepo.On("FindByReportIDAndRevisionNumber", ctx, reportRevision.ReportID, 2, mock.Anything).Return(reportRevision, nil)
+
+	result, err := mockRepo.FindByReportIDAndRevisionNumber(ctx, reportRevision.ReportID, 2, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, reportRevision, result)
+}
+
+func TestReportRevisionRepository_FindByReportIDAndRevisionNumber_NotFound(t *testing.T) {
+	mockRepo := new(repository_mock.MockReportRevisionRepository)
+
+	ctx := context.Background()
+	mockRepo.On("FindByReportIDAndRevisionNumber", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", 9, mock.Anything).Return(entity.ReportRevision{}, gorm.ErrRecordNotFound)
+
+	result, err := mockRepo.FindByReportIDAndRevisionNumber(ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", 9, nil)
+
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Equal(t, entity.ReportRevision{}, result)
+}
@@ -272,9 +272,9 @@ func TestReportScheduleRepository_FindByAdvisorEmailAndGroupByUserID(t *testing.
 	}
 	totalCount := int64(1)
 
-	mockRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, pagReq, userNRP).Return(repository_mockchedules, totalCount, nil)
+	mockRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, pagReq, userNRP, "").Return(repository_mockchedules, totalCount, nil)
 
-	result, count, err := mockRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, pagReq, userNRP)
+	result, count, err := mockRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, pagReq, userNRP, "")
 
 	assert.NoError(t, err)
 	assert.Equal(t, repository_mockchedules, result)
@@ -289,9 +289,9 @@ func TestReportScheduleRepository_FindByAdvisorEmailAndGroupByUserID_Error(t *te
 	pagReq := &dto.PaginationRequest{Limit: 10, Offset: 0}
 	userNRP := ""
 
-	mockRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, pagReq, userNRP).Return(map[string][]entity.ReportSchedule{}, int64(0), errors.New("error"))
+	mockRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, pagReq, userNRP, "").Return(map[string][]entity.ReportSchedule{}, int64(0), errors.New("error"))
 
-	result, count, err := mockRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, pagReq, userNRP)
+	result, count, err := mockRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, pagReq, userNRP, "")
 
 	assert.Error(t, err)
 	assert.Empty(t, result)
@@ -0,0 +1,103 @@
+package routes_test
+
+import (
+	"errors"
+	"io"
+	"monitoring-service/controller"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/middleware"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/routes"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newAuthenticatedRouter registers the analytics routes behind the authentication middleware, with
+// a middleware recording the user the services would read from the context
+func newAuthenticatedRouter(verifier service.TokenVerifier, userManagementService service.UserManagementService, seen *dto.User) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(gin.RecoveryWithWriter(io.Discard))
+	router.Use(middleware.Authentication(verifier))
+	router.Use(func(c *gin.Context) {
+		defer func() {
+			if user, ok := c.Value(service.USER_CONTEXT_KEY).(dto.User); ok {
+				*seen = user
+			}
+		}()
+		c.Next()
+	})
+
+	routes.AnalyticsRoutes(router, controller.AnalyticsController{}, userManagementService)
+
+	return router
+}
+
+func serveAnalytics(router *gin.Engine) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, apiBase+"/analytics", nil)
+	request.Header.Set("Authorization", "Bearer test-token")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func TestAuthentication_VerifiedTokenSkipsRemoteLookup(t *testing.T) {
+	principal := dto.Principal{UserID: "admin-id", Email: "admin@its.ac.id", Role: helper.ROLE_ADMIN}
+	verifier := service_mock.NewMockTokenVerifier()
+	verifier.On("Verify", mock.Anything, "Bearer test-token").Return(principal, nil)
+	userManagementService := service_mock.NewMockUserManagementService()
+
+	var seen dto.User
+	recorder := serveAnalytics(newAuthenticatedRouter(verifier, userManagementService, &seen))
+
+	// past the middleware the handler has no service and panics
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, principal.User(), seen)
+	userManagementService.AssertNotCalled(t, "GetUserData", mock.Anything, mock.Anything, mock.Anything)
+	userManagementService.AssertNotCalled(t, "GetUserRole", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAuthentication_VerifiedRoleIsAuthorized(t *testing.T) {
+	verifier := service_mock.NewMockTokenVerifier()
+	verifier.On("Verify", mock.Anything, "Bearer test-token").Return(dto.Principal{UserID: "student-id", Email: "student@its.ac.id", Role: helper.ROLE_STUDENT}, nil)
+
+	var seen dto.User
+	recorder := serveAnalytics(newAuthenticatedRouter(verifier, service_mock.NewMockUserManagementService(), &seen))
+
+	assert.True(t, forbidden(recorder))
+}
+
+func TestAuthentication_FallsBackToRemoteLookup(t *testing.T) {
+	verifier := service_mock.NewMockTokenVerifier()
+	verifier.On("Verify", mock.Anything, "Bearer test-token").Return(dto.Principal{}, helper.ErrRemoteLookupRequired)
+	userManagementService := service_mock.NewMockUserManagementService()
+	userManagementService.On("GetUserData", mock.Anything, "GET", "Bearer test-token").Return(dto.User{ID: "lo-id", Role: helper.ROLE_LO_MBKM, Email: "lo@its.ac.id"}, nil)
+
+	var seen dto.User
+	recorder := serveAnalytics(newAuthenticatedRouter(verifier, userManagementService, &seen))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "lo-id", seen.ID)
+	userManagementService.AssertCalled(t, "GetUserData", mock.Anything, "GET", "Bearer test-token")
+}
+
+func TestAuthentication_InvalidTokenRejected(t *testing.T) {
+	verifier := service_mock.NewMockTokenVerifier()
+	verifier.On("Verify", mock.Anything, "Bearer test-token").Return(dto.Principal{}, errors.Join(helper.ErrInvalidToken, errors.New("token expired")))
+	userManagementService := service_mock.NewMockUserManagementService()
+
+	var seen dto.User
+	recorder := serveAnalytics(newAuthenticatedRouter(verifier, userManagementService, &seen))
+
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.False(t, forbidden(recorder))
+	userManagementService.AssertNotCalled(t, "GetUserData", mock.Anything, mock.Anything, mock.Anything)
+}
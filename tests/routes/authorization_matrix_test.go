@@ -0,0 +1,204 @@
+package routes_test
+
+import (
+	"encoding/json"
+	"io"
+	"monitoring-service/controller"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/routes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	matrixID = "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
+	apiBase  = "/monitoring-service/api/v1"
+)
+
+var (
+	admin   = helper.ROLE_ADMIN
+	loMBKM  = helper.ROLE_LO_MBKM
+	advisor = helper.ROLE_ADVISOR
+	monitor = helper.ROLE_MONITOR
+	student = helper.ROLE_STUDENT
+
+	everyRole = []string{admin, loMBKM, advisor, monitor, student}
+)
+
+type endpoint struct {
+	method string
+	path   string
+	roles  []string
+}
+
+// endpoints lists every route with the roles allowed past its middleware. Services check the
+// resource itself, so an allowed role may still be turned away for a record it is not related to.
+var endpoints = []endpoint{
+	{http.MethodGet, "/reports", []string{admin}},
+	{http.MethodGet, "/reports/report-schedules/" + matrixID + "/reports", everyRole},
+	{http.MethodPost, "/reports/approval/" + matrixID, []string{advisor}},
+	{http.MethodPost, "/reports/approval", []string{advisor}},
+	{http.MethodGet, "/reports/" + matrixID, everyRole},
+	{http.MethodGet, "/reports/" + matrixID + "/revisions", everyRole},
+	{http.MethodGet, "/reports/" + matrixID + "/revisions/diff", everyRole},
+	{http.MethodPost, "/reports", []string{student}},
+	{http.MethodPut, "/reports/" + matrixID, []string{admin, student}},
+	{http.MethodDelete, "/reports/" + matrixID, []string{admin, student}},
+
+	{http.MethodGet, "/report-schedules", []string{admin}},
+	{http.MethodGet, "/report-schedules/student", []string{student}},
+	{http.MethodPost, "/report-schedules/advisor", []string{advisor}},
+	{http.MethodPost, "/report-schedules/monitor", []string{monitor}},
+	{http.MethodGet, "/report-schedules/" + matrixID, everyRole},
+	{http.MethodGet, "/report-schedules/registrations/" + matrixID + "/report-schedules", everyRole},
+	{http.MethodPost, "/report-schedules/registrations/" + matrixID + "/generate", []string{admin, loMBKM, advisor}},
+	{http.MethodPost, "/report-schedules", []string{admin, loMBKM, advisor}},
+	{http.MethodPut, "/report-schedules/" + matrixID, []string{admin, loMBKM, advisor}},
+	{http.MethodDelete, "/report-schedules/" + matrixID, []string{admin, loMBKM}},
+
+	{http.MethodGet, "/syllabuses", []string{admin}},
+	{http.MethodPost, "/syllabuses/advisor", []string{advisor}},
+	{http.MethodGet, "/syllabuses/student", []string{student}},
+	{http.MethodGet, "/syllabuses/registrations/" + matrixID, everyRole},
+	{http.MethodGet, "/syllabuses/registrations/" + matrixID + "/syllabuses", everyRole},
+	{http.MethodGet, "/syllabuses/" + matrixID, everyRole},
+	{http.MethodPost, "/syllabuses", []string{student}},
+	{http.MethodPut, "/syllabuses/" + matrixID, []string{admin, student}},
+	{http.MethodDelete, "/syllabuses/" + matrixID, []string{admin, student}},
+
+	{http.MethodGet, "/transcripts", []string{admin}},
+	{http.MethodPost, "/transcripts/advisor", []string{advisor}},
+	{http.MethodGet, "/transcripts/student", []string{student}},
+	{http.MethodGet, "/transcripts/registrations/" + matrixID, everyRole},
+	{http.MethodGet, "/transcripts/registrations/" + matrixID + "/transcripts", everyRole},
+	{http.MethodGet, "/transcripts/" + matrixID, everyRole},
+	{http.MethodPost, "/transcripts", []string{student}},
+	{http.MethodPut, "/transcripts/" + matrixID, []string{admin, student}},
+	{http.MethodDelete, "/transcripts/" + matrixID, []string{admin, student}},
+
+	{http.MethodGet, "/registrations/" + matrixID + "/progress", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodGet, "/registrations/" + matrixID + "/dossier", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodPost, "/registrations/" + matrixID + "/dossier", []string{admin, loMBKM, advisor}},
+	{http.MethodGet, "/registrations/" + matrixID + "/dossier/file", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodGet, "/dashboard/advisor", []string{advisor}},
+
+	{http.MethodGet, "/analytics", []string{admin, loMBKM}},
+
+	{http.MethodGet, "/exports/report-schedules", []string{admin, loMBKM, advisor}},
+	{http.MethodGet, "/exports/reports", []string{admin, loMBKM, advisor}},
+	{http.MethodGet, "/exports/documents", []string{admin, loMBKM, advisor}},
+
+	{http.MethodGet, "/notifications/failed", []string{admin}},
+	{http.MethodPost, "/notifications/" + matrixID + "/resend", []string{admin}},
+	{http.MethodGet, "/notifications/preferences", []string{advisor}},
+	{http.MethodPut, "/notifications/preferences", []string{advisor}},
+	{http.MethodGet, "/notifications/templates", []string{admin}},
+	{http.MethodPost, "/notifications/templates", []string{admin}},
+	{http.MethodPut, "/notifications/templates/" + matrixID, []string{admin}},
+	{http.MethodDelete, "/notifications/templates/" + matrixID, []string{admin}},
+	{http.MethodGet, "/notifications/locale", []string{admin, loMBKM, advisor, student}},
+	{http.MethodPut, "/notifications/locale", []string{admin, loMBKM, advisor, student}},
+
+	{http.MethodGet, "/audit-events", []string{admin}},
+
+	{http.MethodGet, "/advisor-assignments", []string{admin, loMBKM, advisor}},
+	{http.MethodPost, "/advisor-assignments", []string{admin, loMBKM}},
+	{http.MethodDelete, "/advisor-assignments/" + matrixID, []string{admin, loMBKM}},
+	{http.MethodGet, "/advisor-delegations", []string{admin, loMBKM, advisor}},
+	{http.MethodPost, "/advisor-delegations", []string{admin, loMBKM, advisor}},
+	{http.MethodDelete, "/advisor-delegations/" + matrixID, []string{admin, loMBKM, advisor}},
+
+	{http.MethodGet, "/monitor-assignments", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodPost, "/monitor-assignments", []string{admin, loMBKM}},
+	{http.MethodDelete, "/monitor-assignments/" + matrixID, []string{admin, loMBKM}},
+	{http.MethodGet, "/reports/" + matrixID + "/evaluations", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodPost, "/reports/" + matrixID + "/evaluations", []string{monitor}},
+	{http.MethodGet, "/registrations/" + matrixID + "/monitoring-visits", []string{admin, loMBKM, advisor, monitor}},
+	{http.MethodPost, "/registrations/" + matrixID + "/monitoring-visits", []string{monitor}},
+}
+
+// newMatrixRouter registers every route the way main does. The controllers have no services, a
+// request that makes it past the middleware panics in its handler and is recovered as a 500.
+func newMatrixRouter(role string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	userManagementService := service_mock.NewMockUserManagementService()
+	userManagementService.On("GetUserData", mock.Anything, "GET", mock.Anything).Return(dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		Role:  role,
+		Email: "user@its.ac.id",
+	}, nil)
+
+	router := gin.New()
+	router.Use(gin.RecoveryWithWriter(io.Discard))
+
+	routes.ReportRoutes(router, controller.ReportController{}, userManagementService)
+	routes.ReportScheduleRoutes(router, controller.ReportScheduleController{}, userManagementService)
+	routes.TranscriptRoutes(router, controller.TranscriptController{}, userManagementService)
+	routes.SyllabusRoutes(router, controller.SyllabusController{}, userManagementService)
+	routes.ProgressRoutes(router, controller.ProgressController{}, controller.DossierController{}, userManagementService)
+	routes.AnalyticsRoutes(router, controller.AnalyticsController{}, userManagementService)
+	routes.ExportRoutes(router, controller.ExportController{}, userManagementService)
+	routes.NotificationRoutes(router, controller.NotificationController{}, userManagementService)
+	routes.NotificationTemplateRoutes(router, controller.NotificationTemplateController{}, userManagementService)
+	routes.AuditRoutes(router, controller.AuditController{}, userManagementService)
+	routes.AdvisorAssignmentRoutes(router, controller.AdvisorAssignmentController{}, userManagementService)
+	routes.MonitoringRoutes(router, controller.MonitoringController{}, userManagementService)
+
+	return router
+}
+
+func forbidden(recorder *httptest.ResponseRecorder) bool {
+	if recorder.Code != http.StatusUnauthorized {
+		return false
+	}
+
+	var response dto.Response
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		return false
+	}
+
+	return response.Message == dto.MESSAGE_FORBIDDEN
+}
+
+func TestAuthorizationMatrix(t *testing.T) {
+	for _, role := range everyRole {
+		router := newMatrixRouter(role)
+
+		for _, endpoint := range endpoints {
+			allowed := false
+			for _, allowedRole := range endpoint.roles {
+				if role == allowedRole {
+					allowed = true
+					break
+				}
+			}
+
+			request := httptest.NewRequest(endpoint.method, apiBase+endpoint.path, nil)
+			request.Header.Set("Authorization", "Bearer test-token")
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.NotEqual(t, http.StatusNotFound, recorder.Code, "%s %s is not registered", endpoint.method, endpoint.path)
+			assert.Equal(t, !allowed, forbidden(recorder), "%s %s %s", role, endpoint.method, endpoint.path)
+		}
+	}
+}
+
+func TestAuthorizationMatrix_WithoutToken(t *testing.T) {
+	router := newMatrixRouter(admin)
+
+	for _, endpoint := range endpoints {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(endpoint.method, apiBase+endpoint.path, nil))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code, "%s %s", endpoint.method, endpoint.path)
+	}
+}
@@ -0,0 +1,83 @@
+package routes_test
+
+import (
+	"io"
+	"monitoring-service/controller"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/routes"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newApprovalRouter serves the report routes with a real report service, for an advisor
+func newApprovalRouter() (*gin.Engine, *repository_mock.MockReportRepository) {
+	gin.SetMode(gin.TestMode)
+
+	advisorUser := dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@its.ac.id"}
+	userManagementService := service_mock.NewMockUserManagementService()
+	userManagementService.On("GetUserData", mock.Anything, "GET", mock.Anything).Return(advisorUser, nil)
+	userManagementService.On("CurrentUser", mock.Anything, mock.Anything).Return(advisorUser, nil)
+
+	reportRepo := new(repository_mock.MockReportRepository)
+	reportService := service.NewReportService(
+		reportRepo,
+		new(repository_mock.MockReportScheduleRepository),
+		new(repository_mock.MockReportRevisionRepository),
+		userManagementService,
+		service_mock.NewMockNotificationService(),
+		service_mock.NewMockAdvisorNotificationService(),
+		service_mock.NewMockNotificationTemplateService(),
+		service_mock.NewMockOutboxService(),
+		service.NewAuditService(new(repository_mock.MockAuditEventRepository)),
+		service_mock.NewMockAdvisorAssignmentService(),
+		nil,
+		helper.LatePolicies{},
+	)
+
+	router := gin.New()
+	router.Use(gin.RecoveryWithWriter(io.Discard))
+	routes.ReportRoutes(router, *controller.NewReportController(reportService), userManagementService)
+
+	return router, reportRepo
+}
+
+func TestReportApproval_RejectsStudentStatuses(t *testing.T) {
+	router, reportRepo := newApprovalRouter()
+
+	for _, status := range []string{helper.REPORT_STATUS_PENDING, helper.REPORT_STATUS_RESUBMITTED} {
+		request := httptest.NewRequest(http.MethodPost, apiBase+"/reports/approval/"+matrixID, strings.NewReader(`{"status":"`+status+`"}`))
+		request.Header.Set("Authorization", "Bearer test-token")
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, recorder.Code, status)
+	}
+	reportRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	reportRepo.AssertNotCalled(t, "Approval", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Advisors review reports, they never edit what the student wrote
+func TestReportUpdate_AdvisorIsForbidden(t *testing.T) {
+	router, reportRepo := newApprovalRouter()
+
+	request := httptest.NewRequest(http.MethodPut, apiBase+"/reports/"+matrixID, strings.NewReader("title=Rewritten&content=Rewritten&report_type=WEEKLY_REPORT"))
+	request.Header.Set("Authorization", "Bearer test-token")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, request)
+
+	assert.True(t, forbidden(recorder), recorder.Body.String())
+	reportRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything, mock.Anything)
+	reportRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
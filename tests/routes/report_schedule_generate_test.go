@@ -0,0 +1,72 @@
+package routes_test
+
+import (
+	"io"
+	"monitoring-service/controller"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/routes"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// A window the client got wrong is a bad request, not a server error
+func TestReportScheduleGenerate_InvalidDatesAreBadRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	adminUser := dto.User{ID: "admin-id", Role: helper.ROLE_ADMIN, Email: "admin@its.ac.id"}
+	userManagementService := service_mock.NewMockUserManagementService()
+	userManagementService.On("GetUserData", mock.Anything, "GET", mock.Anything).Return(adminUser, nil)
+	userManagementService.On("CurrentUser", mock.Anything, mock.Anything).Return(adminUser, nil)
+
+	registrationService := service_mock.NewMockRegistrationService()
+	registrationService.On("GetRegistrationByID", mock.Anything, "GET", matrixID, mock.Anything).Return(dto.Registration{
+		ID:                   matrixID,
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		AcademicAdvisorEmail: "advisor@its.ac.id",
+	}, nil)
+
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+	advisorAssignments.On("Authorize", mock.Anything, adminUser, helper.POLICY_ACTION_CREATE, mock.Anything).Return(nil)
+
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	reportScheduleService := service.NewReportScheduleService(
+		reportScheduleRepo,
+		userManagementService,
+		registrationService,
+		service_mock.NewMockOutboxService(),
+		service.NewAuditService(new(repository_mock.MockAuditEventRepository)),
+		advisorAssignments,
+		helper.ReportScheduleConfig{MaxWeeks: 52},
+	)
+
+	router := gin.New()
+	router.Use(gin.RecoveryWithWriter(io.Discard))
+	routes.ReportScheduleRoutes(router, *controller.NewReportScheduleController(reportScheduleService), userManagementService)
+
+	for name, body := range map[string]string{
+		"unparsable start":   `{"start_date":"2025-02-03","end_date":"2025-05-31T23:59:59Z","cadence":"WEEKLY"}`,
+		"unparsable end":     `{"start_date":"2025-02-03T00:00:00Z","end_date":"31/05/2025","cadence":"WEEKLY"}`,
+		"end before start":   `{"start_date":"2025-05-31T00:00:00Z","end_date":"2025-02-03T00:00:00Z","cadence":"WEEKLY"}`,
+		"longer than a year": `{"start_date":"1970-01-01T00:00:00Z","end_date":"2100-12-31T23:59:59Z","cadence":"WEEKLY"}`,
+	} {
+		request := httptest.NewRequest(http.MethodPost, apiBase+"/report-schedules/registrations/"+matrixID+"/generate", strings.NewReader(body))
+		request.Header.Set("Authorization", "Bearer test-access-token")
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code, name)
+	}
+	reportScheduleRepo.AssertNotCalled(t, "CreateForRegistration", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,278 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/repository"
+	"monitoring-service/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const assignmentRegistrationID = "9b1f0f2e-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+
+var (
+	assignmentAdvisor   = dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@its.ac.id"}
+	assignmentCoAdvisor = dto.User{ID: "co-advisor-id", Role: helper.ROLE_ADVISOR, Email: "co-advisor@its.ac.id"}
+	assignmentDelegate  = dto.User{ID: "delegate-id", Role: helper.ROLE_ADVISOR, Email: "delegate@its.ac.id"}
+	assignmentLOMBKM    = dto.User{ID: "lo-id", Role: helper.ROLE_LO_MBKM, Email: "lo@its.ac.id"}
+	assignmentMonitor   = dto.User{ID: "monitor-id", Role: helper.ROLE_MONITOR, Email: "monitor@its.ac.id"}
+)
+
+type advisorAssignmentFixture struct {
+	advisorAssignmentRepo *repository_mock.MockAdvisorAssignmentRepository
+	monitoringRepo        *repository_mock.MockMonitoringRepository
+	userManagementService *service_mock.MockUserManagementService
+	registrationService   *service_mock.MockRegistrationService
+	outboxService         *service_mock.MockOutboxService
+	auditEventRepo        *repository_mock.MockAuditEventRepository
+	service               service.AdvisorAssignmentService
+}
+
+func newAdvisorAssignmentFixture() advisorAssignmentFixture {
+	fixture := advisorAssignmentFixture{
+		advisorAssignmentRepo: new(repository_mock.MockAdvisorAssignmentRepository),
+		monitoringRepo:        new(repository_mock.MockMonitoringRepository),
+		userManagementService: service_mock.NewMockUserManagementService(),
+		registrationService:   service_mock.NewMockRegistrationService(),
+		outboxService:         service_mock.NewMockOutboxService(),
+		auditEventRepo:        new(repository_mock.MockAuditEventRepository),
+	}
+	fixture.outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	fixture.auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	fixture.registrationService.On("GetRegistrationByID", mock.Anything, "GET", assignmentRegistrationID, mock.Anything).Return(dto.Registration{
+		ID:                   assignmentRegistrationID,
+		AcademicAdvisorEmail: assignmentAdvisor.Email,
+	}, nil)
+	fixture.service = service.NewAdvisorAssignmentService(
+		fixture.advisorAssignmentRepo,
+		fixture.monitoringRepo,
+		fixture.userManagementService,
+		fixture.registrationService,
+		fixture.outboxService,
+		service.NewAuditService(fixture.auditEventRepo),
+	)
+
+	return fixture
+}
+
+// assignmentResource is a report of the registration, naming assignmentAdvisor as its advisor
+func assignmentResource() helper.PolicyResource {
+	return helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_REPORT,
+		RegistrationID: assignmentRegistrationID,
+		OwnerID:        "student-id",
+		AdvisorEmail:   assignmentAdvisor.Email,
+	}
+}
+
+func TestAdvisorAssignmentService_AuthorizeNamedAdvisorWithoutLookup(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+
+	err := fixture.service.Authorize(context.Background(), assignmentAdvisor, helper.POLICY_ACTION_REVIEW, assignmentResource())
+
+	assert.NoError(t, err)
+	fixture.advisorAssignmentRepo.AssertNotCalled(t, "FindByRegistrationID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorAssignmentService_AuthorizeCoAdvisor(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.advisorAssignmentRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.AdvisorAssignment{
+		{RegistrationID: assignmentRegistrationID, AdvisorEmail: assignmentAdvisor.Email, Role: helper.ADVISOR_ROLE_PRIMARY},
+		{RegistrationID: assignmentRegistrationID, AdvisorEmail: assignmentCoAdvisor.Email, Role: helper.ADVISOR_ROLE_SECONDARY},
+	}, nil)
+	fixture.advisorAssignmentRepo.On("FindDelegations", mock.Anything, mock.Anything, mock.Anything).Return([]entity.AdvisorDelegation{}, nil)
+
+	err := fixture.service.Authorize(context.Background(), assignmentCoAdvisor, helper.POLICY_ACTION_REVIEW, assignmentResource())
+	assert.NoError(t, err)
+
+	err = fixture.service.Authorize(context.Background(), assignmentDelegate, helper.POLICY_ACTION_REVIEW, assignmentResource())
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+}
+
+// A delegate covers the report reviews of an advisor on leave and nothing else of its registrations
+func TestAdvisorAssignmentService_AuthorizeDelegateOnlyForReports(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.advisorAssignmentRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.AdvisorAssignment{
+		{RegistrationID: assignmentRegistrationID, AdvisorEmail: assignmentCoAdvisor.Email, Role: helper.ADVISOR_ROLE_SECONDARY},
+	}, nil)
+	fixture.advisorAssignmentRepo.On("FindDelegations", mock.Anything, mock.Anything, mock.Anything).Return([]entity.AdvisorDelegation{
+		{DelegatorEmail: assignmentAdvisor.Email, DelegateEmail: assignmentDelegate.Email},
+	}, nil)
+
+	for _, action := range []string{helper.POLICY_ACTION_VIEW, helper.POLICY_ACTION_REVIEW} {
+		err := fixture.service.Authorize(context.Background(), assignmentDelegate, action, assignmentResource())
+		assert.NoError(t, err, action)
+	}
+
+	for kind, action := range map[string]string{
+		helper.POLICY_RESOURCE_REPORT_SCHEDULE: helper.POLICY_ACTION_UPDATE,
+		helper.POLICY_RESOURCE_DOSSIER:         helper.POLICY_ACTION_VIEW,
+		helper.POLICY_RESOURCE_SYLLABUS:        helper.POLICY_ACTION_VIEW,
+	} {
+		resource := assignmentResource()
+		resource.Kind = kind
+
+		err := fixture.service.Authorize(context.Background(), assignmentDelegate, action, resource)
+		assert.ErrorIs(t, err, helper.ErrForbidden, kind)
+
+		// the co-advisor keeps the full scope of an advisor
+		err = fixture.service.Authorize(context.Background(), assignmentCoAdvisor, action, resource)
+		assert.NoError(t, err, kind)
+	}
+	fixture.advisorAssignmentRepo.AssertNumberOfCalls(t, "FindDelegations", 2)
+}
+
+func TestAdvisorAssignmentService_AuthorizeMonitor(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.monitoringRepo.On("FindAssignmentsByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.MonitorAssignment{
+		{RegistrationID: assignmentRegistrationID, MonitorEmail: assignmentMonitor.Email},
+	}, nil)
+
+	err := fixture.service.Authorize(context.Background(), assignmentMonitor, helper.POLICY_ACTION_VIEW, assignmentResource())
+	assert.NoError(t, err)
+
+	err = fixture.service.Authorize(context.Background(), assignmentMonitor, helper.POLICY_ACTION_REVIEW, assignmentResource())
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+
+	otherMonitor := dto.User{ID: "other-monitor-id", Role: helper.ROLE_MONITOR, Email: "other-monitor@its.ac.id"}
+	err = fixture.service.Authorize(context.Background(), otherMonitor, helper.POLICY_ACTION_VIEW, assignmentResource())
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+	fixture.advisorAssignmentRepo.AssertNotCalled(t, "FindByRegistrationID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorAssignmentService_ReviewersIncludeActiveDelegates(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.advisorAssignmentRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.AdvisorAssignment{
+		{RegistrationID: assignmentRegistrationID, AdvisorEmail: assignmentAdvisor.Email, Role: helper.ADVISOR_ROLE_PRIMARY},
+		{RegistrationID: assignmentRegistrationID, AdvisorEmail: assignmentCoAdvisor.Email, Role: helper.ADVISOR_ROLE_SECONDARY},
+	}, nil)
+	fixture.advisorAssignmentRepo.On("FindDelegations", mock.Anything, mock.MatchedBy(func(filter repository.AdvisorDelegationFilter) bool {
+		return assert.ObjectsAreEqual([]string{assignmentAdvisor.Email, assignmentCoAdvisor.Email}, filter.DelegatorEmails) && filter.ActiveAt != nil
+	}), mock.Anything).Return([]entity.AdvisorDelegation{
+		{DelegatorEmail: assignmentAdvisor.Email, DelegateEmail: assignmentDelegate.Email},
+	}, nil)
+
+	reviewers, err := fixture.service.Reviewers(context.Background(), assignmentRegistrationID, assignmentAdvisor.Email, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{assignmentAdvisor.Email, assignmentCoAdvisor.Email, assignmentDelegate.Email}, reviewers)
+}
+
+func TestAdvisorAssignmentService_ReviewersWithoutAdvisor(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.advisorAssignmentRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.AdvisorAssignment{}, nil)
+
+	reviewers, err := fixture.service.Reviewers(context.Background(), assignmentRegistrationID, "", nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, reviewers)
+	fixture.advisorAssignmentRepo.AssertNotCalled(t, "FindDelegations", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorAssignmentService_AssignSecondaryAdvisor(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentLOMBKM, nil)
+	fixture.advisorAssignmentRepo.On("FindByRegistrationIDAndAdvisorEmail", mock.Anything, assignmentRegistrationID, assignmentCoAdvisor.Email, mock.Anything).Return(entity.AdvisorAssignment{}, gorm.ErrRecordNotFound)
+	fixture.advisorAssignmentRepo.On("Create", mock.Anything, mock.MatchedBy(func(assignment entity.AdvisorAssignment) bool {
+		return assignment.Role == helper.ADVISOR_ROLE_SECONDARY && assignment.AssignedBy == assignmentLOMBKM.Email
+	}), mock.Anything).Return(entity.AdvisorAssignment{
+		ID:             uuid.New(),
+		RegistrationID: assignmentRegistrationID,
+		AdvisorEmail:   assignmentCoAdvisor.Email,
+		Role:           helper.ADVISOR_ROLE_SECONDARY,
+	}, nil)
+
+	assignment, err := fixture.service.Assign(context.Background(), dto.AdvisorAssignmentRequest{
+		RegistrationID: assignmentRegistrationID,
+		AdvisorEmail:   assignmentCoAdvisor.Email,
+	}, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, helper.ADVISOR_ROLE_SECONDARY, assignment.Role)
+	fixture.auditEventRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestAdvisorAssignmentService_AssignRejectsPrimaryAndDuplicates(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentLOMBKM, nil)
+	fixture.advisorAssignmentRepo.On("FindByRegistrationIDAndAdvisorEmail", mock.Anything, assignmentRegistrationID, assignmentCoAdvisor.Email, mock.Anything).Return(entity.AdvisorAssignment{Role: helper.ADVISOR_ROLE_SECONDARY}, nil)
+
+	_, err := fixture.service.Assign(context.Background(), dto.AdvisorAssignmentRequest{
+		RegistrationID: assignmentRegistrationID,
+		AdvisorEmail:   assignmentCoAdvisor.Email,
+		Role:           helper.ADVISOR_ROLE_PRIMARY,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrInvalidAdvisorAssignment)
+
+	_, err = fixture.service.Assign(context.Background(), dto.AdvisorAssignmentRequest{
+		RegistrationID: assignmentRegistrationID,
+		AdvisorEmail:   assignmentAdvisor.Email,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrAdvisorAssignmentExists)
+
+	_, err = fixture.service.Assign(context.Background(), dto.AdvisorAssignmentRequest{
+		RegistrationID: assignmentRegistrationID,
+		AdvisorEmail:   assignmentCoAdvisor.Email,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrAdvisorAssignmentExists)
+	fixture.advisorAssignmentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorAssignmentService_AdvisorDelegatesOwnReviews(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentAdvisor, nil)
+	var created entity.AdvisorDelegation
+	fixture.advisorAssignmentRepo.On("CreateDelegation", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			created = args.Get(1).(entity.AdvisorDelegation)
+		}).
+		Return(entity.AdvisorDelegation{}, nil)
+
+	startDate := time.Now().Format("2006-01-02")
+	endDate := time.Now().AddDate(0, 0, 14).Format("2006-01-02")
+
+	_, err := fixture.service.Delegate(context.Background(), dto.AdvisorDelegationRequest{
+		DelegateEmail: assignmentDelegate.Email,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Reason:        "Sabbatical",
+	}, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, assignmentAdvisor.Email, created.DelegatorEmail)
+	assert.Equal(t, assignmentDelegate.Email, created.DelegateEmail)
+	assert.True(t, helper.DelegationActive(*created.StartsAt, *created.EndsAt, time.Now()))
+
+	_, err = fixture.service.Delegate(context.Background(), dto.AdvisorDelegationRequest{
+		DelegatorEmail: assignmentCoAdvisor.Email,
+		DelegateEmail:  assignmentDelegate.Email,
+		StartDate:      startDate,
+		EndDate:        endDate,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+}
+
+func TestAdvisorAssignmentService_DelegateRejectsPastPeriod(t *testing.T) {
+	fixture := newAdvisorAssignmentFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentLOMBKM, nil)
+
+	_, err := fixture.service.Delegate(context.Background(), dto.AdvisorDelegationRequest{
+		DelegatorEmail: assignmentAdvisor.Email,
+		DelegateEmail:  assignmentDelegate.Email,
+		StartDate:      "2020-01-01",
+		EndDate:        "2020-01-31",
+	}, "token")
+
+	assert.ErrorIs(t, err, helper.ErrInvalidAdvisorDelegation)
+	fixture.advisorAssignmentRepo.AssertNotCalled(t, "CreateDelegation", mock.Anything, mock.Anything, mock.Anything)
+}
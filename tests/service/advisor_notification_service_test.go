@@ -0,0 +1,201 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type advisorNotificationFixture struct {
+	baseRepo                *repository_mock.MockBaseRepository
+	advisorNotificationRepo *repository_mock.MockAdvisorNotificationRepository
+	notificationService     *service_mock.MockNotificationService
+	templateRepo            *repository_mock.MockNotificationTemplateRepository
+	userManagementService   *service_mock.MockUserManagementService
+	advisorAssignments      *service_mock.MockAdvisorAssignmentService
+	service                 service.AdvisorNotificationService
+}
+
+func newAdvisorNotificationFixture(config helper.AdvisorNotificationConfig) advisorNotificationFixture {
+	fixture := advisorNotificationFixture{
+		baseRepo:                new(repository_mock.MockBaseRepository),
+		advisorNotificationRepo: new(repository_mock.MockAdvisorNotificationRepository),
+		notificationService:     service_mock.NewMockNotificationService(),
+		templateRepo:            new(repository_mock.MockNotificationTemplateRepository),
+		userManagementService:   service_mock.NewMockUserManagementService(),
+		advisorAssignments:      service_mock.NewMockAdvisorAssignmentService(),
+	}
+	fixture.baseRepo.On("WithinTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	fixture.advisorAssignments.On("Reviewers", mock.Anything, "registration-id", "advisor@example.com", mock.Anything).Return([]string{"advisor@example.com"}, nil)
+	// no stored templates or locales, notifications render from the embedded English defaults
+	fixture.templateRepo.On("FindLocale", mock.Anything, mock.Anything, mock.Anything).Return(entity.UserLocalePreference{}, gorm.ErrRecordNotFound)
+	fixture.templateRepo.On("FindByTypeAndLocale", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+	templateService := service.NewNotificationTemplateService(fixture.templateRepo, fixture.userManagementService, helper.NotificationTemplateConfig{
+		DefaultLocale: helper.LOCALE_EN,
+		LinkBaseURL:   "https://monitoring.example.com",
+	})
+	fixture.service = service.NewAdvisorNotificationService(fixture.baseRepo, fixture.advisorNotificationRepo, fixture.notificationService, templateService, fixture.userManagementService, fixture.advisorAssignments, config)
+
+	return fixture
+}
+
+var submissionNotification = dto.NotificationRequest{
+	SenderName:    "Student",
+	SenderEmail:   "student@example.com",
+	ReceiverEmail: "advisor@example.com",
+	Type:          helper.ADVISOR_NOTIFICATION_REPORT_SUBMITTED,
+}
+
+var submissionData = dto.NotificationTemplateData{
+	StudentName:  "Student",
+	StudentNRP:   "5025211000",
+	ActivityName: "Internship",
+	ReportType:   "WEEKLY_REPORT",
+	Week:         1,
+	Link:         "/reports/report-id",
+}
+
+const submissionMessage = "Student (5025211000) submitted the week 1 report (WEEKLY_REPORT) for Internship.\nReview the report: https://monitoring.example.com/reports/report-id"
+
+func TestAdvisorNotificationService_NotifySubmissionRightAway(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	tx := &gorm.DB{}
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "advisor@example.com", tx).Return(entity.AdvisorNotificationPreference{}, gorm.ErrRecordNotFound)
+	rendered := submissionNotification
+	rendered.Subject = "New report from Student"
+	rendered.Message = submissionMessage
+	fixture.notificationService.On("Enqueue", mock.Anything, tx, []dto.NotificationRequest{rendered}).Return(nil)
+
+	err := fixture.service.NotifySubmission(context.Background(), tx, "registration-id", submissionNotification, submissionData)
+
+	assert.NoError(t, err)
+	fixture.notificationService.AssertExpectations(t)
+	fixture.advisorNotificationRepo.AssertNotCalled(t, "CreateDigestEntry", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorNotificationService_NotifySubmissionHeldForDigest(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	tx := &gorm.DB{}
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "advisor@example.com", tx).Return(entity.AdvisorNotificationPreference{AdvisorEmail: "advisor@example.com", DailyDigest: true}, nil)
+	fixture.advisorNotificationRepo.On("CreateDigestEntry", mock.Anything, mock.MatchedBy(func(entry entity.AdvisorDigestEntry) bool {
+		return entry.AdvisorEmail == "advisor@example.com" && entry.Message == submissionMessage && entry.DigestedAt == nil
+	}), tx).Return(nil)
+
+	err := fixture.service.NotifySubmission(context.Background(), tx, "registration-id", submissionNotification, submissionData)
+
+	assert.NoError(t, err)
+	fixture.advisorNotificationRepo.AssertExpectations(t)
+	fixture.notificationService.AssertNotCalled(t, "Enqueue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorNotificationService_NotifySubmissionUsesDefaultDigest(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{DefaultDailyDigest: true})
+
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "advisor@example.com", mock.Anything).Return(entity.AdvisorNotificationPreference{}, gorm.ErrRecordNotFound)
+	fixture.advisorNotificationRepo.On("CreateDigestEntry", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	err := fixture.service.NotifySubmission(context.Background(), nil, "registration-id", submissionNotification, submissionData)
+
+	assert.NoError(t, err)
+	fixture.advisorNotificationRepo.AssertExpectations(t)
+}
+
+func TestAdvisorNotificationService_NotifySubmissionWithoutAdvisor(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	notification := submissionNotification
+	notification.ReceiverEmail = ""
+	fixture.advisorAssignments.On("Reviewers", mock.Anything, "unassigned-id", "", mock.Anything).Return(nil, nil)
+
+	err := fixture.service.NotifySubmission(context.Background(), nil, "unassigned-id", notification, submissionData)
+
+	assert.NoError(t, err)
+	fixture.advisorNotificationRepo.AssertNotCalled(t, "FindPreference", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdvisorNotificationService_NotifySubmissionToEveryReviewer(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	tx := &gorm.DB{}
+	fixture.advisorAssignments.On("Reviewers", mock.Anything, "co-advised-id", "advisor@example.com", tx).Return([]string{"advisor@example.com", "co-advisor@example.com", "delegate@example.com"}, nil)
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "advisor@example.com", tx).Return(entity.AdvisorNotificationPreference{}, gorm.ErrRecordNotFound)
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "co-advisor@example.com", tx).Return(entity.AdvisorNotificationPreference{}, gorm.ErrRecordNotFound)
+	fixture.advisorNotificationRepo.On("FindPreference", mock.Anything, "delegate@example.com", tx).Return(entity.AdvisorNotificationPreference{AdvisorEmail: "delegate@example.com", DailyDigest: true}, nil)
+	var receivers []string
+	fixture.notificationService.On("Enqueue", mock.Anything, tx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		for _, notification := range args.Get(2).([]dto.NotificationRequest) {
+			receivers = append(receivers, notification.ReceiverEmail)
+		}
+	})
+	fixture.advisorNotificationRepo.On("CreateDigestEntry", mock.Anything, mock.MatchedBy(func(entry entity.AdvisorDigestEntry) bool {
+		return entry.AdvisorEmail == "delegate@example.com" && entry.Message == submissionMessage
+	}), tx).Return(nil)
+
+	err := fixture.service.NotifySubmission(context.Background(), tx, "co-advised-id", submissionNotification, submissionData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"advisor@example.com", "co-advisor@example.com"}, receivers)
+	fixture.advisorNotificationRepo.AssertExpectations(t)
+}
+
+func TestAdvisorNotificationService_SendDigestsOnePerAdvisor(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	entries := []entity.AdvisorDigestEntry{
+		{ID: uuid.New(), AdvisorEmail: "first@example.com", Message: "report week 1"},
+		{ID: uuid.New(), AdvisorEmail: "first@example.com", Message: "syllabus"},
+		{ID: uuid.New(), AdvisorEmail: "second@example.com", Message: "transcript"},
+	}
+	fixture.advisorNotificationRepo.On("FindUndigestedEntries", mock.Anything, mock.Anything).Return(entries, nil)
+
+	var queued []dto.NotificationRequest
+	fixture.notificationService.On("Enqueue", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			queued = args.Get(2).([]dto.NotificationRequest)
+		}).
+		Return(nil)
+	fixture.advisorNotificationRepo.On("MarkDigested", mock.Anything, []string{entries[0].ID.String(), entries[1].ID.String(), entries[2].ID.String()}, mock.Anything, mock.Anything).Return(nil)
+
+	sent, err := fixture.service.SendDigests(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	assert.Len(t, queued, 2)
+	assert.Equal(t, "first@example.com", queued[0].ReceiverEmail)
+	assert.Equal(t, helper.ADVISOR_NOTIFICATION_SUBMISSION_DIGEST, queued[0].Type)
+	assert.Equal(t, "2 new submissions to review", queued[0].Subject)
+	assert.True(t, strings.HasPrefix(queued[0].Message, "2 new submissions are waiting for your review:"))
+	assert.Contains(t, queued[0].Message, "- syllabus")
+	assert.Equal(t, "second@example.com", queued[1].ReceiverEmail)
+	fixture.advisorNotificationRepo.AssertExpectations(t)
+}
+
+func TestAdvisorNotificationService_UpdatePreference(t *testing.T) {
+	fixture := newAdvisorNotificationFixture(helper.AdvisorNotificationConfig{})
+
+	token := "Bearer advisor-token"
+	fixture.userManagementService.On("CurrentUser", mock.Anything, token).Return(dto.User{Email: "advisor@example.com"}, nil)
+	fixture.advisorNotificationRepo.On("SavePreference", mock.Anything, mock.MatchedBy(func(preference entity.AdvisorNotificationPreference) bool {
+		return preference.AdvisorEmail == "advisor@example.com" && preference.DailyDigest
+	}), mock.Anything).Return(nil)
+
+	dailyDigest := true
+	preference, err := fixture.service.UpdatePreference(context.Background(), token, dto.AdvisorNotificationPreferenceRequest{DailyDigest: &dailyDigest})
+
+	assert.NoError(t, err)
+	assert.True(t, preference.DailyDigest)
+	fixture.advisorNotificationRepo.AssertExpectations(t)
+}
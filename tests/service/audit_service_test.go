@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	"monitoring-service/repository"
+	"monitoring-service/service"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+var auditActor = dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@its.ac.id"}
+
+func TestAuditService_RecordSnapshotsChange(t *testing.T) {
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	auditService := service.NewAuditService(auditEventRepo)
+
+	tx := &gorm.DB{}
+	var recorded []entity.AuditEvent
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, tx).
+		Run(func(args mock.Arguments) {
+			recorded = args.Get(1).([]entity.AuditEvent)
+		}).
+		Return(nil)
+
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Set(helper.REQUEST_ID_CONTEXT_KEY, "req-1")
+	ctx.Set(helper.CLIENT_IP_CONTEXT_KEY, "10.0.0.7")
+
+	reportID := uuid.New()
+	before := entity.Report{ID: reportID, AcademicAdvisorStatus: helper.REPORT_STATUS_PENDING}
+	after := entity.Report{ID: reportID, AcademicAdvisorStatus: helper.REPORT_STATUS_APPROVED}
+
+	err := auditService.Record(ctx, tx, auditActor, helper.AUDIT_ACTION_REVIEW, helper.POLICY_RESOURCE_REPORT, reportID.String(), before, after)
+
+	assert.NoError(t, err)
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, auditActor.ID, recorded[0].ActorID)
+	assert.Equal(t, auditActor.Role, recorded[0].ActorRole)
+	assert.Equal(t, auditActor.Email, recorded[0].ActorEmail)
+	assert.Equal(t, helper.AUDIT_ACTION_REVIEW, recorded[0].Action)
+	assert.Equal(t, helper.POLICY_RESOURCE_REPORT, recorded[0].ResourceType)
+	assert.Equal(t, reportID.String(), recorded[0].ResourceID)
+	assert.Equal(t, "req-1", recorded[0].RequestID)
+	assert.Equal(t, "10.0.0.7", recorded[0].IPAddress)
+	assert.NotNil(t, recorded[0].OccurredAt)
+
+	var beforeSnapshot, afterSnapshot entity.Report
+	assert.NoError(t, json.Unmarshal([]byte(*recorded[0].Before), &beforeSnapshot))
+	assert.NoError(t, json.Unmarshal([]byte(*recorded[0].After), &afterSnapshot))
+	assert.Equal(t, helper.REPORT_STATUS_PENDING, beforeSnapshot.AcademicAdvisorStatus)
+	assert.Equal(t, helper.REPORT_STATUS_APPROVED, afterSnapshot.AcademicAdvisorStatus)
+}
+
+func TestAuditService_RecordCreationHasNoBefore(t *testing.T) {
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	auditService := service.NewAuditService(auditEventRepo)
+
+	var recorded []entity.AuditEvent
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			recorded = args.Get(1).([]entity.AuditEvent)
+		}).
+		Return(nil)
+
+	// outside a request there is no request ID or IP address to record
+	err := auditService.Record(context.Background(), nil, auditActor, helper.AUDIT_ACTION_CREATE, helper.POLICY_RESOURCE_SYLLABUS, "syllabus-1", nil, entity.Syllabus{Title: "Syllabus"})
+
+	assert.NoError(t, err)
+	assert.Nil(t, recorded[0].Before)
+	assert.NotNil(t, recorded[0].After)
+	assert.Empty(t, recorded[0].RequestID)
+	assert.Empty(t, recorded[0].IPAddress)
+}
+
+func TestAuditService_RecordFailsTheChange(t *testing.T) {
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	auditService := service.NewAuditService(auditEventRepo)
+
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("database error"))
+
+	err := auditService.Record(context.Background(), &gorm.DB{}, auditActor, helper.AUDIT_ACTION_DELETE, helper.POLICY_RESOURCE_TRANSCRIPT, "transcript-1", entity.Transcript{}, nil)
+
+	assert.EqualError(t, err, "database error")
+}
+
+func TestAuditService_FindAllFilters(t *testing.T) {
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	auditService := service.NewAuditService(auditEventRepo)
+
+	before := `{"academic_advisor_status":"PENDING"}`
+	occurredAt := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	pagReq := dto.PaginationRequest{Offset: 0, Limit: 10}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	auditEventRepo.On("FindAll", mock.Anything, mock.MatchedBy(func(filter repository.AuditEventFilter) bool {
+		return filter.ResourceType == helper.POLICY_RESOURCE_REPORT &&
+			filter.ResourceID == "report-1" &&
+			filter.ActorEmail == "advisor@its.ac.id" &&
+			filter.From != nil && filter.From.Equal(from) &&
+			filter.To != nil && filter.To.Equal(to)
+	}), &pagReq, mock.Anything).Return([]entity.AuditEvent{{
+		ID:           uuid.New(),
+		ActorEmail:   "advisor@its.ac.id",
+		Action:       helper.AUDIT_ACTION_REVIEW,
+		ResourceType: helper.POLICY_RESOURCE_REPORT,
+		ResourceID:   "report-1",
+		Before:       &before,
+		OccurredAt:   &occurredAt,
+	}}, int64(1), nil)
+
+	auditEvents, metaData, err := auditService.FindAll(context.Background(), dto.AuditEventFilterRequest{
+		ResourceType: helper.POLICY_RESOURCE_REPORT,
+		ResourceID:   "report-1",
+		ActorEmail:   "advisor@its.ac.id",
+		StartDate:    "2026-03-01",
+		EndDate:      "2026-03-07",
+	}, pagReq)
+
+	assert.NoError(t, err)
+	assert.Len(t, auditEvents, 1)
+	assert.JSONEq(t, before, string(auditEvents[0].Before))
+	assert.Nil(t, auditEvents[0].After)
+	assert.Equal(t, int64(1), metaData.Total)
+
+	data, err := json.Marshal(auditEvents[0])
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"after":null`)
+}
+
+func TestAuditService_FindAllInvalidDateRange(t *testing.T) {
+	auditService := service.NewAuditService(new(repository_mock.MockAuditEventRepository))
+
+	_, _, err := auditService.FindAll(context.Background(), dto.AuditEventFilterRequest{
+		StartDate: "2026-03-07",
+		EndDate:   "2026-03-01",
+	}, dto.PaginationRequest{Limit: 10})
+
+	assert.True(t, errors.Is(err, helper.ErrInvalidDateRange))
+}
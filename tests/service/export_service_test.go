@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/repository"
+	"monitoring-service/service"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// An advisor exports every registration they advise, and student names are resolved before the rows are read
+func TestExportService_AdvisorExportsAdvisedRegistrations(t *testing.T) {
+	advisor := dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "co-advisor@example.com"}
+	exportFilter := repository.ExportFilter{AdvisedBy: advisor.Email, UserNRP: "5025211111"}
+
+	exportRepo := new(repository_mock.MockExportRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(advisor, nil)
+	exportRepo.On("FindUnnamedUserIDs", mock.Anything, exportFilter, mock.Anything).Return([]string{"unsynced-student-id"}, nil)
+	userManagementService.On("GetUserByID", mock.Anything, "GET", "token", "unsynced-student-id").Return(dto.User{Name: "Budi"}, nil).Once()
+	exportRepo.On("StreamDocuments", mock.Anything, exportFilter, mock.Anything).Return([]repository.ExportDocumentRow{
+		{RegistrationID: "synced", UserID: "synced-student-id", UserNRP: "5025211111", UserName: "Ani"},
+		{RegistrationID: "unsynced", UserID: "unsynced-student-id", UserNRP: "5025211111"},
+	}, nil)
+
+	var output bytes.Buffer
+	writer, err := helper.NewTableWriter(helper.EXPORT_FORMAT_CSV, &output, "Documents")
+	assert.NoError(t, err)
+
+	exportService := service.NewExportService(exportRepo, userManagementService)
+	err = exportService.ExportDocuments(context.Background(), "token", dto.ExportFilterRequest{UserNRP: "5025211111"}, writer)
+
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), "5025211111,Ani,")
+	assert.Contains(t, output.String(), "5025211111,Budi,")
+	exportRepo.AssertExpectations(t)
+	userManagementService.AssertNotCalled(t, "GetUserByID", mock.Anything, "GET", "token", "synced-student-id")
+}
+
+// Students without a snapshot are looked up concurrently, never more than the bound at once
+func TestExportService_StudentNamesLookedUpConcurrently(t *testing.T) {
+	admin := dto.User{ID: "admin-id", Role: helper.ROLE_ADMIN}
+	exportFilter := repository.ExportFilter{}
+
+	exportRepo := new(repository_mock.MockExportRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+
+	var mu sync.Mutex
+	inFlight, peak := 0, 0
+	lookup := func(mock.Arguments) {
+		mu.Lock()
+		inFlight++
+		peak = max(peak, inFlight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}
+
+	var userIDs []string
+	var rows []repository.ExportDocumentRow
+	for i := 0; i < 3*service.EXPORT_NAME_LOOKUP_CONCURRENCY; i++ {
+		userID := "student-" + strconv.Itoa(i)
+		userIDs = append(userIDs, userID)
+		rows = append(rows, repository.ExportDocumentRow{RegistrationID: "registration-" + strconv.Itoa(i), UserID: userID, UserNRP: "50252" + strconv.Itoa(i)})
+		userManagementService.On("GetUserByID", mock.Anything, "GET", "token", userID).Return(dto.User{Name: "Name " + strconv.Itoa(i)}, nil).Run(lookup).Once()
+	}
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(admin, nil)
+	exportRepo.On("FindUnnamedUserIDs", mock.Anything, exportFilter, mock.Anything).Return(userIDs, nil)
+	exportRepo.On("StreamDocuments", mock.Anything, exportFilter, mock.Anything).Return(rows, nil)
+
+	var output bytes.Buffer
+	writer, err := helper.NewTableWriter(helper.EXPORT_FORMAT_CSV, &output, "Documents")
+	assert.NoError(t, err)
+
+	exportService := service.NewExportService(exportRepo, userManagementService)
+	err = exportService.ExportDocuments(context.Background(), "token", dto.ExportFilterRequest{}, writer)
+
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), ",Name 29,")
+	userManagementService.AssertExpectations(t)
+	assert.Greater(t, peak, 1)
+	assert.LessOrEqual(t, peak, service.EXPORT_NAME_LOOKUP_CONCURRENCY)
+}
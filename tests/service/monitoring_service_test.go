@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+const (
+	monitoringReportID         = "0f6b8a3e-1d2c-4b5a-9e8f-7a6b5c4d3e2f"
+	monitoringReportScheduleID = "5a4b3c2d-1e0f-4a9b-8c7d-6e5f4a3b2c1d"
+)
+
+type monitoringFixture struct {
+	monitoringRepo        *repository_mock.MockMonitoringRepository
+	reportRepo            *repository_mock.MockReportRepository
+	reportScheduleRepo    *repository_mock.MockReportScheduleRepository
+	userManagementService *service_mock.MockUserManagementService
+	registrationService   *service_mock.MockRegistrationService
+	outboxService         *service_mock.MockOutboxService
+	auditEventRepo        *repository_mock.MockAuditEventRepository
+	service               service.MonitoringService
+}
+
+// newMonitoringFixture authorizes through a real advisor assignment service, with assignmentMonitor
+// monitoring the registration
+func newMonitoringFixture() monitoringFixture {
+	fixture := monitoringFixture{
+		monitoringRepo:        new(repository_mock.MockMonitoringRepository),
+		reportRepo:            new(repository_mock.MockReportRepository),
+		reportScheduleRepo:    new(repository_mock.MockReportScheduleRepository),
+		userManagementService: service_mock.NewMockUserManagementService(),
+		registrationService:   service_mock.NewMockRegistrationService(),
+		outboxService:         service_mock.NewMockOutboxService(),
+		auditEventRepo:        new(repository_mock.MockAuditEventRepository),
+	}
+	fixture.outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	fixture.auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	fixture.registrationService.On("GetRegistrationByID", mock.Anything, "GET", assignmentRegistrationID, mock.Anything).Return(dto.Registration{
+		ID:                   assignmentRegistrationID,
+		AcademicAdvisorEmail: assignmentAdvisor.Email,
+	}, nil)
+	fixture.monitoringRepo.On("FindAssignmentsByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.MonitorAssignment{
+		{RegistrationID: assignmentRegistrationID, MonitorEmail: assignmentMonitor.Email},
+	}, nil)
+	fixture.reportRepo.On("FindByID", mock.Anything, monitoringReportID, mock.Anything).Return(entity.Report{
+		ReportScheduleID: monitoringReportScheduleID,
+	}, nil)
+	fixture.reportScheduleRepo.On("FindByID", mock.Anything, monitoringReportScheduleID, mock.Anything).Return(entity.ReportSchedule{
+		RegistrationID:       assignmentRegistrationID,
+		UserID:               "student-id",
+		AcademicAdvisorEmail: assignmentAdvisor.Email,
+	}, nil)
+
+	auditService := service.NewAuditService(fixture.auditEventRepo)
+	advisorAssignments := service.NewAdvisorAssignmentService(
+		new(repository_mock.MockAdvisorAssignmentRepository),
+		fixture.monitoringRepo,
+		fixture.userManagementService,
+		fixture.registrationService,
+		fixture.outboxService,
+		auditService,
+	)
+	fixture.service = service.NewMonitoringService(
+		fixture.monitoringRepo,
+		fixture.reportRepo,
+		fixture.reportScheduleRepo,
+		fixture.userManagementService,
+		fixture.registrationService,
+		advisorAssignments,
+		fixture.outboxService,
+		auditService,
+	)
+
+	return fixture
+}
+
+func TestMonitoringService_AssignRejectsDuplicates(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentLOMBKM, nil)
+	fixture.monitoringRepo.On("FindAssignmentByRegistrationIDAndMonitorEmail", mock.Anything, assignmentRegistrationID, assignmentMonitor.Email, mock.Anything).Return(entity.MonitorAssignment{}, nil)
+
+	_, err := fixture.service.Assign(context.Background(), dto.MonitorAssignmentRequest{
+		RegistrationID: assignmentRegistrationID,
+		MonitorEmail:   assignmentMonitor.Email,
+	}, "token")
+
+	assert.ErrorIs(t, err, helper.ErrMonitorAssignmentExists)
+	fixture.monitoringRepo.AssertNotCalled(t, "CreateAssignment", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMonitoringService_MonitorEvaluatesReport(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentMonitor, nil)
+	fixture.monitoringRepo.On("CreateEvaluation", mock.Anything, mock.MatchedBy(func(evaluation entity.ReportEvaluation) bool {
+		return evaluation.MonitorEmail == assignmentMonitor.Email && evaluation.RegistrationID == assignmentRegistrationID && evaluation.Note == "On track"
+	}), mock.Anything).Return(entity.ReportEvaluation{
+		ID:           uuid.New(),
+		ReportID:     monitoringReportID,
+		MonitorEmail: assignmentMonitor.Email,
+		Note:         "On track",
+	}, nil)
+
+	evaluation, err := fixture.service.Evaluate(context.Background(), monitoringReportID, dto.ReportEvaluationRequest{Note: " On track "}, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "On track", evaluation.Note)
+	fixture.reportRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	fixture.auditEventRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestMonitoringService_AdvisorCannotEvaluateReport(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentAdvisor, nil)
+
+	_, err := fixture.service.Evaluate(context.Background(), monitoringReportID, dto.ReportEvaluationRequest{Note: "Looks fine"}, "token")
+
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+	fixture.monitoringRepo.AssertNotCalled(t, "CreateEvaluation", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMonitoringService_RecordVisit(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentMonitor, nil)
+	fixture.monitoringRepo.On("CreateVisit", mock.Anything, mock.MatchedBy(func(visit entity.MonitoringVisit) bool {
+		return visit.Score == 85 && visit.MonitorEmail == assignmentMonitor.Email
+	}), mock.Anything).Return(entity.MonitoringVisit{ID: uuid.New(), Score: 85}, nil)
+
+	score := 85
+	visit, err := fixture.service.RecordVisit(context.Background(), assignmentRegistrationID, dto.MonitoringVisitRequest{
+		VisitDate: time.Now().Format("2006-01-02"),
+		Score:     &score,
+	}, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 85, visit.Score)
+}
+
+func TestMonitoringService_RecordVisitRejectsInvalidAssessment(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.userManagementService.On("CurrentUser", mock.Anything, "token").Return(assignmentMonitor, nil)
+
+	score := 101
+	_, err := fixture.service.RecordVisit(context.Background(), assignmentRegistrationID, dto.MonitoringVisitRequest{
+		VisitDate: time.Now().Format("2006-01-02"),
+		Score:     &score,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrInvalidMonitoringVisit)
+
+	score = 80
+	_, err = fixture.service.RecordVisit(context.Background(), assignmentRegistrationID, dto.MonitoringVisitRequest{
+		VisitDate: time.Now().AddDate(0, 0, 7).Format("2006-01-02"),
+		Score:     &score,
+	}, "token")
+	assert.ErrorIs(t, err, helper.ErrInvalidMonitoringVisit)
+	fixture.monitoringRepo.AssertNotCalled(t, "CreateVisit", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMonitoringService_UnassignUnknown(t *testing.T) {
+	fixture := newMonitoringFixture()
+	fixture.monitoringRepo.On("FindAssignmentByID", mock.Anything, "missing", mock.Anything).Return(entity.MonitorAssignment{}, gorm.ErrRecordNotFound)
+
+	err := fixture.service.Unassign(context.Background(), "missing", "token")
+
+	assert.ErrorIs(t, err, helper.ErrMonitorAssignmentNotFound)
+}
@@ -0,0 +1,232 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+var notificationTestConfig = helper.NotificationConfig{
+	Enabled:        true,
+	Interval:       time.Second,
+	BatchSize:      50,
+	MaxAttempts:    3,
+	RetryBaseDelay: time.Minute,
+	RetryMaxDelay:  time.Hour,
+	ClaimDuration:  5 * time.Minute,
+	ServiceToken:   "Bearer service-token",
+}
+
+type notificationServiceFixture struct {
+	baseRepo         *repository_mock.MockBaseRepository
+	notificationRepo *repository_mock.MockNotificationRepository
+	brokerService    *service_mock.MockBrokerService
+	service          service.NotificationService
+}
+
+func newNotificationServiceFixture() notificationServiceFixture {
+	fixture := notificationServiceFixture{
+		baseRepo:         new(repository_mock.MockBaseRepository),
+		notificationRepo: new(repository_mock.MockNotificationRepository),
+		brokerService:    service_mock.NewMockBrokerService(),
+	}
+	fixture.baseRepo.On("WithinTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	fixture.notificationRepo.On("Claim", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	fixture.service = service.NewNotificationService(fixture.baseRepo, fixture.notificationRepo, fixture.brokerService, notificationTestConfig)
+
+	return fixture
+}
+
+func pendingNotification(attempts int) entity.Notification {
+	now := time.Now()
+
+	return entity.Notification{
+		ID:            uuid.New(),
+		SenderName:    "Advisor",
+		SenderEmail:   "advisor@example.com",
+		ReceiverEmail: "student@example.com",
+		Type:          "APPROVAL REPORT",
+		Message:       "report week 1 WEEKLY_REPORT has been APPROVED by Advisor",
+		Status:        helper.NOTIFICATION_STATUS_PENDING,
+		Attempts:      attempts,
+		NextAttemptAt: &now,
+	}
+}
+
+func TestNotificationService_EnqueueQueuesPendingNotifications(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	tx := &gorm.DB{}
+	fixture.notificationRepo.On("Create", mock.Anything, mock.MatchedBy(func(notifications []entity.Notification) bool {
+		return len(notifications) == 1 &&
+			notifications[0].ReceiverEmail == "student@example.com" &&
+			notifications[0].Status == helper.NOTIFICATION_STATUS_PENDING &&
+			notifications[0].NextAttemptAt != nil
+	}), tx).Return(nil)
+
+	err := fixture.service.Enqueue(context.Background(), tx, dto.NotificationRequest{ReceiverEmail: "student@example.com", Type: "APPROVAL REPORT"})
+
+	assert.NoError(t, err)
+	fixture.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DeliverDueMarksSent(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(0)
+	fixture.notificationRepo.On("FindDue", mock.Anything, mock.Anything, notificationTestConfig.BatchSize, mock.Anything).Return([]entity.Notification{notification}, nil)
+	fixture.brokerService.On("SendNotification", mock.Anything, mock.Anything, "POST", notificationTestConfig.ServiceToken).Return(dto.NotificationResult{Status: "success"}, nil)
+	fixture.notificationRepo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Status == helper.NOTIFICATION_STATUS_SENT && n.Attempts == 1 && n.SentAt != nil && n.NextAttemptAt == nil
+	}), mock.MatchedBy(func(attempt entity.NotificationAttempt) bool {
+		return attempt.Succeeded && attempt.Attempt == 1 && attempt.NotificationID == notification.ID.String()
+	}), mock.Anything).Return(nil)
+
+	sent, err := fixture.service.DeliverDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	fixture.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DeliverDueSchedulesRetry(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(0)
+	fixture.notificationRepo.On("FindDue", mock.Anything, mock.Anything, notificationTestConfig.BatchSize, mock.Anything).Return([]entity.Notification{notification}, nil)
+	fixture.brokerService.On("SendNotification", mock.Anything, mock.Anything, "POST", notificationTestConfig.ServiceToken).Return(dto.NotificationResult{}, errors.New("broker unavailable"))
+	fixture.notificationRepo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Status == helper.NOTIFICATION_STATUS_PENDING && n.Attempts == 1 && n.NextAttemptAt != nil && n.LastError == "broker unavailable"
+	}), mock.MatchedBy(func(attempt entity.NotificationAttempt) bool {
+		return !attempt.Succeeded && attempt.Error == "broker unavailable"
+	}), mock.Anything).Return(nil)
+
+	sent, err := fixture.service.DeliverDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	fixture.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DeliverDueMarksFailedAfterMaxAttempts(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(notificationTestConfig.MaxAttempts - 1)
+	fixture.notificationRepo.On("FindDue", mock.Anything, mock.Anything, notificationTestConfig.BatchSize, mock.Anything).Return([]entity.Notification{notification}, nil)
+	fixture.brokerService.On("SendNotification", mock.Anything, mock.Anything, "POST", notificationTestConfig.ServiceToken).Return(dto.NotificationResult{}, errors.New("broker unavailable"))
+	fixture.notificationRepo.On("RecordAttempt", mock.Anything, mock.MatchedBy(func(n entity.Notification) bool {
+		return n.Status == helper.NOTIFICATION_STATUS_FAILED && n.Attempts == notificationTestConfig.MaxAttempts && n.NextAttemptAt == nil
+	}), mock.Anything, mock.Anything).Return(nil)
+
+	_, err := fixture.service.DeliverDue(context.Background())
+
+	assert.NoError(t, err)
+	fixture.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DeliverDueSendsOutsideTheClaim(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(0)
+	fixture.notificationRepo.On("FindDue", mock.Anything, mock.Anything, notificationTestConfig.BatchSize, mock.Anything).Return([]entity.Notification{notification}, nil)
+	fixture.brokerService.On("SendNotification", mock.Anything, mock.Anything, "POST", notificationTestConfig.ServiceToken).Run(func(mock.Arguments) {
+		// the claim transaction has ended before the broker is called
+		fixture.baseRepo.AssertNumberOfCalls(t, "WithinTx", 1)
+		fixture.notificationRepo.AssertCalled(t, "Claim", mock.Anything, []string{notification.ID.String()}, mock.Anything, mock.Anything)
+	}).Return(dto.NotificationResult{Status: "success"}, nil)
+	fixture.notificationRepo.On("RecordAttempt", mock.Anything, mock.Anything, mock.Anything, (*gorm.DB)(nil)).Return(errors.New("connection lost"))
+
+	sent, err := fixture.service.DeliverDue(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	fixture.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DeliverDueWithoutServiceToken(t *testing.T) {
+	config := notificationTestConfig
+	config.ServiceToken = ""
+	notificationService := service.NewNotificationService(new(repository_mock.MockBaseRepository), new(repository_mock.MockNotificationRepository), service_mock.NewMockBrokerService(), config)
+
+	_, err := notificationService.DeliverDue(context.Background())
+
+	assert.Error(t, err)
+}
+
+func TestNotificationService_FindFailedIncludesAttemptLog(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(3)
+	notification.Status = helper.NOTIFICATION_STATUS_FAILED
+	attemptedAt := time.Now()
+	pagReq := dto.PaginationRequest{Offset: 0, Limit: 10, URL: "/notifications/failed"}
+
+	fixture.notificationRepo.On("FindByStatus", mock.Anything, helper.NOTIFICATION_STATUS_FAILED, &pagReq, mock.Anything).Return([]entity.Notification{notification}, int64(1), nil)
+	fixture.notificationRepo.On("FindAttempts", mock.Anything, []string{notification.ID.String()}, mock.Anything).Return(map[string][]entity.NotificationAttempt{
+		notification.ID.String(): {
+			{Attempt: 1, Error: "broker unavailable", AttemptedAt: &attemptedAt},
+			{Attempt: 2, Error: "broker unavailable", AttemptedAt: &attemptedAt},
+		},
+	}, nil)
+
+	notifications, metaData, err := fixture.service.FindFailed(context.Background(), pagReq)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), metaData.Total)
+	assert.Len(t, notifications, 1)
+	assert.Len(t, notifications[0].AttemptLog, 2)
+	assert.Equal(t, "broker unavailable", notifications[0].AttemptLog[0].Error)
+}
+
+func TestNotificationService_Resend(t *testing.T) {
+	fixture := newNotificationServiceFixture()
+
+	notification := pendingNotification(0)
+	id := notification.ID.String()
+	fixture.notificationRepo.On("Resend", mock.Anything, id, mock.Anything, mock.Anything).Return(true, nil)
+	fixture.notificationRepo.On("FindByID", mock.Anything, id, mock.Anything).Return(notification, nil)
+
+	response, err := fixture.service.Resend(context.Background(), id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, helper.NOTIFICATION_STATUS_PENDING, response.Status)
+}
+
+func TestNotificationService_ResendErrors(t *testing.T) {
+	t.Run("not found", func(t *testing.T) {
+		fixture := newNotificationServiceFixture()
+
+		id := uuid.NewString()
+		fixture.notificationRepo.On("Resend", mock.Anything, id, mock.Anything, mock.Anything).Return(false, nil)
+		fixture.notificationRepo.On("FindByID", mock.Anything, id, mock.Anything).Return(entity.Notification{}, gorm.ErrRecordNotFound)
+
+		_, err := fixture.service.Resend(context.Background(), id)
+
+		assert.ErrorIs(t, err, helper.ErrNotificationNotFound)
+	})
+
+	t.Run("not failed", func(t *testing.T) {
+		fixture := newNotificationServiceFixture()
+
+		notification := pendingNotification(1)
+		id := notification.ID.String()
+		fixture.notificationRepo.On("Resend", mock.Anything, id, mock.Anything, mock.Anything).Return(false, nil)
+		fixture.notificationRepo.On("FindByID", mock.Anything, id, mock.Anything).Return(notification, nil)
+
+		_, err := fixture.service.Resend(context.Background(), id)
+
+		assert.ErrorIs(t, err, helper.ErrNotificationNotFailed)
+	})
+}
@@ -0,0 +1,161 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+var approvalNotification = dto.NotificationRequest{
+	SenderName:    "Dr. Sari",
+	SenderEmail:   "advisor@example.com",
+	ReceiverEmail: "student@example.com",
+	Type:          helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+}
+
+var approvalData = dto.NotificationTemplateData{
+	StudentName: "Budi",
+	AdvisorName: "Dr. Sari",
+	Week:        2,
+	Status:      "APPROVED",
+	Link:        "/reports/report-id",
+}
+
+func newNotificationTemplateService(templateRepo *repository_mock.MockNotificationTemplateRepository) service.NotificationTemplateService {
+	return service.NewNotificationTemplateService(templateRepo, service_mock.NewMockUserManagementService(), helper.NotificationTemplateConfig{
+		DefaultLocale: helper.LOCALE_ID,
+		LinkBaseURL:   "https://monitoring.example.com",
+	})
+}
+
+func TestNotificationTemplateService_RenderInReceiverLocale(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindLocale", mock.Anything, "student@example.com", mock.Anything).Return(entity.UserLocalePreference{UserEmail: "student@example.com", Locale: helper.LOCALE_EN}, nil)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_EN, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+
+	notification, err := newNotificationTemplateService(templateRepo).Render(context.Background(), approvalNotification, approvalData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Report week 2 approved", notification.Subject)
+	assert.Contains(t, notification.Message, "https://monitoring.example.com/reports/report-id")
+	assert.Equal(t, approvalNotification.ReceiverEmail, notification.ReceiverEmail)
+}
+
+func TestNotificationTemplateService_RenderUsesRequestLocale(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindLocale", mock.Anything, mock.Anything, mock.Anything).Return(entity.UserLocalePreference{}, gorm.ErrRecordNotFound)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+
+	ctx := context.WithValue(context.Background(), helper.LOCALE_CONTEXT_KEY, helper.LOCALE_EN)
+	notification, err := newNotificationTemplateService(templateRepo).Render(ctx, approvalNotification, approvalData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Report week 2 approved", notification.Subject)
+
+	notification, err = newNotificationTemplateService(templateRepo).Render(context.Background(), approvalNotification, approvalData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Laporan minggu 2 disetujui", notification.Subject)
+}
+
+func TestNotificationTemplateService_RenderPrefersStoredTemplate(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindLocale", mock.Anything, mock.Anything, mock.Anything).Return(entity.UserLocalePreference{}, gorm.ErrRecordNotFound)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_ID, mock.Anything).Return(entity.NotificationTemplate{
+		ID:      uuid.New(),
+		Type:    helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+		Locale:  helper.LOCALE_ID,
+		Subject: "Laporan {{.Week}}: {{.Status}}",
+		Body:    "Dicek oleh {{.AdvisorName}}",
+	}, nil)
+
+	notification, err := newNotificationTemplateService(templateRepo).Render(context.Background(), approvalNotification, approvalData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Laporan 2: APPROVED", notification.Subject)
+	assert.Equal(t, "Dicek oleh Dr. Sari", notification.Message)
+}
+
+func TestNotificationTemplateService_RenderFallsBackToDefaultLocale(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindLocale", mock.Anything, mock.Anything, mock.Anything).Return(entity.UserLocalePreference{Locale: helper.LOCALE_EN}, nil)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, "CUSTOM", helper.LOCALE_EN, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, "CUSTOM", helper.LOCALE_ID, mock.Anything).Return(entity.NotificationTemplate{
+		Type:   "CUSTOM",
+		Locale: helper.LOCALE_ID,
+		Body:   "Halo {{.StudentName}}",
+	}, nil)
+
+	notification := approvalNotification
+	notification.Type = "CUSTOM"
+	rendered, err := newNotificationTemplateService(templateRepo).Render(context.Background(), notification, approvalData)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Halo Budi", rendered.Message)
+	templateRepo.AssertExpectations(t)
+}
+
+func TestNotificationTemplateService_RenderUnknownType(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindLocale", mock.Anything, mock.Anything, mock.Anything).Return(entity.UserLocalePreference{}, gorm.ErrRecordNotFound)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+
+	notification := approvalNotification
+	notification.Type = "UNKNOWN"
+	_, err := newNotificationTemplateService(templateRepo).Render(context.Background(), notification, approvalData)
+
+	assert.ErrorIs(t, err, helper.ErrNotificationTemplateNotFound)
+}
+
+func TestNotificationTemplateService_CreateRejectsInvalidTemplate(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(entity.NotificationTemplate{}, gorm.ErrRecordNotFound)
+
+	_, err := newNotificationTemplateService(templateRepo).Create(context.Background(), dto.NotificationTemplateRequest{
+		Type:   helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+		Locale: "en-US",
+		Body:   "{{.StudentName",
+	})
+
+	assert.ErrorIs(t, err, helper.ErrInvalidNotificationTemplate)
+	templateRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNotificationTemplateService_CreateRejectsDuplicate(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	templateRepo.On("FindByTypeAndLocale", mock.Anything, helper.NOTIFICATION_TYPE_REPORT_APPROVAL, helper.LOCALE_EN, mock.Anything).Return(entity.NotificationTemplate{ID: uuid.New()}, nil)
+
+	_, err := newNotificationTemplateService(templateRepo).Create(context.Background(), dto.NotificationTemplateRequest{
+		Type:   helper.NOTIFICATION_TYPE_REPORT_APPROVAL,
+		Locale: helper.LOCALE_EN,
+		Body:   "Reviewed",
+	})
+
+	assert.ErrorIs(t, err, helper.ErrNotificationTemplateExists)
+}
+
+func TestNotificationTemplateService_IndexMergesDefaults(t *testing.T) {
+	templateRepo := new(repository_mock.MockNotificationTemplateRepository)
+	stored := entity.NotificationTemplate{ID: uuid.New(), Type: helper.NOTIFICATION_TYPE_REPORT_APPROVAL, Locale: helper.LOCALE_EN, Body: "Reviewed"}
+	templateRepo.On("Index", mock.Anything, mock.Anything).Return([]entity.NotificationTemplate{stored}, nil)
+
+	notificationTemplates, err := newNotificationTemplateService(templateRepo).Index(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, notificationTemplates, len(helper.DefaultNotificationTemplates()))
+	assert.Equal(t, stored.ID.String(), notificationTemplates[0].ID)
+	for _, notificationTemplate := range notificationTemplates[1:] {
+		assert.True(t, notificationTemplate.Default)
+		assert.False(t, notificationTemplate.Type == stored.Type && notificationTemplate.Locale == stored.Locale)
+	}
+}
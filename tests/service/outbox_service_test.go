@@ -0,0 +1,132 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type publishedMessage struct {
+	routingKey string
+	messageID  string
+	body       []byte
+}
+
+type fakeEventPublisher struct {
+	messages []publishedMessage
+	err      error
+}
+
+func (p *fakeEventPublisher) Publish(ctx context.Context, routingKey string, messageID string, body []byte) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, publishedMessage{routingKey: routingKey, messageID: messageID, body: body})
+	return nil
+}
+
+var outboxTestConfig = helper.OutboxConfig{Enabled: true, BatchSize: 100}
+
+func TestOutboxService_RecordWritesVersionedEnvelope(t *testing.T) {
+	outboxRepo := new(repository_mock.MockOutboxRepository)
+	outboxService := service.NewOutboxService(new(repository_mock.MockBaseRepository), outboxRepo, &fakeEventPublisher{}, outboxTestConfig)
+
+	var recorded []entity.OutboxEvent
+	outboxRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			recorded = args.Get(1).([]entity.OutboxEvent)
+		}).
+		Return(nil)
+
+	data := dto.ReportEventData{ReportID: "report-1", Status: helper.REPORT_STATUS_APPROVED}
+	err := outboxService.Record(context.Background(), &gorm.DB{}, dto.DOMAIN_EVENT_REPORT_APPROVED, "report", "report-1", data)
+
+	assert.NoError(t, err)
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, dto.DOMAIN_EVENT_REPORT_APPROVED, recorded[0].EventType)
+	assert.Equal(t, "report-1", recorded[0].AggregateID)
+
+	var envelope struct {
+		ID      string              `json:"id"`
+		Type    string              `json:"type"`
+		Version int                 `json:"version"`
+		Source  string              `json:"source"`
+		Data    dto.ReportEventData `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(recorded[0].Payload), &envelope))
+	assert.Equal(t, recorded[0].ID.String(), envelope.ID)
+	assert.Equal(t, dto.DOMAIN_EVENT_REPORT_APPROVED, envelope.Type)
+	assert.Equal(t, dto.DOMAIN_EVENT_SCHEMA_VERSION, envelope.Version)
+	assert.Equal(t, dto.DOMAIN_EVENT_SOURCE, envelope.Source)
+	assert.Equal(t, data, envelope.Data)
+}
+
+func TestOutboxService_RecordUsesCallerTransaction(t *testing.T) {
+	outboxRepo := new(repository_mock.MockOutboxRepository)
+	outboxService := service.NewOutboxService(new(repository_mock.MockBaseRepository), outboxRepo, &fakeEventPublisher{}, outboxTestConfig)
+
+	tx := &gorm.DB{}
+	outboxRepo.On("Create", mock.Anything, mock.Anything, tx).Return(errors.New("database error"))
+
+	err := outboxService.Record(context.Background(), tx, dto.DOMAIN_EVENT_SYLLABUS_UPLOADED, "syllabus", "syllabus-1", dto.DocumentEventData{})
+
+	assert.Error(t, err)
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestOutboxService_PublishPending(t *testing.T) {
+	outboxRepo := new(repository_mock.MockOutboxRepository)
+	publisher := &fakeEventPublisher{}
+	outboxService := service.NewOutboxService(new(repository_mock.MockBaseRepository), outboxRepo, publisher, outboxTestConfig)
+
+	outboxEvent := entity.OutboxEvent{ID: uuid.New(), EventType: dto.DOMAIN_EVENT_REPORT_SUBMITTED, Payload: `{"type":"report.submitted"}`}
+	outboxRepo.On("PublishPending", mock.Anything, outboxTestConfig.BatchSize, mock.Anything).Return([]entity.OutboxEvent{outboxEvent}, nil)
+
+	published, err := outboxService.PublishPending(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []publishedMessage{{
+		routingKey: dto.DOMAIN_EVENT_REPORT_SUBMITTED,
+		messageID:  outboxEvent.ID.String(),
+		body:       []byte(outboxEvent.Payload),
+	}}, publisher.messages)
+}
+
+func TestOutboxService_PublishPendingKeepsFailedEvents(t *testing.T) {
+	outboxRepo := new(repository_mock.MockOutboxRepository)
+	publisher := &fakeEventPublisher{err: errors.New("broker unavailable")}
+	outboxService := service.NewOutboxService(new(repository_mock.MockBaseRepository), outboxRepo, publisher, outboxTestConfig)
+
+	outboxEvent := entity.OutboxEvent{ID: uuid.New(), EventType: dto.DOMAIN_EVENT_REPORT_SUBMITTED}
+	outboxRepo.On("PublishPending", mock.Anything, outboxTestConfig.BatchSize, mock.Anything).Return([]entity.OutboxEvent{outboxEvent}, nil)
+
+	published, err := outboxService.PublishPending(context.Background())
+
+	assert.ErrorContains(t, err, "broker unavailable")
+	assert.Equal(t, 0, published)
+}
+
+func TestOutboxService_TransactionRollsBackOnError(t *testing.T) {
+	baseRepo := new(repository_mock.MockBaseRepository)
+	outboxService := service.NewOutboxService(baseRepo, new(repository_mock.MockOutboxRepository), &fakeEventPublisher{}, outboxTestConfig)
+
+	baseRepo.On("WithinTx", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	err := outboxService.Transaction(context.Background(), func(tx *gorm.DB) error {
+		return errors.New("write failed")
+	})
+
+	assert.EqualError(t, err, "write failed")
+}
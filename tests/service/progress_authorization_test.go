@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// A caller without access to the registration never gets its progress aggregated
+func TestProgressService_FindByRegistrationIDAuthorizesFirst(t *testing.T) {
+	student := dto.User{ID: "other-student-id", NRP: "5025219999", Role: helper.ROLE_STUDENT}
+
+	progressRepo := new(repository_mock.MockProgressRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	registrationService := service_mock.NewMockRegistrationService()
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(student, nil)
+	registrationService.On("GetRegistrationByID", mock.Anything, "GET", assignmentRegistrationID, "token").Return(dto.Registration{
+		ID:                   assignmentRegistrationID,
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		AcademicAdvisorEmail: "advisor@example.com",
+	}, nil)
+	advisorAssignments.On("Authorize", mock.Anything, student, helper.POLICY_ACTION_VIEW, helper.PolicyResource{
+		Kind:           helper.POLICY_RESOURCE_PROGRESS,
+		RegistrationID: assignmentRegistrationID,
+		OwnerID:        "student-id",
+		OwnerNRP:       "5025211111",
+		AdvisorEmail:   "advisor@example.com",
+	}).Return(helper.ErrForbidden)
+
+	progressService := service.NewProgressService(
+		progressRepo,
+		new(repository_mock.MockSyllabusRepository),
+		new(repository_mock.MockTranscriptRepository),
+		userManagementService,
+		registrationService,
+		advisorAssignments,
+	)
+
+	_, err := progressService.FindByRegistrationID(context.Background(), assignmentRegistrationID, "token")
+
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+	advisorAssignments.AssertExpectations(t)
+	progressRepo.AssertNotCalled(t, "SummarizeByRegistrationID", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,69 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Listings read the registration from the snapshot joined into each row and only look up the unsynced ones
+func TestSyllabusService_ListingReadsJoinedSnapshots(t *testing.T) {
+	student := dto.User{ID: "student-id", NRP: "5025211111", Role: helper.ROLE_STUDENT}
+
+	syllabusRepo := new(repository_mock.MockSyllabusRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	registrationService := service_mock.NewMockRegistrationService()
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(student, nil)
+	syllabusRepo.On("FindByUserNRPAndGroupByRegistrationID", mock.Anything, student.NRP, mock.Anything).Return(map[string][]entity.Syllabus{
+		"synced": {{
+			ID:             uuid.New(),
+			RegistrationID: "synced",
+			ListedRegistration: entity.ListedRegistration{
+				RegistrationSynced:       true,
+				RegistrationActivityName: "Internship",
+				RegistrationApproved:     true,
+			},
+		}},
+		"unapproved": {{
+			ID:             uuid.New(),
+			RegistrationID: "unapproved",
+			ListedRegistration: entity.ListedRegistration{
+				RegistrationSynced:       true,
+				RegistrationActivityName: "Research",
+			},
+		}},
+		"unsynced": {{ID: uuid.New(), RegistrationID: "unsynced"}},
+	}, nil)
+	registrationService.On("GetRegistrationsByIDs", mock.Anything, "GET", []string{"unsynced"}, "token").Return(service.RegistrationLookup{
+		Registrations: map[string]dto.Registration{"unsynced": {ID: "unsynced", ActivityName: "Teaching", ApprovalStatus: true}},
+	}, nil)
+
+	syllabusService := service.NewSyllabusService(
+		syllabusRepo,
+		userManagementService,
+		registrationService,
+		nil,
+		service_mock.NewMockOutboxService(),
+		service_mock.NewMockAdvisorNotificationService(),
+		service.NewAuditService(new(repository_mock.MockAuditEventRepository)),
+		service_mock.NewMockAdvisorAssignmentService(),
+	)
+
+	response, err := syllabusService.FindByUserNRPAndGroupByRegistrationID(context.Background(), "token")
+
+	assert.NoError(t, err)
+	assert.Contains(t, response.Syllabuses, "Internship")
+	assert.Contains(t, response.Syllabuses, "Teaching")
+	assert.NotContains(t, response.Syllabuses, "Research")
+	registrationService.AssertExpectations(t)
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const registrationTestToken = "Bearer test-token"
+
+var registrationTestConfig = helper.DownstreamConfig{Timeout: time.Second}
+
+func writeRegistrationData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": "success",
+		"data":   data,
+	})
+}
+
+func TestGetRegistrationsByIDsChunksBatchRequests(t *testing.T) {
+	var mu sync.Mutex
+	var batchSizes []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		var body struct {
+			RegistrationIDs []string `json:"registration_ids"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		mu.Lock()
+		batchSizes = append(batchSizes, len(body.RegistrationIDs))
+		mu.Unlock()
+
+		var registrations []map[string]interface{}
+		for _, id := range body.RegistrationIDs {
+			if id == "missing" {
+				continue
+			}
+			registrations = append(registrations, map[string]interface{}{"id": id, "activity_name": "activity " + id})
+		}
+		writeRegistrationData(w, registrations)
+	}))
+	defer server.Close()
+
+	ids := []string{"missing"}
+	for i := 0; i < service.REGISTRATION_BATCH_SIZE*2; i++ {
+		ids = append(ids, fmt.Sprintf("registration-%d", i))
+	}
+	ids = append(ids, ids[1])
+
+	registrationService := service.NewRegistrationManagementService(server.URL, nil, registrationTestConfig)
+	lookup, err := registrationService.GetRegistrationsByIDs(context.Background(), "GET", ids, registrationTestToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{service.REGISTRATION_BATCH_SIZE, service.REGISTRATION_BATCH_SIZE, 1}, batchSizes)
+	assert.Len(t, lookup.Registrations, service.REGISTRATION_BATCH_SIZE*2)
+
+	registration, err := lookup.Get(ids[1])
+	assert.NoError(t, err)
+	assert.Equal(t, "activity "+ids[1], registration.ActivityName)
+
+	_, err = lookup.Get("missing")
+	assert.ErrorIs(t, err, service.ErrRegistrationNotFound)
+}
+
+func TestGetRegistrationsByIDsFallsBackToPerIDRequests(t *testing.T) {
+	var mu sync.Mutex
+	batchCalls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			mu.Lock()
+			batchCalls++
+			mu.Unlock()
+			http.NotFound(w, r)
+			return
+		}
+
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		switch id {
+		case "missing":
+			http.NotFound(w, r)
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			writeRegistrationData(w, map[string]interface{}{"id": id, "activity_name": "activity " + id, "approval_status": true})
+		}
+	}))
+	defer server.Close()
+
+	registrationService := service.NewRegistrationManagementService(server.URL, nil, registrationTestConfig)
+	ids := []string{"first", "missing", "broken"}
+
+	for i := 0; i < 2; i++ {
+		lookup, err := registrationService.GetRegistrationsByIDs(context.Background(), "GET", ids, registrationTestToken)
+		assert.NoError(t, err)
+
+		registration, err := lookup.Get("first")
+		assert.NoError(t, err)
+		assert.True(t, registration.ApprovalStatus)
+
+		_, err = lookup.Get("missing")
+		assert.ErrorIs(t, err, service.ErrRegistrationNotFound)
+
+		_, err = lookup.Get("broken")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, service.ErrRegistrationNotFound)
+	}
+
+	// the missing batch endpoint is remembered instead of being probed on every lookup
+	assert.Equal(t, 1, batchCalls)
+}
+
+func TestGetRegistrationsByIDsRejectsInvalidToken(t *testing.T) {
+	registrationService := service.NewRegistrationManagementService("http://localhost", nil, registrationTestConfig)
+
+	_, err := registrationService.GetRegistrationsByIDs(context.Background(), "GET", []string{"first"}, "invalid")
+
+	assert.ErrorIs(t, err, service.ErrInvalidToken)
+}
@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRegistrationSyncService_HandleEvent_Updated(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	syncService := service.NewRegistrationSyncService(snapshotRepo, service_mock.NewMockRegistrationService(), userManagementService, helper.RegistrationSyncConfig{})
+
+	// the reassigned advisor and the student are looked up afresh
+	userManagementService.On("InvalidateUser", "student-1").Return()
+	userManagementService.On("InvalidateEmail", "new-advisor@example.com").Return()
+
+	occurredAt := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	snapshotRepo.On("Sync", mock.Anything, mock.MatchedBy(func(snapshot entity.RegistrationSnapshot) bool {
+		return snapshot.RegistrationID == "registration-1" &&
+			snapshot.AcademicAdvisorID == "advisor-2" &&
+			snapshot.AcademicAdvisorEmail == "new-advisor@example.com" &&
+			snapshot.SyncedAt.Equal(occurredAt)
+	}), mock.Anything).Return(true, nil)
+
+	err := syncService.HandleEvent(context.Background(), []byte(`{
+		"event": "registration.updated",
+		"occurred_at": "2025-03-01T08:00:00Z",
+		"data": {"id": "registration-1", "user_id": "student-1", "academic_advisor": "advisor-2", "academic_advisor_email": "new-advisor@example.com"}
+	}`))
+
+	assert.NoError(t, err)
+	snapshotRepo.AssertExpectations(t)
+	userManagementService.AssertExpectations(t)
+}
+
+// A stale event describes an older registration, the cached lookups are kept
+func TestRegistrationSyncService_HandleEvent_StaleKeepsCache(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	syncService := service.NewRegistrationSyncService(snapshotRepo, service_mock.NewMockRegistrationService(), userManagementService, helper.RegistrationSyncConfig{})
+
+	snapshotRepo.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+	err := syncService.HandleEvent(context.Background(), []byte(`{
+		"event": "registration.updated",
+		"data": {"id": "registration-1", "user_id": "student-1", "academic_advisor_email": "old-advisor@example.com"}
+	}`))
+
+	assert.NoError(t, err)
+	userManagementService.AssertNotCalled(t, "InvalidateUser", mock.Anything)
+	userManagementService.AssertNotCalled(t, "InvalidateEmail", mock.Anything)
+}
+
+func TestRegistrationSyncService_HandleEvent_Deleted(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	syncService := service.NewRegistrationSyncService(snapshotRepo, service_mock.NewMockRegistrationService(), service_mock.NewMockUserManagementService(), helper.RegistrationSyncConfig{})
+
+	occurredAt := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	snapshotRepo.On("Destroy", mock.Anything, "registration-1", occurredAt, mock.Anything).Return(true, nil)
+
+	err := syncService.HandleEvent(context.Background(), []byte(`{"event": "registration.deleted", "occurred_at": "2025-03-01T08:00:00Z", "data": {"id": "registration-1"}}`))
+
+	assert.NoError(t, err)
+	snapshotRepo.AssertExpectations(t)
+}
+
+func TestRegistrationSyncService_HandleEvent_Malformed(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	syncService := service.NewRegistrationSyncService(snapshotRepo, service_mock.NewMockRegistrationService(), service_mock.NewMockUserManagementService(), helper.RegistrationSyncConfig{})
+
+	for _, body := range []string{`not json`, `{"event": "registration.updated", "data": {}}`} {
+		err := syncService.HandleEvent(context.Background(), []byte(body))
+		assert.ErrorIs(t, err, helper.ErrMalformedMessage)
+	}
+	snapshotRepo.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRegistrationSyncService_HandleEvent_RepositoryError(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	syncService := service.NewRegistrationSyncService(snapshotRepo, service_mock.NewMockRegistrationService(), service_mock.NewMockUserManagementService(), helper.RegistrationSyncConfig{})
+
+	snapshotRepo.On("Sync", mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("database error"))
+
+	err := syncService.HandleEvent(context.Background(), []byte(`{"event": "registration.created", "data": {"id": "registration-1"}}`))
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, helper.ErrMalformedMessage)
+}
+
+func TestSnapshotRegistrationService_GetRegistrationsByIDs(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	liveService := service_mock.NewMockRegistrationService()
+	registrationService := service.NewSnapshotRegistrationService(snapshotRepo, liveService)
+
+	ctx := context.Background()
+	now := time.Now()
+	snapshotRepo.On("FindByIDs", ctx, []string{"synced", "unsynced", "missing"}, mock.Anything).Return(map[string]entity.RegistrationSnapshot{
+		"synced": {RegistrationID: "synced", ActivityName: "Internship", AcademicAdvisorID: "advisor-1", SyncedAt: &now},
+	}, nil)
+	liveService.On("GetRegistrationsByIDs", ctx, "GET", []string{"unsynced", "missing"}, registrationTestToken).Return(service.RegistrationLookup{
+		Registrations: map[string]dto.Registration{"unsynced": {ID: "unsynced", ActivityName: "Research"}},
+		Errors:        map[string]error{"missing": service.ErrRegistrationNotFound},
+	}, nil)
+
+	lookup, err := registrationService.GetRegistrationsByIDs(ctx, "GET", []string{"synced", "unsynced", "missing", "synced"}, registrationTestToken)
+
+	assert.NoError(t, err)
+	synced, err := lookup.Get("synced")
+	assert.NoError(t, err)
+	assert.Equal(t, "Internship", synced.ActivityName)
+	assert.Equal(t, "advisor-1", synced.AcademicAdvisor)
+
+	unsynced, err := lookup.Get("unsynced")
+	assert.NoError(t, err)
+	assert.Equal(t, "Research", unsynced.ActivityName)
+
+	_, err = lookup.Get("missing")
+	assert.ErrorIs(t, err, service.ErrRegistrationNotFound)
+
+	snapshotRepo.AssertExpectations(t)
+	liveService.AssertExpectations(t)
+	// reading never writes the snapshots, the backfill does
+	snapshotRepo.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRegistrationSyncService_Backfill(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	liveService := service_mock.NewMockRegistrationService()
+	syncService := service.NewRegistrationSyncService(snapshotRepo, liveService, service_mock.NewMockUserManagementService(), helper.RegistrationSyncConfig{
+		BackfillToken:     registrationTestToken,
+		BackfillBatchSize: 2,
+	})
+
+	ctx := context.Background()
+	snapshotRepo.On("FindUnsyncedIDs", ctx, "", 2, mock.Anything).Return([]string{"first", "second"}, nil)
+	snapshotRepo.On("FindUnsyncedIDs", ctx, "second", 2, mock.Anything).Return([]string{}, nil)
+	liveService.On("GetRegistrationsByIDs", ctx, "GET", []string{"first", "second"}, registrationTestToken).Return(service.RegistrationLookup{
+		Registrations: map[string]dto.Registration{"first": {ActivityName: "Internship"}},
+		Errors:        map[string]error{"second": service.ErrRegistrationNotFound},
+	}, nil)
+	snapshotRepo.On("Sync", ctx, mock.MatchedBy(func(snapshot entity.RegistrationSnapshot) bool {
+		return snapshot.RegistrationID == "first" && snapshot.ActivityName == "Internship" && snapshot.SyncedAt != nil
+	}), mock.Anything).Return(true, nil)
+
+	synced, err := syncService.Backfill(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, synced)
+	snapshotRepo.AssertExpectations(t)
+}
+
+func TestSnapshotRegistrationService_FallsBackWhenSnapshotsFail(t *testing.T) {
+	snapshotRepo := new(repository_mock.MockRegistrationSnapshotRepository)
+	liveService := service_mock.NewMockRegistrationService()
+	registrationService := service.NewSnapshotRegistrationService(snapshotRepo, liveService)
+
+	ctx := context.Background()
+	lookup := service.RegistrationLookup{Registrations: map[string]dto.Registration{"first": {ID: "first"}}}
+	snapshotRepo.On("FindByIDs", ctx, []string{"first"}, mock.Anything).Return(nil, errors.New("database error"))
+	liveService.On("GetRegistrationsByIDs", ctx, "GET", []string{"first"}, registrationTestToken).Return(lookup, nil)
+
+	result, err := registrationService.GetRegistrationsByIDs(ctx, "GET", []string{"first"}, registrationTestToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, lookup, result)
+	snapshotRepo.AssertNotCalled(t, "Sync", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// The activity of a new schedule is the registration's, never one the client made up
+func TestReportScheduleService_CreateTakesActivityFromRegistration(t *testing.T) {
+	admin := dto.User{ID: "admin-id", Role: helper.ROLE_ADMIN, Email: "admin@example.com"}
+
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	registrationService := service_mock.NewMockRegistrationService()
+	outboxService := service_mock.NewMockOutboxService()
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(admin, nil)
+	advisorAssignments.On("Authorize", mock.Anything, admin, helper.POLICY_ACTION_CREATE, mock.Anything).Return(nil)
+	registrationService.On("GetRegistrationByID", mock.Anything, "GET", assignmentRegistrationID, "token").Return(dto.Registration{
+		ID:                   assignmentRegistrationID,
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		AcademicAdvisor:      "advisor-id",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Kampus Mengajar",
+	}, nil)
+	reportScheduleRepo.On("Create", mock.Anything, mock.MatchedBy(func(reportSchedule entity.ReportSchedule) bool {
+		return reportSchedule.ActivityName == "Kampus Mengajar"
+	}), mock.Anything).Return(entity.ReportSchedule{ActivityName: "Kampus Mengajar"}, nil)
+	outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	outboxService.On("Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	reportScheduleService := service.NewReportScheduleService(
+		reportScheduleRepo,
+		userManagementService,
+		registrationService,
+		outboxService,
+		service.NewAuditService(auditEventRepo),
+		advisorAssignments,
+		helper.ReportScheduleConfig{},
+	)
+
+	response, err := reportScheduleService.Create(context.Background(), dto.ReportScheduleRequest{
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		RegistrationID:       assignmentRegistrationID,
+		AcademicAdvisorID:    "advisor-id",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ReportType:           "WEEKLY_REPORT",
+		Week:                 1,
+		StartDate:            "2025-02-03T00:00:00Z",
+		EndDate:              "2025-02-09T23:59:59Z",
+	}, "token")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Kampus Mengajar", response.ActivityName)
+	reportScheduleRepo.AssertExpectations(t)
+}
+
+// An advisor cannot create schedules on another advisor's registration by naming themselves in the request
+func TestReportScheduleService_CreateRejectsAdvisorOfAnotherRegistration(t *testing.T) {
+	intruder := dto.User{ID: "intruder-id", Role: helper.ROLE_ADVISOR, Email: "intruder@its.ac.id"}
+	fixture := newAdvisorAssignmentFixture()
+
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	registrationService := service_mock.NewMockRegistrationService()
+
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(intruder, nil)
+	registrationService.On("GetRegistrationByID", mock.Anything, "GET", assignmentRegistrationID, "token").Return(dto.Registration{
+		ID:                   assignmentRegistrationID,
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		AcademicAdvisorEmail: assignmentAdvisor.Email,
+	}, nil)
+	fixture.advisorAssignmentRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return([]entity.AdvisorAssignment{}, nil)
+	fixture.advisorAssignmentRepo.On("FindDelegations", mock.Anything, mock.Anything, mock.Anything).Return([]entity.AdvisorDelegation{}, nil)
+
+	reportScheduleService := service.NewReportScheduleService(
+		reportScheduleRepo,
+		userManagementService,
+		registrationService,
+		service_mock.NewMockOutboxService(),
+		service.NewAuditService(new(repository_mock.MockAuditEventRepository)),
+		fixture.service,
+		helper.ReportScheduleConfig{},
+	)
+
+	_, err := reportScheduleService.Create(context.Background(), dto.ReportScheduleRequest{
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		RegistrationID:       assignmentRegistrationID,
+		AcademicAdvisorID:    intruder.ID,
+		AcademicAdvisorEmail: intruder.Email,
+		ReportType:           "WEEKLY_REPORT",
+		Week:                 1,
+		StartDate:            "2025-02-03T00:00:00Z",
+		EndDate:              "2025-02-09T23:59:59Z",
+	}, "token")
+
+	assert.ErrorIs(t, err, helper.ErrForbidden)
+	reportScheduleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// An update never moves a schedule to another registration or student
+func TestReportScheduleService_UpdateKeepsOwnership(t *testing.T) {
+	advisor := dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@its.ac.id"}
+	scheduleID := uuid.New()
+
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	outboxService := service_mock.NewMockOutboxService()
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+
+	reportSchedule := entity.ReportSchedule{
+		ID:                   scheduleID,
+		UserID:               "student-id",
+		UserNRP:              "5025211111",
+		RegistrationID:       assignmentRegistrationID,
+		AcademicAdvisorEmail: advisor.Email,
+		ReportType:           "WEEKLY_REPORT",
+		Week:                 1,
+	}
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(advisor, nil)
+	advisorAssignments.On("Authorize", mock.Anything, advisor, helper.POLICY_ACTION_UPDATE, mock.Anything).Return(nil)
+	reportScheduleRepo.On("FindByID", mock.Anything, scheduleID.String(), mock.Anything).Return(reportSchedule, nil)
+	reportScheduleRepo.On("Update", mock.Anything, scheduleID.String(), mock.MatchedBy(func(updated entity.ReportSchedule) bool {
+		return updated.RegistrationID == assignmentRegistrationID &&
+			updated.UserID == "student-id" &&
+			updated.UserNRP == "5025211111" &&
+			updated.Week == 2
+	}), mock.Anything).Return(nil)
+	outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	reportScheduleService := service.NewReportScheduleService(
+		reportScheduleRepo,
+		userManagementService,
+		service_mock.NewMockRegistrationService(),
+		outboxService,
+		service.NewAuditService(auditEventRepo),
+		advisorAssignments,
+		helper.ReportScheduleConfig{},
+	)
+
+	err := reportScheduleService.Update(context.Background(), scheduleID.String(), dto.ReportScheduleRequest{
+		UserID:         "other-student-id",
+		UserNRP:        "5025219999",
+		RegistrationID: "other-registration-id",
+		Week:           2,
+	}, "token")
+
+	assert.NoError(t, err)
+	reportScheduleRepo.AssertExpectations(t)
+}
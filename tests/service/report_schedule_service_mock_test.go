@@ -6,6 +6,7 @@ import (
 	"log"
 	"monitoring-service/dto"
 	"monitoring-service/entity"
+	"monitoring-service/helper"
 	repository_mock "monitoring-service/mocks/repository"
 	service_mock "monitoring-service/mocks/service"
 	"monitoring-service/service"
@@ -65,12 +66,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -107,20 +108,20 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": "test@gmail.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        true,
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: "test@gmail.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(reportSchedules, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -144,16 +145,16 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	ctx := context.Background()
 	token := "test-token"
 
-	usersData := map[string]interface{}{
-		"id": "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+	usersData := dto.User{
+		ID: "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
 		// NRP is missing
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -171,16 +172,16 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(map[string][]entity.ReportSchedule{}, errors.New("database error"))
 
 	// Call the method
@@ -199,12 +200,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -225,20 +226,20 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": "test@gmail.com",
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: "test@gmail.com",
 		// Activity name is missing
-		"approval_status": true,
+		ApprovalStatus: true,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(reportSchedules, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -256,12 +257,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -282,28 +283,27 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": "test@gmail.com",
-		"activity_name":          "Test Activity",
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: "test@gmail.com",
+		ActivityName:         "Test Activity",
 		// Approval status is missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(reportSchedules, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
 
-	// Assertions
-	assert.Error(suite.T(), err)
-	assert.Equal(suite.T(), "registration approval status not found", err.Error())
-	assert.Equal(suite.T(), dto.ReportScheduleByStudentResponse{}, result)
+	// Assertions: a registration without approval status decodes as not approved and is skipped
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result.Reports)
 }
 
 // Test FindByUserNRPAndGroupByRegistrationID - No Approved Schedules
@@ -313,12 +313,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -339,20 +339,20 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": "test@gmail.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        false, // Not approved
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: "test@gmail.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       false, // Not approved
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(reportSchedules, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -363,10 +363,13 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserNRPAndGroupByRegistra
 }
 
 func (s *mockReportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.ReportScheduleByStudentResponse, error) {
-	usersData := s.userManagementService.GetUserData("GET", token)
+	usersData, err := s.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.ReportScheduleByStudentResponse{}, err
+	}
 
-	userNRP, ok := usersData["nrp"].(string)
-	if !ok {
+	userNRP := usersData.NRP
+	if userNRP == "" {
 		return dto.ReportScheduleByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -378,18 +381,17 @@ func (s *mockReportScheduleService) FindByUserNRPAndGroupByRegistrationID(ctx co
 	reportScheduleResponses := make(map[string][]dto.ReportScheduleResponse)
 
 	for registrationID, reportSchedules := range reportSchedules {
-		registration := s.registrationService.GetRegistrationByID("GET", registrationID, token)
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			return dto.ReportScheduleByStudentResponse{}, errors.New("registration activity name not found")
+		registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+		if err != nil {
+			return dto.ReportScheduleByStudentResponse{}, err
 		}
 
-		approvalStatus, ok := registration["approval_status"].(bool)
-		if !ok {
-			return dto.ReportScheduleByStudentResponse{}, errors.New("registration approval status not found")
+		registrationActivityName := registration.ActivityName
+		if registrationActivityName == "" {
+			return dto.ReportScheduleByStudentResponse{}, errors.New("registration activity name not found")
 		}
 
-		if !approvalStatus {
+		if !registration.ApprovalStatus {
 			continue
 		}
 
@@ -451,16 +453,16 @@ func (suite *ReportScheduleServiceTestSuite) TestCreate_Success() {
 		EndDate:              time.Now().AddDate(0, 0, 7).Format(time.RFC3339),
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN", // Role with access
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN", // Role with access
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("Create", ctx, mock.AnythingOfType("entity.ReportSchedule"), mock.Anything).Return(entity.ReportSchedule{
 		ID:                   uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
 		UserID:               reportScheduleRequest.UserID,
@@ -498,16 +500,16 @@ func (suite *ReportScheduleServiceTestSuite) TestCreate_InvalidUserRole() {
 		AcademicAdvisorEmail: "test@gmail.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",           // Invalid role for creating schedules
-		"email": "different@gmail.com", // Different email from academic advisor
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",           // Invalid role for creating schedules
+		Email: "different@gmail.com", // Different email from academic advisor
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.Create(ctx, reportScheduleRequest, token)
@@ -536,16 +538,16 @@ func (suite *ReportScheduleServiceTestSuite) TestCreate_AdvisorEmailMismatch() {
 		EndDate:              "2023-05-07T23:59:59Z",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "10000000",
-		"name":  "John Doe",
-		"role":  "DOSEN PEMBIMBING",
-		"email": "different@example.com", // Different from the request
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "10000000",
+		Name:  "John Doe",
+		Role:  "DOSEN PEMBIMBING",
+		Email: "different@example.com", // Different from the request
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	_, err := suite.service.Create(ctx, reportSchedule, token)
@@ -573,16 +575,16 @@ func (suite *ReportScheduleServiceTestSuite) TestCreate_RepositoryError() {
 		EndDate:              time.Now().AddDate(0, 0, 7).Format(time.RFC3339),
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("Create", ctx, mock.AnythingOfType("entity.ReportSchedule"), mock.Anything).Return(entity.ReportSchedule{}, errors.New("database error"))
 
 	// Call the method
@@ -612,16 +614,16 @@ func (suite *ReportScheduleServiceTestSuite) TestCreate_InvalidDateFormat() {
 		EndDate:              time.Now().AddDate(0, 0, 7).Format(time.RFC3339),
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.Create(ctx, reportScheduleRequest, token)
@@ -639,12 +641,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByID_Success() {
 	token := "test-token"
 	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b3"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	reportSchedule := entity.ReportSchedule{
@@ -674,7 +676,7 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByID_Success() {
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, id, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -715,12 +717,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByID_AccessDenied() {
 	token := "test-token"
 	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b3"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA", // Invalid role
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA", // Invalid role
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	reportSchedule := entity.ReportSchedule{
@@ -738,7 +740,7 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByID_AccessDenied() {
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, id, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -768,12 +770,12 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_Success() {
 		EndDate:              time.Now().AddDate(0, 0, 14).Format(time.RFC3339),
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	originalReportSchedule := entity.ReportSchedule{
@@ -790,7 +792,7 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_Success() {
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, id, mock.Anything).Return(originalReportSchedule, nil)
 	suite.mockReportScheduleRepo.On("Update", ctx, id, mock.AnythingOfType("entity.ReportSchedule"), mock.Anything).Return(nil)
 
@@ -815,16 +817,16 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_AccessDenied() {
 		AcademicAdvisorEmail: "test@gmail.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA", // Invalid role
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA", // Invalid role
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	err := suite.service.Update(ctx, id, reportScheduleRequest, token)
@@ -849,16 +851,16 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_RecordNotFound() {
 		AcademicAdvisorEmail: "test@gmail.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, id, mock.Anything).Return(entity.ReportSchedule{}, errors.New("record not found"))
 
 	// Call the method
@@ -888,12 +890,12 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_RepositoryError() {
 		EndDate:              time.Now().AddDate(0, 0, 14).Format(time.RFC3339),
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	originalReportSchedule := entity.ReportSchedule{
@@ -910,7 +912,7 @@ func (suite *ReportScheduleServiceTestSuite) TestUpdate_RepositoryError() {
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, id, mock.Anything).Return(originalReportSchedule, nil)
 	suite.mockReportScheduleRepo.On("Update", ctx, id, mock.AnythingOfType("entity.ReportSchedule"), mock.Anything).Return(errors.New("database error"))
 
@@ -929,16 +931,16 @@ func (suite *ReportScheduleServiceTestSuite) TestDestroy_Success() {
 	token := "test-token"
 	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b3"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("Destroy", ctx, id, mock.Anything).Return(nil)
 
 	// Call the method
@@ -955,16 +957,16 @@ func (suite *ReportScheduleServiceTestSuite) TestDestroy_AccessDenied() {
 	token := "test-token"
 	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b3"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA", // Invalid role
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA", // Invalid role
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	err := suite.service.Destroy(ctx, id, token)
@@ -981,16 +983,16 @@ func (suite *ReportScheduleServiceTestSuite) TestDestroy_RepositoryError() {
 	token := "test-token"
 	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b3"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("Destroy", ctx, id, mock.Anything).Return(errors.New("database error"))
 
 	// Call the method
@@ -1038,7 +1040,7 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByRegistrationID_Success()
 	suite.mockReportScheduleRepo.On("FindByRegistrationID", ctx, registrationID, mock.Anything).Return(reportSchedules, nil)
 
 	// Call the method
-	result, err := suite.service.FindByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1059,7 +1061,7 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByRegistrationID_NotFound()
 	suite.mockReportScheduleRepo.On("FindByRegistrationID", ctx, registrationID, mock.Anything).Return([]entity.ReportSchedule{}, errors.New("record not found"))
 
 	// Call the method
-	result, err := suite.service.FindByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1074,12 +1076,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserID_Success() {
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	reportSchedules := []entity.ReportSchedule{
@@ -1110,7 +1112,7 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserID_Success() {
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserID", ctx, userNRP, mock.Anything).Return(reportSchedules, nil)
 
 	// Call the method
@@ -1131,16 +1133,16 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserID_UserNRPNotFound()
 	ctx := context.Background()
 	token := "test-token"
 
-	usersData := map[string]interface{}{
-		"id": "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+	usersData := dto.User{
+		ID: "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
 		// NRP is missing
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserID(ctx, token)
@@ -1158,16 +1160,16 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByUserID_RepositoryError()
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN",
-		"email": "dimas@example.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN",
+		Email: "dimas@example.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockReportScheduleRepo.On("FindByUserID", ctx, userNRP, mock.Anything).Return([]entity.ReportSchedule{}, errors.New("database error"))
 
 	// Call the method
@@ -1189,12 +1191,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_Success() {
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	reportScheduleReq := dto.ReportScheduleAdvisorRequest{UserNRP: "5025211111"}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	uuid1, _ := uuid.Parse("9c2fc428-3cca-4c76-a690-e6ba24d135b3")
@@ -1262,20 +1264,20 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_Success() {
 		"5025211111": {expectedReportScheduleResponse},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               "5025211111",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": "advisor@example.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        "APPROVED",
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              "5025211111",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
-	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP).Return(reportSchedulesByUserNRP, totalCount, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP, reportScheduleReq.SubmissionStatus).Return(reportSchedulesByUserNRP, totalCount, nil)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration, nil)
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmail(ctx, token, pagReq, reportScheduleReq)
 
@@ -1303,16 +1305,16 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_AdvisorEmail
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	reportScheduleReq := dto.ReportScheduleAdvisorRequest{UserNRP: ""}
 
-	usersData := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
-		"role": "DOSEN PEMBIMBING",
+	usersData := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
+		Role: "DOSEN PEMBIMBING",
 		// Email is missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmail(ctx, token, pagReq, reportScheduleReq)
@@ -1333,17 +1335,17 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_RepositoryEr
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	reportScheduleReq := dto.ReportScheduleAdvisorRequest{UserNRP: ""}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
-	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP).Return(map[string][]entity.ReportSchedule{}, int64(0), errors.New("database error"))
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP, reportScheduleReq.SubmissionStatus).Return(map[string][]entity.ReportSchedule{}, int64(0), errors.New("database error"))
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmail(ctx, token, pagReq, reportScheduleReq)
@@ -1364,12 +1366,12 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_ActivityName
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	reportScheduleReq := dto.ReportScheduleAdvisorRequest{UserNRP: ""}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	userNRP := "5025211111"
@@ -1392,20 +1394,20 @@ func (suite *ReportScheduleServiceTestSuite) TestFindByAdvisorEmail_ActivityName
 
 	totalCount := int64(1)
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_name":              "Dimas Fadilah",
-		"academic_advisor":       "test",
-		"academic_advisor_email": advisorEmail,
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserName:             "Dimas Fadilah",
+		AcademicAdvisor:      "test",
+		AcademicAdvisorEmail: advisorEmail,
 		// Activity name is missing
-		"approval_status": true,
+		ApprovalStatus: true,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
-	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP).Return(reportSchedules, totalCount, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockReportScheduleRepo.On("FindByAdvisorEmailAndGroupByUserID", ctx, advisorEmail, mock.Anything, &pagReq, reportScheduleReq.UserNRP, reportScheduleReq.SubmissionStatus).Return(reportSchedules, totalCount, nil)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmail(ctx, token, pagReq, reportScheduleReq)
@@ -1455,17 +1457,17 @@ func (suite *ReportScheduleServiceTestSuite) TestIndex_Success() {
 		},
 	}
 
-	userData := map[string]interface{}{
-		"id":    userID,
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	userData := dto.User{
+		ID:    userID,
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("Index", ctx, mock.Anything).Return(reportSchedules, nil)
-	suite.mockUserManagementService.On("GetUserByID", "GET", token, userID).Return(userData)
+	suite.mockUserManagementService.On("GetUserByID", mock.Anything, "GET", token, userID).Return(userData, nil)
 
 	// Call the method
 	result, err := suite.service.Index(ctx, token)
@@ -1520,17 +1522,17 @@ func (suite *ReportScheduleServiceTestSuite) TestIndex_UserNameNotFound() {
 		},
 	}
 
-	userData := map[string]interface{}{
-		"id":  userID,
-		"nrp": "5025211111",
+	userData := dto.User{
+		ID:  userID,
+		NRP: "5025211111",
 		// Name is missing
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("Index", ctx, mock.Anything).Return(reportSchedules, nil)
-	suite.mockUserManagementService.On("GetUserByID", "GET", token, userID).Return(userData)
+	suite.mockUserManagementService.On("GetUserByID", mock.Anything, "GET", token, userID).Return(userData, nil)
 
 	// Call the method
 	result, err := suite.service.Index(ctx, token)
@@ -1554,19 +1556,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_AdminRole(
 		AcademicAdvisorEmail: "test@gmail.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "ADMIN", // Admin role should always have access
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "ADMIN", // Admin role should always have access
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1586,19 +1588,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_LOMBKMRole
 		AcademicAdvisorEmail: "test@gmail.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "LO-MBKM", // LO-MBKM role should always have access
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "LO-MBKM", // LO-MBKM role should always have access
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1619,19 +1621,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_AdvisorMat
 		AcademicAdvisorEmail: advisorEmail,
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail, // Matching email
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail, // Matching email
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1651,19 +1653,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_AdvisorNon
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
-		"role": "DOSEN PEMBIMBING",
+	usersData := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
+		Role: "DOSEN PEMBIMBING",
 		// Email is missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1684,19 +1686,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_InvalidRol
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA", // Invalid role
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA", // Invalid role
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1717,19 +1719,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_RoleNotFou
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
+	usersData := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
 		// Role is missing
-		"email": "dimasfadilah20@gmail.com",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1750,19 +1752,19 @@ func (suite *ReportScheduleServiceTestSuite) TestReportScheduleAccess_AdvisorEma
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	usersData := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
-		"role": "DOSEN PEMBIMBING",
+	usersData := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
+		Role: "DOSEN PEMBIMBING",
 		// Email is missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
-	result, err := suite.service.ReportScheduleAccess(ctx, reportScheduleRequest, token)
+	result, err := suite.service.(*mockReportScheduleService).ReportScheduleAccess(ctx, reportScheduleRequest, token)
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1938,7 +1940,7 @@ func (s *mockReportScheduleService) Destroy(ctx context.Context, id string, toke
 	return nil
 }
 
-func (s *mockReportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string) ([]dto.ReportScheduleResponse, error) {
+func (s *mockReportScheduleService) FindByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.ReportScheduleResponse, error) {
 	reportSchedules, err := s.reportScheduleRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
@@ -1973,21 +1975,22 @@ func (s *mockReportScheduleService) FindByRegistrationID(ctx context.Context, re
 }
 
 func (s *mockReportScheduleService) ReportScheduleAccess(ctx context.Context, reportSchedule dto.ReportScheduleRequest, token string) (bool, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return false, err
+	}
 
-	// convert userRole to string
-	userRole, ok := user["role"].(string)
-	if !ok {
+	userRole := user.Role
+	if userRole == "" {
 		return false, errors.New("user role not found")
 	}
 
 	if userRole == "DOSEN PEMBIMBING" {
-		userEmail, ok := user["email"].(string)
-		if !ok {
+		if user.Email == "" {
 			return false, errors.New("user email not found")
 		}
 
-		if userEmail != reportSchedule.AcademicAdvisorEmail {
+		if user.Email != reportSchedule.AcademicAdvisorEmail {
 			return false, errors.New("user email not found")
 		}
 	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
@@ -1998,10 +2001,13 @@ func (s *mockReportScheduleService) ReportScheduleAccess(ctx context.Context, re
 }
 
 func (s *mockReportScheduleService) FindByUserID(ctx context.Context, token string) ([]dto.ReportScheduleResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
+	user, err := s.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return nil, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return nil, errors.New("user NRP not found")
 	}
 
@@ -2044,13 +2050,17 @@ func (s *mockReportScheduleService) FindByUserID(ctx context.Context, token stri
 }
 
 func (s *mockReportScheduleService) FindByAdvisorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
-	user := s.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := s.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
-	reportSchedules, totalCount, err := s.reportScheduleRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, &pagReq, reportScheduleRequest.UserNRP)
+	reportSchedules, totalCount, err := s.reportScheduleRepo.FindByAdvisorEmailAndGroupByUserID(ctx, advisorEmail, nil, &pagReq, reportScheduleRequest.UserNRP, reportScheduleRequest.SubmissionStatus)
 	if err != nil {
 		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
 	}
@@ -2061,10 +2071,13 @@ func (s *mockReportScheduleService) FindByAdvisorEmail(ctx context.Context, toke
 		var reportSchedule []dto.ReportScheduleResponse
 		for _, reportScheduleAdvisor := range reportScheduleAdvisors {
 			// Get activity name
-			activity := s.registrationService.GetRegistrationByID("GET", reportScheduleAdvisor.RegistrationID, token)
-			activityName, ok := activity["activity_name"].(string)
+			activity, err := s.registrationService.GetRegistrationByID(ctx, "GET", reportScheduleAdvisor.RegistrationID, token)
+			if err != nil {
+				return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+			}
 
-			if !ok {
+			activityName := activity.ActivityName
+			if activityName == "" {
 				return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("activity name not found")
 			}
 
@@ -2114,6 +2127,24 @@ func (s *mockReportScheduleService) FindByAdvisorEmail(ctx context.Context, toke
 	}, paginationResponse, nil
 }
 
+func (s *mockReportScheduleService) FindByMonitorEmail(ctx context.Context, token string, pagReq dto.PaginationRequest, reportScheduleRequest dto.ReportScheduleAdvisorRequest) (dto.ReportScheduleByAdvisorResponse, dto.PaginationResponse, error) {
+	user, err := s.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	if user.Email == "" {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, errors.New("monitor email not found")
+	}
+
+	_, _, err = s.reportScheduleRepo.FindByMonitorEmailAndGroupByUserID(ctx, user.Email, nil, &pagReq, reportScheduleRequest.UserNRP, reportScheduleRequest.SubmissionStatus)
+	if err != nil {
+		return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+
+	return dto.ReportScheduleByAdvisorResponse{}, dto.PaginationResponse{}, nil
+}
+
 func (s *mockReportScheduleService) Index(ctx context.Context, token string) (dto.ReportScheduleByAdvisorResponse, error) {
 	reportSchedules, err := s.reportScheduleRepo.Index(ctx, nil)
 	if err != nil {
@@ -2124,9 +2155,13 @@ func (s *mockReportScheduleService) Index(ctx context.Context, token string) (dt
 
 	for userID, reportScheduleAdvisors := range reportSchedules {
 		// get user by user id
-		user := s.userManagementService.GetUserByID("GET", token, userID)
-		userName, ok := user["name"].(string)
-		if !ok {
+		user, err := s.userManagementService.GetUserByID(ctx, "GET", token, userID)
+		if err != nil {
+			return dto.ReportScheduleByAdvisorResponse{}, err
+		}
+
+		userName := user.Name
+		if userName == "" {
 			return dto.ReportScheduleByAdvisorResponse{}, errors.New("user name not found")
 		}
 
@@ -2170,6 +2205,215 @@ func (s *mockReportScheduleService) Index(ctx context.Context, token string) (dt
 	}, nil
 }
 
+func (s *mockReportScheduleService) Generate(ctx context.Context, registrationID string, request dto.ReportScheduleGenerateRequest, token string) (dto.ReportScheduleGenerateResponse, error) {
+	registration, err := s.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration not found")
+	}
+
+	academicAdvisorEmail := registration.AcademicAdvisorEmail
+	if academicAdvisorEmail == "" {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("registration academic advisor email not found")
+	}
+
+	access, err := s.ReportScheduleAccess(ctx, dto.ReportScheduleRequest{AcademicAdvisorEmail: academicAdvisorEmail}, token)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	if !access {
+		return dto.ReportScheduleGenerateResponse{}, errors.New("user role not allowed")
+	}
+
+	startDate, err := time.Parse(time.RFC3339, request.StartDate)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	endDate, err := time.Parse(time.RFC3339, request.EndDate)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	periods, err := helper.GenerateReportPeriods(startDate, endDate, request.Cadence)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	var reportSchedules []entity.ReportSchedule
+	for _, period := range periods {
+		periodStart, periodEnd := period.StartDate, period.EndDate
+		reportSchedules = append(reportSchedules, entity.ReportSchedule{
+			ID:                   uuid.New(),
+			RegistrationID:       registrationID,
+			AcademicAdvisorEmail: academicAdvisorEmail,
+			ReportType:           "WEEKLY_REPORT",
+			Week:                 period.Week,
+			StartDate:            &periodStart,
+			EndDate:              &periodEnd,
+		})
+	}
+
+	if request.IncludeFinalReport {
+		reportSchedules = append(reportSchedules, entity.ReportSchedule{
+			ID:                   uuid.New(),
+			RegistrationID:       registrationID,
+			AcademicAdvisorEmail: academicAdvisorEmail,
+			ReportType:           "FINAL_REPORT",
+			Week:                 helper.ActivityWeeks(startDate, endDate),
+			StartDate:            &startDate,
+			EndDate:              &endDate,
+		})
+	}
+
+	created, skipped, err := s.reportScheduleRepo.CreateForRegistration(ctx, registrationID, reportSchedules, nil)
+	if err != nil {
+		return dto.ReportScheduleGenerateResponse{}, err
+	}
+
+	response := dto.ReportScheduleGenerateResponse{}
+	for _, reportSchedule := range created {
+		response.Created = append(response.Created, dto.ReportScheduleResponse{
+			ID:         reportSchedule.ID.String(),
+			ReportType: reportSchedule.ReportType,
+			Week:       reportSchedule.Week,
+		})
+	}
+	for _, reportSchedule := range skipped {
+		response.Skipped = append(response.Skipped, dto.ReportScheduleResponse{
+			ID:         reportSchedule.ID.String(),
+			ReportType: reportSchedule.ReportType,
+			Week:       reportSchedule.Week,
+		})
+	}
+
+	return response, nil
+}
+
+// Test Generate - Success
+func (suite *ReportScheduleServiceTestSuite) TestGenerate_Success() {
+	// Prepare test data
+	ctx := context.Background()
+	token := "test-token"
+	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
+
+	request := dto.ReportScheduleGenerateRequest{
+		StartDate:          "2025-02-03T00:00:00+07:00",
+		EndDate:            "2025-03-02T23:59:59+07:00",
+		Cadence:            "WEEKLY",
+		IncludeFinalReport: true,
+	}
+
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              "5025211111",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "test@gmail.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
+	}
+
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac2",
+		Role:  "LO-MBKM",
+		Email: "lo@gmail.com",
+	}
+
+	existingWeek := entity.ReportSchedule{
+		ID:             uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		RegistrationID: registrationID,
+		ReportType:     "WEEKLY_REPORT",
+		Week:           1,
+	}
+
+	// Set up expectations
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockReportScheduleRepo.On("CreateForRegistration", ctx, registrationID, mock.MatchedBy(func(reportSchedules []entity.ReportSchedule) bool {
+		// four weekly schedules and one final report
+		return len(reportSchedules) == 5 && reportSchedules[4].ReportType == "FINAL_REPORT" && reportSchedules[3].Week == 4
+	}), mock.Anything).Return([]entity.ReportSchedule{
+		{ID: uuid.New(), RegistrationID: registrationID, ReportType: "WEEKLY_REPORT", Week: 2},
+		{ID: uuid.New(), RegistrationID: registrationID, ReportType: "WEEKLY_REPORT", Week: 3},
+		{ID: uuid.New(), RegistrationID: registrationID, ReportType: "WEEKLY_REPORT", Week: 4},
+		{ID: uuid.New(), RegistrationID: registrationID, ReportType: "FINAL_REPORT", Week: 4},
+	}, []entity.ReportSchedule{existingWeek}, nil)
+
+	// Call the method
+	result, err := suite.service.Generate(ctx, registrationID, request, token)
+
+	// Assertions
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, len(result.Created))
+	assert.Equal(suite.T(), 1, len(result.Skipped))
+	assert.Equal(suite.T(), 1, result.Skipped[0].Week)
+}
+
+// Test Generate - Advisor Not Assigned
+func (suite *ReportScheduleServiceTestSuite) TestGenerate_AdvisorEmailMismatch() {
+	// Prepare test data
+	ctx := context.Background()
+	token := "test-token"
+	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
+
+	registration := dto.Registration{
+		ID:                   registrationID,
+		AcademicAdvisorEmail: "test@gmail.com",
+	}
+
+	usersData := dto.User{
+		Role:  "DOSEN PEMBIMBING",
+		Email: "other@gmail.com",
+	}
+
+	// Set up expectations
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+
+	// Call the method
+	result, err := suite.service.Generate(ctx, registrationID, dto.ReportScheduleGenerateRequest{}, token)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), dto.ReportScheduleGenerateResponse{}, result)
+	suite.mockReportScheduleRepo.AssertNotCalled(suite.T(), "CreateForRegistration", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test Generate - Invalid Cadence
+func (suite *ReportScheduleServiceTestSuite) TestGenerate_InvalidCadence() {
+	// Prepare test data
+	ctx := context.Background()
+	token := "test-token"
+	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
+
+	request := dto.ReportScheduleGenerateRequest{
+		StartDate: "2025-02-03T00:00:00+07:00",
+		EndDate:   "2025-03-02T23:59:59+07:00",
+		Cadence:   "MONTHLY",
+	}
+
+	registration := dto.Registration{
+		ID:                   registrationID,
+		AcademicAdvisorEmail: "test@gmail.com",
+	}
+
+	usersData := dto.User{
+		Role: "ADMIN",
+	}
+
+	// Set up expectations
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+
+	// Call the method
+	_, err := suite.service.Generate(ctx, registrationID, request, token)
+
+	// Assertions
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), "invalid cadence", err.Error())
+}
+
 func TestReportScheduleServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(ReportScheduleServiceTestSuite))
 }
@@ -3,6 +3,7 @@ package service_test
 import (
 	"monitoring-service/dto"
 	"monitoring-service/entity"
+	"monitoring-service/helper"
 	repository_mock "monitoring-service/mocks/repository"
 	service_mock "monitoring-service/mocks/service"
 	"monitoring-service/service"
@@ -24,6 +25,7 @@ type ReportServiceTestSuite struct {
 	suite.Suite
 	mockReportRepo            *repository_mock.MockReportRepository
 	mockReportScheduleRepo    *repository_mock.MockReportScheduleRepository
+	mockReportRevisionRepo    *repository_mock.MockReportRevisionRepository
 	mockUserManagementService *service_mock.MockUserManagementService
 	mockFileService           *service_mock.MockFileService
 	service                   service.ReportService
@@ -32,18 +34,21 @@ type ReportServiceTestSuite struct {
 func (suite *ReportServiceTestSuite) SetupTest() {
 	suite.mockReportRepo = new(repository_mock.MockReportRepository)
 	suite.mockReportScheduleRepo = new(repository_mock.MockReportScheduleRepository)
+	suite.mockReportRevisionRepo = new(repository_mock.MockReportRevisionRepository)
 	suite.mockUserManagementService = new(service_mock.MockUserManagementService)
 	suite.mockFileService = new(service_mock.MockFileService)
 
 	createService := func(
 		reportRepo *repository_mock.MockReportRepository,
 		reportScheduleRepo *repository_mock.MockReportScheduleRepository,
+		reportRevisionRepo *repository_mock.MockReportRevisionRepository,
 		userManagementService *service_mock.MockUserManagementService,
 		fileService *service_mock.MockFileService,
 	) service.ReportService {
 		svc := &mockReportService{
 			reportRepo:            reportRepo,
 			reportScheduleRepo:    reportScheduleRepo,
+			reportRevisionRepo:    reportRevisionRepo,
 			userManagementService: userManagementService,
 			fileService:           fileService,
 		}
@@ -53,6 +58,7 @@ func (suite *ReportServiceTestSuite) SetupTest() {
 	suite.service = createService(
 		suite.mockReportRepo,
 		suite.mockReportScheduleRepo,
+		suite.mockReportRevisionRepo,
 		suite.mockUserManagementService,
 		suite.mockFileService,
 	)
@@ -61,6 +67,7 @@ func (suite *ReportServiceTestSuite) SetupTest() {
 type mockReportService struct {
 	reportRepo            *repository_mock.MockReportRepository
 	reportScheduleRepo    *repository_mock.MockReportScheduleRepository
+	reportRevisionRepo    *repository_mock.MockReportRevisionRepository
 	userManagementService *service_mock.MockUserManagementService
 	fileService           *service_mock.MockFileService
 }
@@ -95,12 +102,20 @@ func (m *mockReportService) Create(ctx context.Context, report dto.ReportRequest
 		return dto.ReportResponse{}, err
 	}
 
-	user := m.userManagementService.GetUserData("GET", token)
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
 
-	if user["id"] != reportSchedule.UserID {
+	if user.ID != reportSchedule.UserID {
 		return dto.ReportResponse{}, errors.New("unauthorized")
 	}
 
+	status, err := helper.ValidateInitialReportStatus(report.Status)
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
+
 	var fileID string
 	if file != nil {
 		result, err := m.fileService.Upload(file, "sim_mbkm", "", "")
@@ -117,7 +132,7 @@ func (m *mockReportService) Create(ctx context.Context, report dto.ReportRequest
 		Title:                 report.Title,
 		Content:               report.Content,
 		ReportType:            report.ReportType,
-		AcademicAdvisorStatus: "PENDING",
+		AcademicAdvisorStatus: status,
 	}
 
 	createdReport, err := m.reportRepo.Create(ctx, reportEntity, nil)
@@ -137,12 +152,25 @@ func (m *mockReportService) Create(ctx context.Context, report dto.ReportRequest
 	}, nil
 }
 
-func (m *mockReportService) Update(ctx context.Context, id string, report dto.ReportRequest) error {
+func (m *mockReportService) Update(ctx context.Context, id string, report dto.ReportRequest, token string) error {
 	res, err := m.reportRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
 	}
 
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return errors.New("unauthorized")
+	}
+
+	status := res.AcademicAdvisorStatus
+	if user.Role == "MAHASISWA" {
+		status, err = helper.ResolveEditedReportStatus(res.AcademicAdvisorStatus, report.Status)
+		if err != nil {
+			return err
+		}
+	}
+
 	reportEntity := entity.Report{
 		ID:                    res.ID,
 		ReportScheduleID:      report.ReportScheduleID,
@@ -151,7 +179,7 @@ func (m *mockReportService) Update(ctx context.Context, id string, report dto.Re
 		ReportType:            report.ReportType,
 		FileStorageID:         res.FileStorageID,
 		Feedback:              res.Feedback,
-		AcademicAdvisorStatus: res.AcademicAdvisorStatus,
+		AcademicAdvisorStatus: status,
 	}
 
 	// Keep original values if request fields are empty
@@ -171,6 +199,105 @@ func (m *mockReportService) Update(ctx context.Context, id string, report dto.Re
 	return m.reportRepo.Update(ctx, id, reportEntity, nil)
 }
 
+func (m *mockReportService) FindRevisions(ctx context.Context, reportID string, token string) ([]dto.ReportRevisionResponse, error) {
+	report, err := m.reportRepo.FindByID(ctx, reportID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := m.reportAccess(ctx, report.ReportScheduleID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !access {
+		return nil, errors.New("unauthorized")
+	}
+
+	reportRevisions, err := m.reportRevisionRepo.FindByReportID(ctx, reportID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reportRevisionResponses := []dto.ReportRevisionResponse{}
+	for _, reportRevision := range reportRevisions {
+		reportRevisionResponses = append(reportRevisionResponses, dto.ReportRevisionResponse{
+			ID:                    reportRevision.ID.String(),
+			ReportID:              reportRevision.ReportID,
+			RevisionNumber:        reportRevision.RevisionNumber,
+			Title:                 reportRevision.Title,
+			Content:               reportRevision.Content,
+			AcademicAdvisorStatus: reportRevision.AcademicAdvisorStatus,
+		})
+	}
+
+	return reportRevisionResponses, nil
+}
+
+func (m *mockReportService) DiffRevisions(ctx context.Context, reportID string, fromRevision int, toRevision int, token string) (dto.ReportRevisionDiffResponse, error) {
+	report, err := m.reportRepo.FindByID(ctx, reportID, nil)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
+	}
+
+	access, err := m.reportAccess(ctx, report.ReportScheduleID, token)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
+	}
+
+	if !access {
+		return dto.ReportRevisionDiffResponse{}, errors.New("unauthorized")
+	}
+
+	from, err := m.reportRevisionRepo.FindByReportIDAndRevisionNumber(ctx, reportID, fromRevision, nil)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
+	}
+
+	to, err := m.reportRevisionRepo.FindByReportIDAndRevisionNumber(ctx, reportID, toRevision, nil)
+	if err != nil {
+		return dto.ReportRevisionDiffResponse{}, err
+	}
+
+	changes := []dto.ReportRevisionFieldChange{}
+	if from.Title != to.Title {
+		changes = append(changes, dto.ReportRevisionFieldChange{Field: "title", From: from.Title, To: to.Title})
+	}
+	if from.Content != to.Content {
+		changes = append(changes, dto.ReportRevisionFieldChange{Field: "content", From: from.Content, To: to.Content, Lines: helper.DiffLines(from.Content, to.Content)})
+	}
+
+	return dto.ReportRevisionDiffResponse{
+		ReportID: reportID,
+		From:     dto.ReportRevisionResponse{RevisionNumber: from.RevisionNumber},
+		To:       dto.ReportRevisionResponse{RevisionNumber: to.RevisionNumber},
+		Changes:  changes,
+	}, nil
+}
+
+func (m *mockReportService) reportAccess(ctx context.Context, reportScheduleID string, token string) (bool, error) {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return false, err
+	}
+
+	reportSchedule, err := m.reportScheduleRepo.FindByID(ctx, reportScheduleID, nil)
+	if err != nil {
+		return false, err
+	}
+
+	switch user.Role {
+	case "DOSEN PEMBIMBING":
+		return user.Email == reportSchedule.AcademicAdvisorEmail, nil
+	case "MAHASISWA":
+		return user.ID == reportSchedule.UserID, nil
+	case "ADMIN", "LO-MBKM":
+		return true, nil
+	}
+
+	return false, errors.New("user role not allowed")
+}
+
 func (m *mockReportService) FindByID(ctx context.Context, id string, token string) (dto.ReportResponse, error) {
 	report, err := m.reportRepo.FindByID(ctx, id, nil)
 	if err != nil {
@@ -182,11 +309,12 @@ func (m *mockReportService) FindByID(ctx context.Context, id string, token strin
 		return dto.ReportResponse{}, err
 	}
 
-	user := m.userManagementService.GetUserData("GET", token)
-	userID, _ := user["id"].(string)
-	userRole, _ := user["role"].(string)
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.ReportResponse{}, err
+	}
 
-	if userRole != "ADMIN" && userID != reportSchedule.UserID {
+	if user.Role != "ADMIN" && user.ID != reportSchedule.UserID {
 		return dto.ReportResponse{}, errors.New("unauthorized")
 	}
 
@@ -202,11 +330,11 @@ func (m *mockReportService) FindByID(ctx context.Context, id string, token strin
 	}, nil
 }
 
-func (m *mockReportService) Destroy(ctx context.Context, id string) error {
+func (m *mockReportService) Destroy(ctx context.Context, id string, token string) error {
 	return m.reportRepo.Destroy(ctx, id, nil)
 }
 
-func (m *mockReportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string) ([]dto.ReportResponse, error) {
+func (m *mockReportService) FindByReportScheduleID(ctx context.Context, reportScheduleID string, token string) ([]dto.ReportResponse, error) {
 	reports, err := m.reportRepo.FindByReportScheduleID(ctx, reportScheduleID, nil)
 	if err != nil {
 		return nil, err
@@ -246,9 +374,13 @@ func (m *mockReportService) Approval(ctx context.Context, token string, report d
 			return err
 		}
 
-		advisor := m.userManagementService.GetUserData("GET", token)
-		advisorEmail, ok := advisor["email"].(string)
-		if !ok {
+		advisor, err := m.userManagementService.GetUserData(ctx, "GET", token)
+		if err != nil {
+			return err
+		}
+
+		advisorEmail := advisor.Email
+		if advisorEmail == "" {
 			return errors.New("advisor email not found")
 		}
 
@@ -256,6 +388,10 @@ func (m *mockReportService) Approval(ctx context.Context, token string, report d
 			return errors.New("unauthorized")
 		}
 
+		if err := helper.ValidateReportStatusTransition(reportEntity.AcademicAdvisorStatus, report.Status); err != nil {
+			return err
+		}
+
 		reportEntity.AcademicAdvisorStatus = report.Status
 		reportEntity.Feedback = report.Feedback
 
@@ -375,16 +511,16 @@ func (suite *ReportServiceTestSuite) TestFindByID_Success() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "user-123",
-		"email": "user@example.com",
-		"role":  "ADMIN",
+	userAuth := dto.User{
+		ID:    "user-123",
+		Email: "user@example.com",
+		Role:  "ADMIN",
 	}
 
 	// Set up expectations
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(report, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -478,16 +614,16 @@ func (suite *ReportServiceTestSuite) TestFindByID_Unauthorized() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "different-user", // Different user ID
-		"email": "user@example.com",
-		"role":  "MAHASISWA", // Not ADMIN or DOSEN
+	userAuth := dto.User{
+		ID:    "different-user", // Different user ID
+		Email: "user@example.com",
+		Role:  "MAHASISWA", // Not ADMIN or DOSEN
 	}
 
 	// Set up expectations
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(report, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -522,10 +658,10 @@ func (suite *ReportServiceTestSuite) TestCreate_SuccessWithFile() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "user-123", // Same as report schedule's UserID
-		"email": "user@example.com",
-		"role":  "MAHASISWA",
+	userAuth := dto.User{
+		ID:    "user-123", // Same as report schedule's UserID
+		Email: "user@example.com",
+		Role:  "MAHASISWA",
 	}
 
 	fileResponse := map[string]interface{}{
@@ -547,7 +683,7 @@ func (suite *ReportServiceTestSuite) TestCreate_SuccessWithFile() {
 	// Set up expectations
 	suite.mockFileService.On("Upload", file, mock.Anything, mock.Anything, mock.Anything).Return(fileResponse, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportRequest.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("Create", ctx, mock.AnythingOfType("entity.Report"), mock.Anything).Return(createdReport, nil)
 
 	// Call the method
@@ -588,10 +724,10 @@ func (suite *ReportServiceTestSuite) TestCreate_SuccessWithoutFile() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "user-123", // Same as report schedule's UserID
-		"email": "user@example.com",
-		"role":  "MAHASISWA",
+	userAuth := dto.User{
+		ID:    "user-123", // Same as report schedule's UserID
+		Email: "user@example.com",
+		Role:  "MAHASISWA",
 	}
 
 	createdReport := entity.Report{
@@ -607,7 +743,7 @@ func (suite *ReportServiceTestSuite) TestCreate_SuccessWithoutFile() {
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportRequest.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("Create", ctx, mock.AnythingOfType("entity.Report"), mock.Anything).Return(createdReport, nil)
 
 	// Call the method
@@ -632,11 +768,11 @@ func (suite *ReportServiceTestSuite) TestCreate_FileUploadError() {
 	token := "test-token"
 	file := &multipart.FileHeader{} // Mock file
 
-	userData := map[string]interface{}{
-		"id":   "9c2fc428-3cca-4c76-a690-e6ba24d135b1",
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
-		"role": "MAHASISWA",
+	userData := dto.User{
+		ID:   "9c2fc428-3cca-4c76-a690-e6ba24d135b1",
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
+		Role: "MAHASISWA",
 	}
 
 	reportRequest := dto.ReportRequest{
@@ -657,7 +793,7 @@ func (suite *ReportServiceTestSuite) TestCreate_FileUploadError() {
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportRequest.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 
 	suite.mockFileService = new(service_mock.MockFileService)
 
@@ -729,15 +865,15 @@ func (suite *ReportServiceTestSuite) TestCreate_Unauthorized() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "different-user", // Different from report schedule's UserID
-		"email": "user@example.com",
-		"role":  "MAHASISWA",
+	userAuth := dto.User{
+		ID:    "different-user", // Different from report schedule's UserID
+		Email: "user@example.com",
+		Role:  "MAHASISWA",
 	}
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportRequest.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 
 	// Call the method
 	result, err := suite.service.Create(ctx, reportRequest, nil, token)
@@ -770,15 +906,15 @@ func (suite *ReportServiceTestSuite) TestCreate_RepositoryError() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "user-123", // Same as report schedule's UserID
-		"email": "user@example.com",
-		"role":  "MAHASISWA",
+	userAuth := dto.User{
+		ID:    "user-123", // Same as report schedule's UserID
+		Email: "user@example.com",
+		Role:  "MAHASISWA",
 	}
 
 	// Set up expectations
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportRequest.ReportScheduleID, mock.Anything).Return(reportSchedule, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("Create", ctx, mock.AnythingOfType("entity.Report"), mock.Anything).Return(entity.Report{}, errors.New("database error"))
 
 	// Call the method
@@ -816,10 +952,11 @@ func (suite *ReportServiceTestSuite) TestUpdate_Success() {
 
 	// Set up expectations
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(existingReport, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", "test-token").Return(dto.User{Role: "ADMIN"}, nil)
 	suite.mockReportRepo.On("Update", ctx, id, mock.AnythingOfType("entity.Report"), mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Update(ctx, id, reportRequest)
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -842,7 +979,7 @@ func (suite *ReportServiceTestSuite) TestUpdate_ReportNotFound() {
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(entity.Report{}, errors.New("record not found"))
 
 	// Call the method
-	err := suite.service.Update(ctx, id, reportRequest)
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -875,10 +1012,11 @@ func (suite *ReportServiceTestSuite) TestUpdate_RepositoryError() {
 
 	// Set up expectations
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(existingReport, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", "test-token").Return(dto.User{Role: "ADMIN"}, nil)
 	suite.mockReportRepo.On("Update", ctx, id, mock.AnythingOfType("entity.Report"), mock.Anything).Return(errors.New("database error"))
 
 	// Call the method
-	err := suite.service.Update(ctx, id, reportRequest)
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -911,6 +1049,7 @@ func (suite *ReportServiceTestSuite) TestUpdate_PartialUpdate() {
 
 	// Set up expectations
 	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(existingReport, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", "test-token").Return(dto.User{Role: "ADMIN"}, nil)
 
 	// Mock the update to verify the expected entity
 	suite.mockReportRepo.On("Update", ctx, id, mock.MatchedBy(func(r entity.Report) bool {
@@ -921,7 +1060,7 @@ func (suite *ReportServiceTestSuite) TestUpdate_PartialUpdate() {
 	}), mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Update(ctx, id, reportRequest)
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -937,7 +1076,7 @@ func (suite *ReportServiceTestSuite) TestDestroy_Success() {
 	suite.mockReportRepo.On("Destroy", ctx, id, mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Destroy(ctx, id)
+	err := suite.service.Destroy(ctx, id, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -953,7 +1092,7 @@ func (suite *ReportServiceTestSuite) TestDestroy_RepositoryError() {
 	suite.mockReportRepo.On("Destroy", ctx, id, mock.Anything).Return(errors.New("database error"))
 
 	// Call the method
-	err := suite.service.Destroy(ctx, id)
+	err := suite.service.Destroy(ctx, id, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -993,7 +1132,7 @@ func (suite *ReportServiceTestSuite) TestFindByReportScheduleID_Success() {
 	suite.mockReportRepo.On("FindByReportScheduleID", ctx, reportScheduleID, mock.Anything).Return(reports, nil)
 
 	// Call the method
-	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID)
+	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1019,7 +1158,7 @@ func (suite *ReportServiceTestSuite) TestFindByReportScheduleID_RepositoryError(
 	suite.mockReportRepo.On("FindByReportScheduleID", ctx, reportScheduleID, mock.Anything).Return([]entity.Report{}, errors.New("database error"))
 
 	// Call the method
-	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID)
+	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1037,7 +1176,7 @@ func (suite *ReportServiceTestSuite) TestFindByReportScheduleID_EmptyResult() {
 	suite.mockReportRepo.On("FindByReportScheduleID", ctx, reportScheduleID, mock.Anything).Return([]entity.Report{}, nil)
 
 	// Call the method
-	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID)
+	result, err := suite.service.FindByReportScheduleID(ctx, reportScheduleID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1096,14 +1235,14 @@ func (suite *ReportServiceTestSuite) TestApproval_Success() {
 		AcademicAdvisorEmail: "advisor@example.com", // Same as user's email
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "advisor-123",
-		"email": "advisor@example.com", // Same as academic advisor email
-		"role":  "DOSEN PEMBIMBING",
+	userAuth := dto.User{
+		ID:    "advisor-123",
+		Email: "advisor@example.com", // Same as academic advisor email
+		Role:  "DOSEN PEMBIMBING",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 
 	// First report
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report1, nil)
@@ -1160,11 +1299,11 @@ func (suite *ReportServiceTestSuite) TestApproval_AdvisorEmailNotFound() {
 		IDs:      []string{"9c2fc428-3cca-4c76-a690-e6ba24d135b5"},
 	}
 
-	userData := map[string]interface{}{
-		"id":   uuid2.String(),
-		"nrp":  "5025211111",
-		"name": "Dimas Fadilah",
-		"role": "MAHASISWA",
+	userData := dto.User{
+		ID:   uuid2.String(),
+		NRP:  "5025211111",
+		Name: "Dimas Fadilah",
+		Role: "MAHASISWA",
 		// "email": "dimas@gmail.com",
 	}
 
@@ -1194,7 +1333,7 @@ func (suite *ReportServiceTestSuite) TestApproval_AdvisorEmailNotFound() {
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(reportEntity, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, reportEntity.ReportScheduleID, mock.Anything).Return(reportScheduleEntity, nil)
 
@@ -1218,14 +1357,14 @@ func (suite *ReportServiceTestSuite) TestApproval_ReportNotFound() {
 		IDs:      []string{"9c2fc428-3cca-4c76-a690-e6ba24d135b5"},
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "advisor-123",
-		"email": "advisor@example.com",
-		"role":  "DOSEN PEMBIMBING",
+	userAuth := dto.User{
+		ID:    "advisor-123",
+		Email: "advisor@example.com",
+		Role:  "DOSEN PEMBIMBING",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(entity.Report{}, errors.New("record not found"))
 
 	// Call the method
@@ -1259,14 +1398,14 @@ func (suite *ReportServiceTestSuite) TestApproval_ReportScheduleNotFound() {
 		FileStorageID:         "file-123",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "advisor-123",
-		"email": "advisor@example.com",
-		"role":  "DOSEN PEMBIMBING",
+	userAuth := dto.User{
+		ID:    "advisor-123",
+		Email: "advisor@example.com",
+		Role:  "DOSEN PEMBIMBING",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, "schedule-1", mock.Anything).Return(entity.ReportSchedule{}, errors.New("record not found"))
 
@@ -1310,14 +1449,14 @@ func (suite *ReportServiceTestSuite) TestApproval_Unauthorized() {
 		AcademicAdvisorEmail: "advisor@example.com",
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "advisor-123",
-		"email": "different@example.com", // Different from academic advisor email
-		"role":  "DOSEN PEMBIMBING",
+	userAuth := dto.User{
+		ID:    "advisor-123",
+		Email: "different@example.com", // Different from academic advisor email
+		Role:  "DOSEN PEMBIMBING",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b1", mock.Anything).Return(schedule, nil)
 
@@ -1361,14 +1500,14 @@ func (suite *ReportServiceTestSuite) TestApproval_RepositoryError() {
 		AcademicAdvisorEmail: "advisor@example.com", // Same as user's email
 	}
 
-	userAuth := map[string]interface{}{
-		"id":    "advisor-123",
-		"email": "advisor@example.com", // Same as academic advisor email
-		"role":  "DOSEN PEMBIMBING",
+	userAuth := dto.User{
+		ID:    "advisor-123",
+		Email: "advisor@example.com", // Same as academic advisor email
+		Role:  "DOSEN PEMBIMBING",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userAuth)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
 	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report, nil)
 	suite.mockReportScheduleRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b3", mock.Anything).Return(schedule, nil)
 	suite.mockReportRepo.On("Approval", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.MatchedBy(func(r entity.Report) bool {
@@ -1383,6 +1522,268 @@ func (suite *ReportServiceTestSuite) TestApproval_RepositoryError() {
 	assert.Equal(suite.T(), "database error", err.Error())
 }
 
+// Test Update - Student cannot edit an approved report
+func (suite *ReportServiceTestSuite) TestUpdate_StudentApprovedReportLocked() {
+	ctx := context.Background()
+	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	reportRequest := dto.ReportRequest{
+		Title: "Updated Test Report",
+	}
+
+	existingReport := entity.Report{
+		ID:                    uuid.MustParse(id),
+		ReportScheduleID:      "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+		Title:                 "Original Test Report",
+		AcademicAdvisorStatus: "APPROVED",
+	}
+
+	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(existingReport, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", "test-token").Return(dto.User{ID: "user-123", Role: "MAHASISWA"}, nil)
+
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
+
+	assert.ErrorIs(suite.T(), err, helper.ErrReportLocked)
+	suite.mockReportRepo.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test Update - Editing after a revision request resubmits the report
+func (suite *ReportServiceTestSuite) TestUpdate_StudentResubmitsRevision() {
+	ctx := context.Background()
+	id := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	reportRequest := dto.ReportRequest{
+		Content: "Revised Test Content",
+	}
+
+	existingReport := entity.Report{
+		ID:                    uuid.MustParse(id),
+		ReportScheduleID:      "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+		Title:                 "Original Test Report",
+		Content:               "Original Test Content",
+		AcademicAdvisorStatus: "REVISION_REQUESTED",
+	}
+
+	suite.mockReportRepo.On("FindByID", ctx, id, mock.Anything).Return(existingReport, nil)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", "test-token").Return(dto.User{ID: "user-123", Role: "MAHASISWA"}, nil)
+	suite.mockReportRepo.On("Update", ctx, id, mock.MatchedBy(func(r entity.Report) bool {
+		return r.AcademicAdvisorStatus == "RESUBMITTED" && r.Content == "Revised Test Content"
+	}), mock.Anything).Return(nil)
+
+	err := suite.service.Update(ctx, id, reportRequest, "test-token")
+
+	assert.NoError(suite.T(), err)
+}
+
+// Test Approval - Unknown status
+func (suite *ReportServiceTestSuite) TestApproval_InvalidStatus() {
+	ctx := context.Background()
+	token := "test-token"
+
+	reportApprovalRequest := dto.ReportApprovalRequest{
+		Status: "APROVED",
+		IDs:    []string{"9c2fc428-3cca-4c76-a690-e6ba24d135b5"},
+	}
+
+	report := entity.Report{
+		ID:                    uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b5"),
+		ReportScheduleID:      "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+		AcademicAdvisorStatus: "PENDING",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID:                   uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		AcademicAdvisorEmail: "advisor@example.com",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(dto.User{Email: "advisor@example.com", Role: "DOSEN PEMBIMBING"}, nil)
+	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b3", mock.Anything).Return(schedule, nil)
+
+	err := suite.service.Approval(ctx, token, reportApprovalRequest)
+
+	assert.ErrorIs(suite.T(), err, helper.ErrInvalidReportStatus)
+	suite.mockReportRepo.AssertNotCalled(suite.T(), "Approval", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test Approval - Approved report cannot be reopened
+func (suite *ReportServiceTestSuite) TestApproval_InvalidTransition() {
+	ctx := context.Background()
+	token := "test-token"
+
+	reportApprovalRequest := dto.ReportApprovalRequest{
+		Status: "REVISION_REQUESTED",
+		IDs:    []string{"9c2fc428-3cca-4c76-a690-e6ba24d135b5"},
+	}
+
+	report := entity.Report{
+		ID:                    uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b5"),
+		ReportScheduleID:      "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+		AcademicAdvisorStatus: "APPROVED",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID:                   uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		AcademicAdvisorEmail: "advisor@example.com",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(dto.User{Email: "advisor@example.com", Role: "DOSEN PEMBIMBING"}, nil)
+	suite.mockReportRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b5", mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, "9c2fc428-3cca-4c76-a690-e6ba24d135b3", mock.Anything).Return(schedule, nil)
+
+	err := suite.service.Approval(ctx, token, reportApprovalRequest)
+
+	var transitionErr *helper.ReportStatusTransitionError
+	assert.ErrorAs(suite.T(), err, &transitionErr)
+	assert.ErrorIs(suite.T(), err, helper.ErrReportStatusConflict)
+	assert.Equal(suite.T(), "APPROVED", transitionErr.From)
+	suite.mockReportRepo.AssertNotCalled(suite.T(), "Approval", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test FindRevisions - Success case
+func (suite *ReportServiceTestSuite) TestFindRevisions_Success() {
+	ctx := context.Background()
+	token := "test-token"
+	reportID := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	report := entity.Report{
+		ID:               uuid.MustParse(reportID),
+		ReportScheduleID: "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID:     uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		UserID: "user-123",
+	}
+
+	revisions := []entity.ReportRevision{
+		{ID: uuid.New(), ReportID: reportID, RevisionNumber: 1, Title: "Draft", AcademicAdvisorStatus: "PENDING"},
+		{ID: uuid.New(), ReportID: reportID, RevisionNumber: 2, Title: "Final", AcademicAdvisorStatus: "APPROVED"},
+	}
+
+	userAuth := dto.User{
+		ID:   "user-123",
+		Role: "MAHASISWA",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
+	suite.mockReportRepo.On("FindByID", ctx, reportID, mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(schedule, nil)
+	suite.mockReportRevisionRepo.On("FindByReportID", ctx, reportID, mock.Anything).Return(revisions, nil)
+
+	result, err := suite.service.FindRevisions(ctx, reportID, token)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result, 2)
+	assert.Equal(suite.T(), 1, result[0].RevisionNumber)
+	assert.Equal(suite.T(), "Final", result[1].Title)
+}
+
+// Test FindRevisions - Unauthorized
+func (suite *ReportServiceTestSuite) TestFindRevisions_Unauthorized() {
+	ctx := context.Background()
+	token := "test-token"
+	reportID := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	report := entity.Report{
+		ID:               uuid.MustParse(reportID),
+		ReportScheduleID: "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID:     uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		UserID: "user-123",
+	}
+
+	userAuth := dto.User{
+		ID:   "different-user",
+		Role: "MAHASISWA",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
+	suite.mockReportRepo.On("FindByID", ctx, reportID, mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(schedule, nil)
+
+	result, err := suite.service.FindRevisions(ctx, reportID, token)
+
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), "unauthorized", err.Error())
+	assert.Nil(suite.T(), result)
+	suite.mockReportRevisionRepo.AssertNotCalled(suite.T(), "FindByReportID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// Test DiffRevisions - Success case
+func (suite *ReportServiceTestSuite) TestDiffRevisions_Success() {
+	ctx := context.Background()
+	token := "test-token"
+	reportID := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	report := entity.Report{
+		ID:               uuid.MustParse(reportID),
+		ReportScheduleID: "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID:                   uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+		AcademicAdvisorEmail: "advisor@example.com",
+	}
+
+	from := entity.ReportRevision{ReportID: reportID, RevisionNumber: 1, Title: "Week 1", Content: "a\nb"}
+	to := entity.ReportRevision{ReportID: reportID, RevisionNumber: 2, Title: "Week 1", Content: "a\nc"}
+
+	userAuth := dto.User{
+		Email: "advisor@example.com",
+		Role:  "DOSEN PEMBIMBING",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
+	suite.mockReportRepo.On("FindByID", ctx, reportID, mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(schedule, nil)
+	suite.mockReportRevisionRepo.On("FindByReportIDAndRevisionNumber", ctx, reportID, 1, mock.Anything).Return(from, nil)
+	suite.mockReportRevisionRepo.On("FindByReportIDAndRevisionNumber", ctx, reportID, 2, mock.Anything).Return(to, nil)
+
+	result, err := suite.service.DiffRevisions(ctx, reportID, 1, 2, token)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), result.Changes, 1)
+	assert.Equal(suite.T(), "content", result.Changes[0].Field)
+	assert.Equal(suite.T(), []dto.DiffLine{
+		{Operation: helper.DIFF_EQUAL, Text: "a"},
+		{Operation: helper.DIFF_DELETE, Text: "b"},
+		{Operation: helper.DIFF_INSERT, Text: "c"},
+	}, result.Changes[0].Lines)
+}
+
+// Test DiffRevisions - Revision Not Found
+func (suite *ReportServiceTestSuite) TestDiffRevisions_RevisionNotFound() {
+	ctx := context.Background()
+	token := "test-token"
+	reportID := "9c2fc428-3cca-4c76-a690-e6ba24d135b5"
+
+	report := entity.Report{
+		ID:               uuid.MustParse(reportID),
+		ReportScheduleID: "9c2fc428-3cca-4c76-a690-e6ba24d135b3",
+	}
+
+	schedule := entity.ReportSchedule{
+		ID: uuid.MustParse("9c2fc428-3cca-4c76-a690-e6ba24d135b3"),
+	}
+
+	userAuth := dto.User{
+		Role: "ADMIN",
+	}
+
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userAuth, nil)
+	suite.mockReportRepo.On("FindByID", ctx, reportID, mock.Anything).Return(report, nil)
+	suite.mockReportScheduleRepo.On("FindByID", ctx, report.ReportScheduleID, mock.Anything).Return(schedule, nil)
+	suite.mockReportRevisionRepo.On("FindByReportIDAndRevisionNumber", ctx, reportID, 1, mock.Anything).Return(entity.ReportRevision{}, errors.New("record not found"))
+
+	_, err := suite.service.DiffRevisions(ctx, reportID, 1, 3, token)
+
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), "record not found", err.Error())
+}
+
 func TestReportServiceTestSuite(t *testing.T) {
 	suite.Run(t, new(ReportServiceTestSuite))
 }
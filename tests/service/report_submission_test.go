@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"monitoring-service/dto"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// A resubmission after the deadline keeps the lateness of the first submission and is never rejected,
+// and the report stays on its schedule
+func TestReportService_ResubmissionKeepsFirstSubmission(t *testing.T) {
+	reportID := uuid.New()
+	reportScheduleID := uuid.NewString()
+	firstSubmittedAt := time.Now().Add(-72 * time.Hour)
+	deadline := time.Now().Add(-48 * time.Hour)
+	student := dto.User{ID: "student-id", Role: helper.ROLE_STUDENT, Email: "student@example.com"}
+
+	report := entity.Report{
+		ID:                    reportID,
+		ReportScheduleID:      reportScheduleID,
+		Title:                 "Week 1",
+		ReportType:            "WEEKLY_REPORT",
+		AcademicAdvisorStatus: helper.REPORT_STATUS_REVISION_REQUESTED,
+		SubmittedAt:           &firstSubmittedAt,
+	}
+
+	reportRepo := new(repository_mock.MockReportRepository)
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	reportRevisionRepo := new(repository_mock.MockReportRevisionRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	advisorNotifications := service_mock.NewMockAdvisorNotificationService()
+	outboxService := service_mock.NewMockOutboxService()
+	auditEventRepo := new(repository_mock.MockAuditEventRepository)
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+
+	reportRepo.On("FindByID", mock.Anything, reportID.String(), mock.Anything).Return(report, nil)
+	reportScheduleRepo.On("FindByID", mock.Anything, reportScheduleID, mock.Anything).Return(entity.ReportSchedule{
+		UserID:     student.ID,
+		ReportType: "WEEKLY_REPORT",
+		EndDate:    &deadline,
+	}, nil)
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(student, nil)
+	advisorAssignments.On("Authorize", mock.Anything, student, helper.POLICY_ACTION_UPDATE, mock.Anything).Return(nil)
+	reportRepo.On("Update", mock.Anything, reportID.String(), mock.MatchedBy(func(updated entity.Report) bool {
+		return updated.AcademicAdvisorStatus == helper.REPORT_STATUS_RESUBMITTED &&
+			updated.SubmittedAt == nil && !updated.IsLate && updated.LateSeconds == 0 &&
+			updated.ReportScheduleID == reportScheduleID
+	}), mock.Anything).Return(nil)
+	reportRevisionRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(entity.ReportRevision{}, nil)
+	auditEventRepo.On("Create", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	outboxService.On("Record", mock.Anything, mock.Anything, dto.DOMAIN_EVENT_REPORT_SUBMITTED, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	advisorNotifications.On("NotifySubmission", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	reportService := service.NewReportService(
+		reportRepo,
+		reportScheduleRepo,
+		reportRevisionRepo,
+		userManagementService,
+		service_mock.NewMockNotificationService(),
+		advisorNotifications,
+		service_mock.NewMockNotificationTemplateService(),
+		outboxService,
+		service.NewAuditService(auditEventRepo),
+		advisorAssignments,
+		nil,
+		helper.LatePolicies{"WEEKLY_REPORT": {Mode: helper.LATE_POLICY_REJECT}},
+	)
+
+	err := reportService.Update(context.Background(), reportID.String(), dto.ReportRequest{ReportScheduleID: "another-schedule-id", Title: "Week 1, revised"}, "token")
+
+	assert.NoError(t, err)
+	reportRepo.AssertExpectations(t)
+	advisorNotifications.AssertExpectations(t)
+}
+
+// Every schedule of a registration is listed with its own latest report, not only the first one
+func TestReportScheduleService_FindByRegistrationIDListsEveryReport(t *testing.T) {
+	advisor := dto.User{ID: "advisor-id", Role: helper.ROLE_ADVISOR, Email: "advisor@example.com"}
+	firstEnd := time.Now().Add(-14 * 24 * time.Hour)
+	secondEnd := time.Now().Add(-7 * 24 * time.Hour)
+	submittedAt := time.Now().Add(-15 * 24 * time.Hour)
+
+	reportSchedules := []entity.ReportSchedule{
+		{ID: uuid.New(), RegistrationID: assignmentRegistrationID, AcademicAdvisorEmail: advisor.Email, Week: 1, EndDate: &firstEnd},
+		{ID: uuid.New(), RegistrationID: assignmentRegistrationID, AcademicAdvisorEmail: advisor.Email, Week: 2, EndDate: &secondEnd},
+	}
+	for i := range reportSchedules {
+		reportSchedules[i].Report = []entity.Report{{
+			ID:                    uuid.New(),
+			ReportScheduleID:      reportSchedules[i].ID.String(),
+			AcademicAdvisorStatus: helper.REPORT_STATUS_PENDING,
+			SubmittedAt:           &submittedAt,
+		}}
+	}
+
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	userManagementService := service_mock.NewMockUserManagementService()
+	advisorAssignments := service_mock.NewMockAdvisorAssignmentService()
+
+	reportScheduleRepo.On("FindByRegistrationID", mock.Anything, assignmentRegistrationID, mock.Anything).Return(reportSchedules, nil)
+	userManagementService.On("CurrentUser", mock.Anything, "token").Return(advisor, nil)
+	advisorAssignments.On("Authorize", mock.Anything, advisor, helper.POLICY_ACTION_VIEW, mock.Anything).Return(nil)
+
+	reportScheduleService := service.NewReportScheduleService(
+		reportScheduleRepo,
+		userManagementService,
+		service_mock.NewMockRegistrationService(),
+		service_mock.NewMockOutboxService(),
+		service.NewAuditService(new(repository_mock.MockAuditEventRepository)),
+		advisorAssignments,
+		helper.ReportScheduleConfig{},
+	)
+
+	responses, err := reportScheduleService.FindByRegistrationID(context.Background(), assignmentRegistrationID, "token")
+
+	assert.NoError(t, err)
+	assert.Len(t, responses, 2)
+	for _, response := range responses {
+		assert.Equal(t, helper.SUBMISSION_STATUS_ON_TIME, response.SubmissionStatus, response.Week)
+		assert.NotNil(t, response.Report, response.Week)
+	}
+}
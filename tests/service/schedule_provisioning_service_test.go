@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"monitoring-service/entity"
+	"monitoring-service/helper"
+	repository_mock "monitoring-service/mocks/repository"
+	service_mock "monitoring-service/mocks/service"
+	"monitoring-service/service"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var scheduleProvisioningTestConfig = helper.ScheduleProvisioningConfig{
+	Enabled:            true,
+	Cadence:            helper.REPORT_CADENCE_WEEKLY,
+	IncludeFinalReport: true,
+}
+
+const approvedEvent = `{
+	"event": "registration.approved",
+	"occurred_at": "2025-02-01T08:00:00Z",
+	"data": {
+		"id": "registration-1",
+		"user_id": "user-1",
+		"user_nrp": "5025211000",
+		"academic_advisor": "advisor-1",
+		"academic_advisor_email": "advisor@example.com",
+		"activity_name": "Internship",
+		"approval_status": true,
+		"activity_start_date": "2025-02-03T00:00:00Z",
+		"activity_end_date": "2025-02-24T00:00:00Z"
+	}
+}`
+
+// newTransactionalOutbox accepts any transaction and any recorded event
+func newTransactionalOutbox() *service_mock.MockOutboxService {
+	outboxService := service_mock.NewMockOutboxService()
+	outboxService.On("Transaction", mock.Anything, mock.Anything).Return(nil)
+	outboxService.On("Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	return outboxService
+}
+
+func TestScheduleProvisioningService_HandleEvent_CreatesSchedules(t *testing.T) {
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	outboxService := newTransactionalOutbox()
+	provisioningService := service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningTestConfig)
+
+	var provisioned []entity.ReportSchedule
+	reportScheduleRepo.On("CreateForRegistration", mock.Anything, "registration-1", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			provisioned = args.Get(2).([]entity.ReportSchedule)
+		}).
+		Return(make([]entity.ReportSchedule, 4), []entity.ReportSchedule{}, nil)
+
+	err := provisioningService.HandleEvent(context.Background(), []byte(approvedEvent))
+
+	assert.NoError(t, err)
+	outboxService.AssertNumberOfCalls(t, "Record", 4)
+	// three weeks of activity plus the final report
+	assert.Len(t, provisioned, 4)
+	assert.Equal(t, "WEEKLY_REPORT", provisioned[0].ReportType)
+	assert.Equal(t, 1, provisioned[0].Week)
+	assert.Equal(t, "FINAL_REPORT", provisioned[3].ReportType)
+	for _, reportSchedule := range provisioned {
+		assert.Equal(t, "5025211000", reportSchedule.UserNRP)
+		assert.Equal(t, "advisor-1", reportSchedule.AcademicAdvisorID)
+		assert.Equal(t, "advisor@example.com", reportSchedule.AcademicAdvisorEmail)
+	}
+	reportScheduleRepo.AssertExpectations(t)
+}
+
+func TestScheduleProvisioningService_HandleEvent_Redelivered(t *testing.T) {
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	outboxService := newTransactionalOutbox()
+	provisioningService := service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningTestConfig)
+
+	existing := []entity.ReportSchedule{{RegistrationID: "registration-1", ReportType: "WEEKLY_REPORT", Week: 1}}
+	reportScheduleRepo.On("CreateForRegistration", mock.Anything, "registration-1", mock.Anything, mock.Anything).
+		Return([]entity.ReportSchedule{}, existing, nil)
+
+	err := provisioningService.HandleEvent(context.Background(), []byte(approvedEvent))
+
+	assert.NoError(t, err)
+	// a redelivery creates nothing, so it publishes nothing either
+	outboxService.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScheduleProvisioningService_HandleEvent_Malformed(t *testing.T) {
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	outboxService := newTransactionalOutbox()
+	provisioningService := service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningTestConfig)
+
+	bodies := []string{
+		`not json`,
+		`{"event": "registration.approved", "data": {"id": "registration-1", "user_id": "user-1", "user_nrp": "5025211000", "academic_advisor_email": "advisor@example.com"}}`,
+		`{"event": "registration.approved", "data": {"id": "registration-1", "user_id": "user-1", "user_nrp": "5025211000", "academic_advisor_email": "advisor@example.com",
+			"activity_start_date": "2025-02-24T00:00:00Z", "activity_end_date": "2025-02-03T00:00:00Z"}}`,
+	}
+	for _, body := range bodies {
+		err := provisioningService.HandleEvent(context.Background(), []byte(body))
+		assert.ErrorIs(t, err, helper.ErrMalformedMessage)
+	}
+	reportScheduleRepo.AssertNotCalled(t, "CreateForRegistration", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScheduleProvisioningService_HandleEvent_IgnoresOtherEvents(t *testing.T) {
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	outboxService := newTransactionalOutbox()
+	provisioningService := service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningTestConfig)
+
+	err := provisioningService.HandleEvent(context.Background(), []byte(`{"event": "registration.updated", "data": {"id": "registration-1"}}`))
+
+	assert.NoError(t, err)
+	reportScheduleRepo.AssertNotCalled(t, "CreateForRegistration", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScheduleProvisioningService_HandleEvent_RepositoryError(t *testing.T) {
+	reportScheduleRepo := new(repository_mock.MockReportScheduleRepository)
+	outboxService := newTransactionalOutbox()
+	provisioningService := service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningTestConfig)
+
+	reportScheduleRepo.On("CreateForRegistration", mock.Anything, "registration-1", mock.Anything, mock.Anything).
+		Return([]entity.ReportSchedule{}, []entity.ReportSchedule{}, errors.New("database error"))
+
+	err := provisioningService.HandleEvent(context.Background(), []byte(approvedEvent))
+
+	// transient failures are requeued rather than dead-lettered
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, helper.ErrMalformedMessage)
+}
@@ -110,22 +110,22 @@ func (m *mockSyllabusService) Create(ctx context.Context, syllabus dto.SyllabusR
 	}
 
 	// Verify user has access to this registration
-	user := m.userManagementService.GetUserData("GET", token)
-	if user == nil {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	registration := m.registrationService.GetRegistrationByID("GET", syllabus.RegistrationID, token)
-	if registration == nil {
+	registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", syllabus.RegistrationID, token)
+	if err != nil {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	userID, ok := registration["user_id"].(string)
-	if !ok {
+	userID := registration.UserID
+	if userID == "" {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	if userID != user["id"] {
+	if userID != user.ID {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
@@ -133,9 +133,9 @@ func (m *mockSyllabusService) Create(ctx context.Context, syllabus dto.SyllabusR
 	var syllabusEntity entity.Syllabus
 	syllabusEntity.ID = uuid.New()
 	syllabusEntity.UserID = userID
-	syllabusEntity.UserNRP = registration["user_nrp"].(string)
-	syllabusEntity.AcademicAdvisorID = registration["academic_advisor"].(string)
-	syllabusEntity.AcademicAdvisorEmail = registration["academic_advisor_email"].(string)
+	syllabusEntity.UserNRP = registration.UserNRP
+	syllabusEntity.AcademicAdvisorID = registration.AcademicAdvisor
+	syllabusEntity.AcademicAdvisorEmail = registration.AcademicAdvisorEmail
 	syllabusEntity.RegistrationID = syllabus.RegistrationID
 	syllabusEntity.Title = syllabus.Title
 	syllabusEntity.FileStorageID = result["file_id"].(string)
@@ -163,7 +163,7 @@ func (m *mockSyllabusService) Create(ctx context.Context, syllabus dto.SyllabusR
 	}, nil
 }
 
-func (m *mockSyllabusService) Update(ctx context.Context, id string, subject dto.SyllabusRequest) error {
+func (m *mockSyllabusService) Update(ctx context.Context, id string, subject dto.SyllabusRequest, token string) error {
 	res, err := m.syllabusRepo.FindByID(ctx, id, nil)
 	if err != nil {
 		return err
@@ -211,24 +211,24 @@ func (m *mockSyllabusService) FindByID(ctx context.Context, id string, token str
 		return dto.SyllabusResponse{}, err
 	}
 
-	user := m.userManagementService.GetUserData("GET", token)
-	if user == nil {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
-	userRole, ok := user["role"].(string)
-	if !ok {
+	userRole := user.Role
+	if userRole == "" {
 		return dto.SyllabusResponse{}, errors.New("unauthorized")
 	}
 
 	if userRole == "DOSEN PEMBIMBING" {
-		userEmail, ok := user["email"].(string)
-		if !ok || syllabus.AcademicAdvisorEmail != userEmail {
+		userEmail := user.Email
+		if userEmail == "" || syllabus.AcademicAdvisorEmail != userEmail {
 			return dto.SyllabusResponse{}, errors.New("unauthorized")
 		}
 	} else if userRole == "MAHASISWA" {
-		userNRP, ok := user["nrp"].(string)
-		if !ok || syllabus.UserNRP != userNRP {
+		userNRP := user.NRP
+		if userNRP == "" || syllabus.UserNRP != userNRP {
 			return dto.SyllabusResponse{}, errors.New("unauthorized")
 		}
 	} else if userRole != "ADMIN" && userRole != "LO-MBKM" {
@@ -247,7 +247,7 @@ func (m *mockSyllabusService) FindByID(ctx context.Context, id string, token str
 	}, nil
 }
 
-func (m *mockSyllabusService) Destroy(ctx context.Context, id string) error {
+func (m *mockSyllabusService) Destroy(ctx context.Context, id string, token string) error {
 	err := m.syllabusRepo.Destroy(ctx, id, nil)
 	if err != nil {
 		return err
@@ -256,7 +256,7 @@ func (m *mockSyllabusService) Destroy(ctx context.Context, id string) error {
 	return nil
 }
 
-func (m *mockSyllabusService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.SyllabusResponse, error) {
+func (m *mockSyllabusService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.SyllabusResponse, error) {
 	syllabus, err := m.syllabusRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return dto.SyllabusResponse{}, err
@@ -274,7 +274,7 @@ func (m *mockSyllabusService) FindByRegistrationID(ctx context.Context, registra
 	}, nil
 }
 
-func (m *mockSyllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.SyllabusResponse, error) {
+func (m *mockSyllabusService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.SyllabusResponse, error) {
 	syllabuses, err := m.syllabusRepo.FindAllByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
@@ -298,9 +298,12 @@ func (m *mockSyllabusService) FindAllByRegistrationID(ctx context.Context, regis
 }
 
 func (m *mockSyllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.SyllabusAdvisorFilterRequest) (dto.SyllabusAdvisorResponse, dto.PaginationResponse, error) {
-	user := m.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.SyllabusAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
@@ -312,14 +315,11 @@ func (m *mockSyllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Co
 	syllabusResponses := make(map[string][]dto.SyllabusResponse)
 	for userNRP, syllabus := range syllabuses {
 		// Get registration details to add activity name
-		registration := m.registrationService.GetRegistrationByID("GET", syllabus.RegistrationID, token)
+		registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", syllabus.RegistrationID, token)
 
 		var activityName string
-		if registration != nil {
-			activityNameVal, ok := registration["activity_name"].(string)
-			if ok {
-				activityName = activityNameVal
-			}
+		if err == nil {
+			activityName = registration.ActivityName
 		}
 
 		syllabusResponses[userNRP] = append(syllabusResponses[userNRP], dto.SyllabusResponse{
@@ -349,10 +349,13 @@ func (m *mockSyllabusService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Co
 }
 
 func (m *mockSyllabusService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.SyllabusByStudentResponse, error) {
-	user := m.userManagementService.GetUserData("GET", token)
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.SyllabusByStudentResponse{}, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return dto.SyllabusByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -364,17 +367,17 @@ func (m *mockSyllabusService) FindByUserNRPAndGroupByRegistrationID(ctx context.
 	syllabusResponses := make(map[string][]dto.SyllabusResponse)
 
 	for registrationID, syllabusList := range syllabuses {
-		registration := m.registrationService.GetRegistrationByID("GET", registrationID, token)
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
-			return dto.SyllabusByStudentResponse{}, errors.New("registration activity name not found")
+		registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+		if err != nil {
+			return dto.SyllabusByStudentResponse{}, err
 		}
 
-		approvalStatus, ok := registration["approval_status"].(bool)
-		if !ok {
-			return dto.SyllabusByStudentResponse{}, errors.New("registration approval status not found")
+		registrationActivityName := registration.ActivityName
+		if registrationActivityName == "" {
+			return dto.SyllabusByStudentResponse{}, errors.New("registration activity name not found")
 		}
 
+		approvalStatus := registration.ApprovalStatus
 		if !approvalStatus {
 			continue
 		}
@@ -505,22 +508,22 @@ func (suite *SyllabusServiceTestSuite) TestCreate_Success() {
 		Size:     1024,
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               "5025211111",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@example.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        true,
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              "5025211111",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 
 	fileUploadResult := map[string]interface{}{
@@ -545,8 +548,8 @@ func (suite *SyllabusServiceTestSuite) TestCreate_Success() {
 	// Set up expectations
 	suite.mockSyllabusRepo.On("FindByRegistrationID", ctx, syllabusRequest.RegistrationID, mock.Anything).Return(entity.Syllabus{}, errors.New("record not found"))
 	suite.mockFileService.On("Upload", file, "sim_mbkm", "", "").Return(fileUploadResult, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", syllabusRequest.RegistrationID, token).Return(registration)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", syllabusRequest.RegistrationID, token).Return(registration, nil)
 	suite.mockSyllabusRepo.On("Create", ctx, mock.AnythingOfType("entity.Syllabus"), mock.Anything).Return(syllabusEntity, nil)
 
 	// Call the method
@@ -667,22 +670,22 @@ func (suite *SyllabusServiceTestSuite) TestCreate_Unauthorized() {
 		Size:     1024,
 	}
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "Dimas Fadilah",
-		"role":  "MAHASISWA",
-		"email": "dimasfadilah20@gmail.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "Dimas Fadilah",
+		Role:  "MAHASISWA",
+		Email: "dimasfadilah20@gmail.com",
 	}
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "different-user-id", // Different user ID
-		"user_nrp":               "5025211111",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@example.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        true,
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "different-user-id", // Different user ID
+		UserNRP:              "5025211111",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 
 	fileUploadResult := map[string]interface{}{
@@ -692,8 +695,8 @@ func (suite *SyllabusServiceTestSuite) TestCreate_Unauthorized() {
 	// Set up expectations
 	suite.mockSyllabusRepo.On("FindByRegistrationID", ctx, syllabusRequest.RegistrationID, mock.Anything).Return(entity.Syllabus{}, errors.New("record not found"))
 	suite.mockFileService.On("Upload", file, "sim_mbkm", "", "").Return(fileUploadResult, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", syllabusRequest.RegistrationID, token).Return(registration)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", syllabusRequest.RegistrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.Create(ctx, syllabusRequest, file, token)
@@ -712,12 +715,12 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_S
 	advisorEmail := "advisor@example.com"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "1001",
-		"name":  "Advisor User",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "1001",
+		Name:  "Advisor User",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	pagReq := dto.PaginationRequest{
@@ -746,22 +749,22 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_S
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               userNRP,
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": advisorEmail,
-		"activity_name":          "Test Activity",
-		"approval_status":        true,
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              userNRP,
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: advisorEmail,
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 
 	totalCount := int64(1)
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockSyllabusRepo.On("FindByAdvisorEmailAndGroupByUserNRP", ctx, advisorEmail, mock.Anything, &pagReq, userNRP).Return(syllabusesByNRP, totalCount, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmailAndGroupByUserNRP(ctx, token, pagReq, filter)
@@ -789,11 +792,11 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_A
 	ctx := context.Background()
 	token := "test-token"
 
-	usersData := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":  "1001",
-		"name": "Advisor User",
-		"role": "DOSEN PEMBIMBING",
+	usersData := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:  "1001",
+		Name: "Advisor User",
+		Role: "DOSEN PEMBIMBING",
 		// Email is missing
 	}
 
@@ -807,7 +810,7 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_A
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmailAndGroupByUserNRP(ctx, token, pagReq, filter)
@@ -826,12 +829,12 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_R
 	token := "test-token"
 	advisorEmail := "advisor@example.com"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "1001",
-		"name":  "Advisor User",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "1001",
+		Name:  "Advisor User",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	pagReq := dto.PaginationRequest{
@@ -844,7 +847,7 @@ func (suite *SyllabusServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP_R
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockSyllabusRepo.On("FindByAdvisorEmailAndGroupByUserNRP", ctx, advisorEmail, mock.Anything, &pagReq, filter.UserNRP).Return(map[string]entity.Syllabus{}, int64(0), errors.New("database error"))
 
 	// Call the method
@@ -864,12 +867,12 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Student User",
-		"role":  "MAHASISWA",
-		"email": "student@example.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Student User",
+		Role:  "MAHASISWA",
+		Email: "student@example.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -892,20 +895,20 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               userNRP,
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@example.com",
-		"activity_name":          "Test Activity",
-		"approval_status":        true,
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              userNRP,
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@example.com",
+		ActivityName:         "Test Activity",
+		ApprovalStatus:       true,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockSyllabusRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(syllabuses, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -925,16 +928,16 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 	ctx := context.Background()
 	token := "test-token"
 
-	usersData := map[string]interface{}{
-		"id": "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+	usersData := dto.User{
+		ID: "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
 		// NRP is missing
-		"name":  "Student User",
-		"role":  "MAHASISWA",
-		"email": "student@example.com",
+		Name:  "Student User",
+		Role:  "MAHASISWA",
+		Email: "student@example.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -952,16 +955,16 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Student User",
-		"role":  "MAHASISWA",
-		"email": "student@example.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Student User",
+		Role:  "MAHASISWA",
+		Email: "student@example.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockSyllabusRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(map[string][]entity.Syllabus{}, errors.New("database error"))
 
 	// Call the method
@@ -980,12 +983,12 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 	token := "test-token"
 	userNRP := "5025211111"
 
-	usersData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "Student User",
-		"role":  "MAHASISWA",
-		"email": "student@example.com",
+	usersData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "Student User",
+		Role:  "MAHASISWA",
+		Email: "student@example.com",
 	}
 
 	registrationID := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -1004,20 +1007,20 @@ func (suite *SyllabusServiceTestSuite) TestFindByUserNRPAndGroupByRegistrationID
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     registrationID,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               userNRP,
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@example.com",
+	registration := dto.Registration{
+		ID:                   registrationID,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              userNRP,
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@example.com",
 		// Activity name is missing
-		"approval_status": true,
+		ApprovalStatus: true,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(usersData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(usersData, nil)
 	suite.mockSyllabusRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(syllabuses, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID, token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID, token).Return(registration, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -0,0 +1,318 @@
+package service_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var verifierClaimNames = helper.JWTClaimNames{UserID: "sub", Email: "email", NRP: "nrp", Role: "role", Name: "name"}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, keyID string, claims map[string]interface{}) string {
+	segment := func(value interface{}) string {
+		data, _ := json.Marshal(value)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	input := segment(map[string]string{"alg": "RS256", "kid": keyID}) + "." + segment(claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+
+	return "Bearer " + input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func advisorClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "0f3a4c1e-d5d6-4b8e-9a51-6f7f2b7c2d10",
+		"email": "advisor@its.ac.id",
+		"role":  helper.ROLE_ADVISOR,
+		"name":  "Advisor",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+// jwksServer serves the public keys it currently holds and counts the fetches
+type jwksServer struct {
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+	down    bool
+	hold    chan struct{}
+	server  *httptest.Server
+}
+
+func newJWKSServer(keys map[string]*rsa.PrivateKey) *jwksServer {
+	s := &jwksServer{keys: keys}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.fetches++
+		hold := s.hold
+		s.mu.Unlock()
+		if hold != nil {
+			<-hold
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.down {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		var jwks []map[string]string
+		for kid, key := range s.keys {
+			jwks = append(jwks, map[string]string{
+				"kty": "RSA",
+				"kid": kid,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": jwks})
+	}))
+	return s
+}
+
+func (s *jwksServer) rotate(keys map[string]*rsa.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down = down
+}
+
+// stall keeps every following request waiting until the returned channel is closed
+func (s *jwksServer) stall() chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hold = make(chan struct{})
+	return s.hold
+}
+
+func (s *jwksServer) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+func jwksConfig(url string) helper.JWTConfig {
+	return helper.JWTConfig{
+		JWKSURL:                url,
+		JWKSRefreshInterval:    time.Hour,
+		JWKSMinRefreshInterval: 0,
+		Leeway:                 30 * time.Second,
+		RemoteFallback:         true,
+		Claims:                 verifierClaimNames,
+	}
+}
+
+func newTokenVerifier(t *testing.T, config helper.JWTConfig, downstreamConfig helper.DownstreamConfig) service.TokenVerifier {
+	verifier, err := service.NewTokenVerifier(config, downstreamConfig)
+	assert.NoError(t, err)
+	return verifier
+}
+
+func TestTokenVerifier_StaticPublicKey(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+
+	verifier := newTokenVerifier(t, helper.JWTConfig{
+		PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+		Claims:    verifierClaimNames,
+	}, helper.DownstreamConfig{Timeout: time.Second})
+
+	principal, err := verifier.Verify(context.Background(), signToken(t, key, "", advisorClaims()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Principal{
+		UserID: "0f3a4c1e-d5d6-4b8e-9a51-6f7f2b7c2d10",
+		Email:  "advisor@its.ac.id",
+		Role:   helper.ROLE_ADVISOR,
+		Name:   "Advisor",
+	}, principal)
+	assert.Equal(t, principal.UserID, principal.User().ID)
+}
+
+func TestTokenVerifier_JWKSCachedAndRotated(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"old": oldKey})
+	defer jwks.server.Close()
+
+	verifier := newTokenVerifier(t, jwksConfig(jwks.server.URL), helper.DownstreamConfig{Timeout: time.Second})
+
+	for i := 0; i < 3; i++ {
+		_, err := verifier.Verify(context.Background(), signToken(t, oldKey, "old", advisorClaims()))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, jwks.fetchCount())
+
+	// the issuer rotates its key, the first token naming the new key triggers a refetch
+	jwks.rotate(map[string]*rsa.PrivateKey{"old": oldKey, "new": newKey})
+
+	_, err := verifier.Verify(context.Background(), signToken(t, newKey, "new", advisorClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, jwks.fetchCount())
+
+	// a key the issuer does not publish is rejected
+	_, err = verifier.Verify(context.Background(), signToken(t, newKey, "forged", advisorClaims()))
+	assert.True(t, errors.Is(err, helper.ErrInvalidToken))
+	assert.True(t, errors.Is(err, helper.ErrUnknownSigningKey))
+}
+
+func TestTokenVerifier_UnknownKeyRefetchThrottled(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"current": key})
+	defer jwks.server.Close()
+
+	config := jwksConfig(jwks.server.URL)
+	config.JWKSMinRefreshInterval = time.Hour
+	verifier := newTokenVerifier(t, config, helper.DownstreamConfig{Timeout: time.Second})
+
+	for i := 0; i < 5; i++ {
+		_, err := verifier.Verify(context.Background(), signToken(t, key, "made-up", advisorClaims()))
+		assert.True(t, errors.Is(err, helper.ErrUnknownSigningKey))
+	}
+
+	assert.Equal(t, 1, jwks.fetchCount())
+}
+
+func TestTokenVerifier_KeepsKeysWhenRefetchFails(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"current": key})
+	defer jwks.server.Close()
+
+	config := jwksConfig(jwks.server.URL)
+	config.JWKSRefreshInterval = 0
+	verifier := newTokenVerifier(t, config, helper.DownstreamConfig{Timeout: time.Second})
+
+	_, err := verifier.Verify(context.Background(), signToken(t, key, "current", advisorClaims()))
+	assert.NoError(t, err)
+
+	jwks.setDown(true)
+
+	_, err = verifier.Verify(context.Background(), signToken(t, key, "current", advisorClaims()))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, jwks.fetchCount())
+}
+
+// A slow refetch triggered by an unknown key does not hold up tokens signed with a known key
+func TestTokenVerifier_RefetchDoesNotBlockKnownKeys(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"current": key})
+	defer jwks.server.Close()
+
+	verifier := newTokenVerifier(t, jwksConfig(jwks.server.URL), helper.DownstreamConfig{Timeout: 5 * time.Second})
+
+	_, err := verifier.Verify(context.Background(), signToken(t, key, "current", advisorClaims()))
+	assert.NoError(t, err)
+
+	release := jwks.stall()
+	defer close(release)
+
+	go verifier.Verify(context.Background(), signToken(t, key, "rotated", advisorClaims()))
+	assert.Eventually(t, func() bool { return jwks.fetchCount() == 2 }, 2*time.Second, 10*time.Millisecond)
+
+	verified := make(chan error, 1)
+	go func() {
+		_, err := verifier.Verify(context.Background(), signToken(t, key, "current", advisorClaims()))
+		verified <- err
+	}()
+
+	select {
+	case err := <-verified:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("verifying a known key waited for the JWKS refetch")
+	}
+}
+
+func TestTokenVerifier_RemoteFallback(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"current": key})
+	defer jwks.server.Close()
+
+	withoutRole := advisorClaims()
+	delete(withoutRole, "role")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"opaque token", "Bearer 4|opaque-session-token"},
+		{"missing claims", signToken(t, key, "current", withoutRole)},
+	}
+
+	for _, test := range tests {
+		verifier := newTokenVerifier(t, jwksConfig(jwks.server.URL), helper.DownstreamConfig{Timeout: time.Second})
+		_, err := verifier.Verify(context.Background(), test.token)
+		assert.True(t, errors.Is(err, helper.ErrRemoteLookupRequired), test.name)
+
+		config := jwksConfig(jwks.server.URL)
+		config.RemoteFallback = false
+		verifier = newTokenVerifier(t, config, helper.DownstreamConfig{Timeout: time.Second})
+		_, err = verifier.Verify(context.Background(), test.token)
+		assert.True(t, errors.Is(err, helper.ErrInvalidToken), test.name)
+	}
+
+	// without keys configured every token is looked up remotely
+	verifier := newTokenVerifier(t, helper.JWTConfig{Claims: verifierClaimNames}, helper.DownstreamConfig{Timeout: time.Second})
+	_, err := verifier.Verify(context.Background(), signToken(t, key, "current", advisorClaims()))
+	assert.True(t, errors.Is(err, helper.ErrRemoteLookupRequired))
+}
+
+func TestTokenVerifier_ExpiredTokenRejectedDespiteFallback(t *testing.T) {
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	jwks := newJWKSServer(map[string]*rsa.PrivateKey{"current": key})
+	defer jwks.server.Close()
+
+	claims := advisorClaims()
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+
+	verifier := newTokenVerifier(t, jwksConfig(jwks.server.URL), helper.DownstreamConfig{Timeout: time.Second})
+	_, err := verifier.Verify(context.Background(), signToken(t, key, "current", claims))
+
+	assert.True(t, errors.Is(err, helper.ErrInvalidToken))
+	assert.False(t, errors.Is(err, helper.ErrRemoteLookupRequired))
+}
+
+// A key that is configured but unusable stops the service from starting
+func TestTokenVerifier_UnusablePublicKey(t *testing.T) {
+	_, err := service.NewTokenVerifier(helper.JWTConfig{
+		PublicKey: "-----BEGIN PUBLIC KEY-----\nbm90IGEga2V5\n-----END PUBLIC KEY-----\n",
+		Claims:    verifierClaimNames,
+	}, helper.DownstreamConfig{Timeout: time.Second})
+	assert.Error(t, err)
+
+	_, err = service.NewTokenVerifier(helper.JWTConfig{
+		PublicKeyFile: filepath.Join(t.TempDir(), "missing.pem"),
+		Claims:        verifierClaimNames,
+	}, helper.DownstreamConfig{Timeout: time.Second})
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
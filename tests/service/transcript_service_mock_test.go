@@ -114,31 +114,31 @@ func (m *mockTranscriptService) Create(ctx context.Context, transcript dto.Trans
 	m.fileService.On("Upload", file, "sim_mbkm", "", mock.Anything).Return(fileResult, nil)
 
 	// Get user data for authorization
-	user := m.userManagementService.GetUserData("GET", token)
-	if user == nil {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
 	// Get registration data
-	registration := m.registrationService.GetRegistrationByID("GET", transcript.RegistrationID, token)
-	if registration == nil {
+	registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", transcript.RegistrationID, token)
+	if err != nil {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
 	// Check if user has access
-	userID, ok := registration["user_id"].(string)
-	if !ok {
+	userID := registration.UserID
+	if userID == "" {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
-	if userID != user["id"] {
+	if userID != user.ID {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
 	// Create transcript entity
-	userNRP, _ := registration["user_nrp"].(string)
-	academicAdvisorID, _ := registration["academic_advisor"].(string)
-	academicAdvisorEmail, _ := registration["academic_advisor_email"].(string)
+	userNRP := registration.UserNRP
+	academicAdvisorID := registration.AcademicAdvisor
+	academicAdvisorEmail := registration.AcademicAdvisorEmail
 
 	var transcriptEntity entity.Transcript
 	transcriptEntity.ID = uuid.New()
@@ -174,7 +174,7 @@ func (m *mockTranscriptService) Create(ctx context.Context, transcript dto.Trans
 }
 
 // Update method implementation for mock service
-func (m *mockTranscriptService) Update(ctx context.Context, id string, subject dto.TranscriptRequest) error {
+func (m *mockTranscriptService) Update(ctx context.Context, id string, subject dto.TranscriptRequest, token string) error {
 	// Find the existing transcript
 	transcript, err := m.transcriptRepo.FindByID(ctx, id, nil)
 	if err != nil {
@@ -229,14 +229,14 @@ func (m *mockTranscriptService) FindByID(ctx context.Context, id string, token s
 	}
 
 	// Check authorization
-	user := m.userManagementService.GetUserData("GET", token)
-	if user == nil {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
 		return dto.TranscriptResponse{}, errors.New("unauthorized")
 	}
 
 	// Get user role
-	userRole, ok := user["role"].(string)
-	if !ok {
+	userRole := user.Role
+	if userRole == "" {
 		return dto.TranscriptResponse{}, errors.New("user role not found")
 	}
 
@@ -257,8 +257,8 @@ func (m *mockTranscriptService) FindByID(ctx context.Context, id string, token s
 
 	// For academic advisors, check if they are assigned to this transcript
 	if userRole == "DOSEN PEMBIMBING" {
-		userEmail, ok := user["email"].(string)
-		if !ok {
+		userEmail := user.Email
+		if userEmail == "" {
 			return dto.TranscriptResponse{}, errors.New("user email not found")
 		}
 
@@ -279,8 +279,8 @@ func (m *mockTranscriptService) FindByID(ctx context.Context, id string, token s
 
 	// For students, check if the transcript belongs to them
 	if userRole == "MAHASISWA" {
-		userID, ok := user["id"].(string)
-		if !ok {
+		userID := user.ID
+		if userID == "" {
 			return dto.TranscriptResponse{}, errors.New("user ID not found")
 		}
 
@@ -304,7 +304,7 @@ func (m *mockTranscriptService) FindByID(ctx context.Context, id string, token s
 }
 
 // Destroy method implementation for mock service
-func (m *mockTranscriptService) Destroy(ctx context.Context, id string) error {
+func (m *mockTranscriptService) Destroy(ctx context.Context, id string, token string) error {
 	err := m.transcriptRepo.Destroy(ctx, id, nil)
 	if err != nil {
 		return err
@@ -313,7 +313,7 @@ func (m *mockTranscriptService) Destroy(ctx context.Context, id string) error {
 }
 
 // FindByRegistrationID method implementation for mock service
-func (m *mockTranscriptService) FindByRegistrationID(ctx context.Context, registrationID string) (dto.TranscriptResponse, error) {
+func (m *mockTranscriptService) FindByRegistrationID(ctx context.Context, registrationID string, token string) (dto.TranscriptResponse, error) {
 	transcript, err := m.transcriptRepo.FindByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return dto.TranscriptResponse{}, err
@@ -332,7 +332,7 @@ func (m *mockTranscriptService) FindByRegistrationID(ctx context.Context, regist
 }
 
 // FindAllByRegistrationID method implementation for mock service
-func (m *mockTranscriptService) FindAllByRegistrationID(ctx context.Context, registrationID string) ([]dto.TranscriptResponse, error) {
+func (m *mockTranscriptService) FindAllByRegistrationID(ctx context.Context, registrationID string, token string) ([]dto.TranscriptResponse, error) {
 	transcripts, err := m.transcriptRepo.FindAllByRegistrationID(ctx, registrationID, nil)
 	if err != nil {
 		return nil, err
@@ -357,9 +357,12 @@ func (m *mockTranscriptService) FindAllByRegistrationID(ctx context.Context, reg
 
 // FindByAdvisorEmailAndGroupByUserNRP method implementation for mock service
 func (m *mockTranscriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.Context, token string, pagReq dto.PaginationRequest, filter dto.TranscriptAdvisorFilterRequest) (dto.TranscriptAdvisorResponse, dto.PaginationResponse, error) {
-	user := m.userManagementService.GetUserData("GET", token)
-	advisorEmail, ok := user["email"].(string)
-	if !ok {
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, err
+	}
+	advisorEmail := user.Email
+	if advisorEmail == "" {
 		return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("advisor email not found")
 	}
 
@@ -371,13 +374,13 @@ func (m *mockTranscriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.
 	transcriptResponses := make(map[string][]dto.TranscriptResponse)
 	for userNRP, transcript := range transcripts {
 		// get registration by registration id
-		registration := m.registrationService.GetRegistrationByID("GET", transcript.RegistrationID, token)
-		if registration == nil {
-			return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("registration not found")
+		registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", transcript.RegistrationID, token)
+		if err != nil {
+			return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, err
 		}
 
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
+		registrationActivityName := registration.ActivityName
+		if registrationActivityName == "" {
 			return dto.TranscriptAdvisorResponse{}, dto.PaginationResponse{}, errors.New("registration activity name not found")
 		}
 
@@ -409,10 +412,13 @@ func (m *mockTranscriptService) FindByAdvisorEmailAndGroupByUserNRP(ctx context.
 
 // FindByUserNRPAndGroupByRegistrationID method implementation for mock service
 func (m *mockTranscriptService) FindByUserNRPAndGroupByRegistrationID(ctx context.Context, token string) (dto.TranscriptByStudentResponse, error) {
-	user := m.userManagementService.GetUserData("GET", token)
+	user, err := m.userManagementService.GetUserData(ctx, "GET", token)
+	if err != nil {
+		return dto.TranscriptByStudentResponse{}, err
+	}
 
-	userNRP, ok := user["nrp"].(string)
-	if !ok {
+	userNRP := user.NRP
+	if userNRP == "" {
 		return dto.TranscriptByStudentResponse{}, errors.New("user NRP not found")
 	}
 
@@ -424,10 +430,13 @@ func (m *mockTranscriptService) FindByUserNRPAndGroupByRegistrationID(ctx contex
 	transcriptResponses := make(map[string][]dto.TranscriptResponse)
 
 	for registrationID, transcripts := range transcriptMap {
-		registration := m.registrationService.GetRegistrationByID("GET", registrationID, token)
+		registration, err := m.registrationService.GetRegistrationByID(ctx, "GET", registrationID, token)
+		if err != nil {
+			return dto.TranscriptByStudentResponse{}, err
+		}
 
-		registrationActivityName, ok := registration["activity_name"].(string)
-		if !ok {
+		registrationActivityName := registration.ActivityName
+		if registrationActivityName == "" {
 			return dto.TranscriptByStudentResponse{}, errors.New("registration activity name not found")
 		}
 
@@ -552,27 +561,27 @@ func (suite *TranscriptServiceTestSuite) TestCreate_Success() {
 	suite.mockFileService.On("Upload", file, "sim_mbkm", "", mock.Anything).Return(fileUploadResult, nil)
 
 	// Mock user data
-	userData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "John Doe",
-		"role":  "MAHASISWA",
-		"email": "john@example.com",
+	userData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "John Doe",
+		Role:  "MAHASISWA",
+		Email: "john@example.com",
 	}
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 
 	// Mock registration data
-	registrationData := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               "5025211111",
-		"user_name":              "John Doe",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@gmail.com",
-		"activity_name":          "MBKM Activity",
-	}
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", transcriptRequest.RegistrationID, token).
-		Return(registrationData)
+	registrationData := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              "5025211111",
+		UserName:             "John Doe",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@gmail.com",
+		ActivityName:         "MBKM Activity",
+	}
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", transcriptRequest.RegistrationID, token).
+		Return(registrationData, nil)
 
 	// Mock creating transcript
 	expectedTranscriptEntity := entity.Transcript{
@@ -680,17 +689,17 @@ func (suite *TranscriptServiceTestSuite) TestFindByID_Success() {
 		},
 	}
 
-	userData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   "5025211111",
-		"name":  "John Doe",
-		"role":  "ADMIN",
-		"email": "john@example.com",
+	userData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   "5025211111",
+		Name:  "John Doe",
+		Role:  "ADMIN",
+		Email: "john@example.com",
 	}
 
 	// Set up expectations
 	suite.mockTranscriptRepo.On("FindByID", ctx, id, mock.Anything).Return(transcript, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -744,17 +753,17 @@ func (suite *TranscriptServiceTestSuite) TestFindByID_Unauthorized() {
 		},
 	}
 
-	userData := map[string]interface{}{
-		"id":    "different-user-id",
-		"nrp":   "5025211112",
-		"name":  "Jane Doe",
-		"role":  "MAHASISWA",
-		"email": "jane@example.com",
+	userData := dto.User{
+		ID:    "different-user-id",
+		NRP:   "5025211112",
+		Name:  "Jane Doe",
+		Role:  "MAHASISWA",
+		Email: "jane@example.com",
 	}
 
 	// Set up expectations
 	suite.mockTranscriptRepo.On("FindByID", ctx, id, mock.Anything).Return(transcript, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -788,17 +797,17 @@ func (suite *TranscriptServiceTestSuite) TestFindByID_AuthorizedAdvisor() {
 		},
 	}
 
-	userData := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "10000000",
-		"name":  "Dr. Advisor",
-		"role":  "DOSEN PEMBIMBING",
-		"email": "advisor@gmail.com", // Matches transcript's advisor email
+	userData := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "10000000",
+		Name:  "Dr. Advisor",
+		Role:  "DOSEN PEMBIMBING",
+		Email: "advisor@gmail.com", // Matches transcript's advisor email
 	}
 
 	// Set up expectations
 	suite.mockTranscriptRepo.On("FindByID", ctx, id, mock.Anything).Return(transcript, nil)
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(userData)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(userData, nil)
 
 	// Call the method
 	result, err := suite.service.FindByID(ctx, id, token)
@@ -841,7 +850,7 @@ func (suite *TranscriptServiceTestSuite) TestUpdate_Success() {
 	suite.mockTranscriptRepo.On("Update", ctx, id, mock.AnythingOfType("entity.Transcript"), mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Update(ctx, id, updateRequest)
+	err := suite.service.Update(ctx, id, updateRequest, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -862,7 +871,7 @@ func (suite *TranscriptServiceTestSuite) TestUpdate_RecordNotFound() {
 	suite.mockTranscriptRepo.On("FindByID", ctx, id, mock.Anything).Return(entity.Transcript{}, errors.New("record not found"))
 
 	// Call the method
-	err := suite.service.Update(ctx, id, updateRequest)
+	err := suite.service.Update(ctx, id, updateRequest, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -901,7 +910,7 @@ func (suite *TranscriptServiceTestSuite) TestUpdate_RepositoryError() {
 	suite.mockTranscriptRepo.On("Update", ctx, id, mock.AnythingOfType("entity.Transcript"), mock.Anything).Return(errors.New("database error"))
 
 	// Call the method
-	err := suite.service.Update(ctx, id, updateRequest)
+	err := suite.service.Update(ctx, id, updateRequest, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -940,7 +949,7 @@ func (suite *TranscriptServiceTestSuite) TestUpdate_PartialUpdate() {
 	suite.mockTranscriptRepo.On("Update", ctx, id, mock.AnythingOfType("entity.Transcript"), mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Update(ctx, id, updateRequest)
+	err := suite.service.Update(ctx, id, updateRequest, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -962,7 +971,7 @@ func (suite *TranscriptServiceTestSuite) TestDestroy_Success() {
 	suite.mockTranscriptRepo.On("Destroy", ctx, id, mock.Anything).Return(nil)
 
 	// Call the method
-	err := suite.service.Destroy(ctx, id)
+	err := suite.service.Destroy(ctx, id, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -978,7 +987,7 @@ func (suite *TranscriptServiceTestSuite) TestDestroy_RepositoryError() {
 	suite.mockTranscriptRepo.On("Destroy", ctx, id, mock.Anything).Return(errors.New("database error"))
 
 	// Call the method
-	err := suite.service.Destroy(ctx, id)
+	err := suite.service.Destroy(ctx, id, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1011,7 +1020,7 @@ func (suite *TranscriptServiceTestSuite) TestFindByRegistrationID_Success() {
 	suite.mockTranscriptRepo.On("FindByRegistrationID", ctx, registrationID, mock.Anything).Return(transcript, nil)
 
 	// Call the method
-	result, err := suite.service.FindByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1031,7 +1040,7 @@ func (suite *TranscriptServiceTestSuite) TestFindByRegistrationID_NotFound() {
 		Return(entity.Transcript{}, errors.New("record not found"))
 
 	// Call the method
-	result, err := suite.service.FindByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1081,7 +1090,7 @@ func (suite *TranscriptServiceTestSuite) TestFindAllByRegistrationID_Success() {
 	suite.mockTranscriptRepo.On("FindAllByRegistrationID", ctx, registrationID, mock.Anything).Return(transcripts, nil)
 
 	// Call the method
-	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1100,7 +1109,7 @@ func (suite *TranscriptServiceTestSuite) TestFindAllByRegistrationID_EmptyResult
 	suite.mockTranscriptRepo.On("FindAllByRegistrationID", ctx, registrationID, mock.Anything).Return([]entity.Transcript{}, nil)
 
 	// Call the method
-	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.NoError(suite.T(), err)
@@ -1117,7 +1126,7 @@ func (suite *TranscriptServiceTestSuite) TestFindAllByRegistrationID_RepositoryE
 	suite.mockTranscriptRepo.On("FindAllByRegistrationID", ctx, registrationID, mock.Anything).Return([]entity.Transcript{}, errors.New("database error"))
 
 	// Call the method
-	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID)
+	result, err := suite.service.FindAllByRegistrationID(ctx, registrationID, "test-token")
 
 	// Assertions
 	assert.Error(suite.T(), err)
@@ -1136,12 +1145,12 @@ func (suite *TranscriptServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP
 	filter := dto.TranscriptAdvisorFilterRequest{UserNRP: "5025211111"}
 	now := time.Now()
 
-	user := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "10000000",
-		"name":  "Dr. Advisor",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	user := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "10000000",
+		Name:  "Dr. Advisor",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	transcripts := map[string]entity.Transcript{
@@ -1161,20 +1170,20 @@ func (suite *TranscriptServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP
 		},
 	}
 
-	registration := map[string]interface{}{
-		"id":                     "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               "5025211111",
-		"user_name":              "John Doe",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": advisorEmail,
-		"activity_name":          "MBKM Activity",
+	registration := dto.Registration{
+		ID:                   "9c2fc428-3cca-4c76-a690-e6ba24d135b4",
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              "5025211111",
+		UserName:             "John Doe",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: advisorEmail,
+		ActivityName:         "MBKM Activity",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 	suite.mockTranscriptRepo.On("FindByAdvisorEmailAndGroupByUserNRP", ctx, advisorEmail, mock.Anything, &pagReq, filter.UserNRP).Return(transcripts, totalCount, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", "9c2fc428-3cca-4c76-a690-e6ba24d135b4", token).Return(registration, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmailAndGroupByUserNRP(ctx, token, pagReq, filter)
@@ -1195,16 +1204,16 @@ func (suite *TranscriptServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	filter := dto.TranscriptAdvisorFilterRequest{UserNRP: "5025211111"}
 
-	user := map[string]interface{}{
-		"id":   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":  "10000000",
-		"name": "Dr. Advisor",
-		"role": "DOSEN PEMBIMBING",
+	user := dto.User{
+		ID:   "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:  "10000000",
+		Name: "Dr. Advisor",
+		Role: "DOSEN PEMBIMBING",
 		// Email missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 
 	// Call the method
 	result, pagination, err := suite.service.FindByAdvisorEmailAndGroupByUserNRP(ctx, token, pagReq, filter)
@@ -1225,16 +1234,16 @@ func (suite *TranscriptServiceTestSuite) TestFindByAdvisorEmailAndGroupByUserNRP
 	pagReq := dto.PaginationRequest{Limit: 10, Offset: 0}
 	filter := dto.TranscriptAdvisorFilterRequest{UserNRP: "5025211111"}
 
-	user := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"nrp":   "10000000",
-		"name":  "Dr. Advisor",
-		"role":  "DOSEN PEMBIMBING",
-		"email": advisorEmail,
+	user := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		NRP:   "10000000",
+		Name:  "Dr. Advisor",
+		Role:  "DOSEN PEMBIMBING",
+		Email: advisorEmail,
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 	suite.mockTranscriptRepo.On("FindByAdvisorEmailAndGroupByUserNRP", ctx, advisorEmail, mock.Anything, &pagReq, filter.UserNRP).Return(map[string]entity.Transcript{}, int64(0), errors.New("database error"))
 
 	// Call the method
@@ -1255,12 +1264,12 @@ func (suite *TranscriptServiceTestSuite) TestFindByUserNRPAndGroupByRegistration
 	userNRP := "5025211111"
 	now := time.Now()
 
-	user := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "John Doe",
-		"role":  "MAHASISWA",
-		"email": "john@example.com",
+	user := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "John Doe",
+		Role:  "MAHASISWA",
+		Email: "john@example.com",
 	}
 
 	registrationID1 := "9c2fc428-3cca-4c76-a690-e6ba24d135b4"
@@ -1301,31 +1310,31 @@ func (suite *TranscriptServiceTestSuite) TestFindByUserNRPAndGroupByRegistration
 		},
 	}
 
-	registration1 := map[string]interface{}{
-		"id":                     registrationID1,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               userNRP,
-		"user_name":              "John Doe",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
-		"academic_advisor_email": "advisor@gmail.com",
-		"activity_name":          "MBKM Activity 1",
+	registration1 := dto.Registration{
+		ID:                   registrationID1,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              userNRP,
+		UserName:             "John Doe",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac1",
+		AcademicAdvisorEmail: "advisor@gmail.com",
+		ActivityName:         "MBKM Activity 1",
 	}
 
-	registration2 := map[string]interface{}{
-		"id":                     registrationID2,
-		"user_id":                "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"user_nrp":               userNRP,
-		"user_name":              "John Doe",
-		"academic_advisor":       "b89dddf4-6ff9-4e9c-891f-dab1960d9ac2",
-		"academic_advisor_email": "advisor2@gmail.com",
-		"activity_name":          "MBKM Activity 2",
+	registration2 := dto.Registration{
+		ID:                   registrationID2,
+		UserID:               "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		UserNRP:              userNRP,
+		UserName:             "John Doe",
+		AcademicAdvisor:      "b89dddf4-6ff9-4e9c-891f-dab1960d9ac2",
+		AcademicAdvisorEmail: "advisor2@gmail.com",
+		ActivityName:         "MBKM Activity 2",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 	suite.mockTranscriptRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(transcriptMap, nil)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID1, token).Return(registration1)
-	suite.mockRegistrationService.On("GetRegistrationByID", "GET", registrationID2, token).Return(registration2)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID1, token).Return(registration1, nil)
+	suite.mockRegistrationService.On("GetRegistrationByID", mock.Anything, "GET", registrationID2, token).Return(registration2, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -1345,16 +1354,16 @@ func (suite *TranscriptServiceTestSuite) TestFindByUserNRPAndGroupByRegistration
 	ctx := context.Background()
 	token := "test-token"
 
-	user := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"name":  "John Doe",
-		"role":  "MAHASISWA",
-		"email": "john@example.com",
+	user := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		Name:  "John Doe",
+		Role:  "MAHASISWA",
+		Email: "john@example.com",
 		// NRP missing
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 
 	// Call the method
 	result, err := suite.service.FindByUserNRPAndGroupByRegistrationID(ctx, token)
@@ -1372,16 +1381,16 @@ func (suite *TranscriptServiceTestSuite) TestFindByUserNRPAndGroupByRegistration
 	token := "test-token"
 	userNRP := "5025211111"
 
-	user := map[string]interface{}{
-		"id":    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
-		"nrp":   userNRP,
-		"name":  "John Doe",
-		"role":  "MAHASISWA",
-		"email": "john@example.com",
+	user := dto.User{
+		ID:    "b89dddf4-6ff9-4e9c-891f-dab1960d9ac0",
+		NRP:   userNRP,
+		Name:  "John Doe",
+		Role:  "MAHASISWA",
+		Email: "john@example.com",
 	}
 
 	// Set up expectations
-	suite.mockUserManagementService.On("GetUserData", "GET", token).Return(user)
+	suite.mockUserManagementService.On("GetUserData", mock.Anything, "GET", token).Return(user, nil)
 	suite.mockTranscriptRepo.On("FindByUserNRPAndGroupByRegistrationID", ctx, userNRP, mock.Anything).Return(map[string][]entity.Transcript{}, errors.New("database error"))
 
 	// Call the method
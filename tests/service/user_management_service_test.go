@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"monitoring-service/dto"
+	"monitoring-service/helper"
+	"monitoring-service/service"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A lookup shared by concurrent callers keeps going when the caller that started it gives up
+func TestUserManagementService_SharedLookupOutlivesFirstCaller(t *testing.T) {
+	arrived := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+		json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"id": "student-id", "name": "Budi"}})
+	}))
+	defer server.Close()
+
+	userManagementService := service.NewUserManagementService(server.URL, nil, helper.UserCacheConfig{TTL: time.Minute}, helper.DownstreamConfig{Timeout: 5 * time.Second})
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	go userManagementService.GetUserByID(firstCtx, "GET", "Bearer token", "student-id")
+	<-arrived
+
+	type result struct {
+		user dto.User
+		err  error
+	}
+	second := make(chan result, 1)
+	go func() {
+		user, err := userManagementService.GetUserByID(context.Background(), "GET", "Bearer token", "student-id")
+		second <- result{user, err}
+	}()
+
+	cancelFirst()
+	// give the second caller time to join the lookup before it completes
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	got := <-second
+	assert.NoError(t, got.err)
+	assert.Equal(t, "Budi", got.user.Name)
+}
@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 	"monitoring-service/config"
 	"monitoring-service/controller"
+	"monitoring-service/helper"
 	"monitoring-service/repository"
 	"monitoring-service/service"
 )
@@ -19,20 +20,67 @@ import (
 // Injectors from wire.go:
 
 // InitializeAPI creates the full application with all dependencies
-func InitializeAPI(db *gorm.DB, config2 *storage.Config, tokenManager *storage.CacheTokenManager, userManagementBaseURI string, brokerBaseURI config.BrokerbaseURI, registrationBaseURI config.RegistrationManagementbaseURI, asyncURIs []string) (*Application, error) {
+func InitializeAPI(db *gorm.DB, config2 *storage.Config, tokenManager *storage.CacheTokenManager, userManagementBaseURI string, brokerBaseURI config.BrokerbaseURI, registrationBaseURI config.RegistrationManagementbaseURI, asyncURIs []string, latePolicies helper.LatePolicies, reminderConfig helper.ReminderConfig, userCacheConfig helper.UserCacheConfig, downstreamConfig helper.DownstreamConfig, registrationSyncConfig helper.RegistrationSyncConfig, reportScheduleConfig helper.ReportScheduleConfig, scheduleProvisioningConfig helper.ScheduleProvisioningConfig, outboxConfig helper.OutboxConfig, notificationConfig helper.NotificationConfig, advisorNotificationConfig helper.AdvisorNotificationConfig, notificationTemplateConfig helper.NotificationTemplateConfig, jwtConfig helper.JWTConfig) (*Application, error) {
 	reportRepository := ProvideReportRepository(db)
 	reportScheduleReposiotry := ProvideReportScheduleRepository(db)
-	reportService := ProvideReportService(reportRepository, reportScheduleReposiotry, userManagementBaseURI, brokerBaseURI, asyncURIs, config2, tokenManager)
+	reportRevisionRepository := ProvideReportRevisionRepository(db)
+	userManagementService := ProvideUserManagementService(userManagementBaseURI, asyncURIs, userCacheConfig, downstreamConfig)
+	tokenVerifier, err := ProvideTokenVerifier(jwtConfig, downstreamConfig)
+	if err != nil {
+		return nil, err
+	}
+	brokerService := ProvideBrokerService(brokerBaseURI, asyncURIs, downstreamConfig)
+	baseRepository := ProvideBaseRepository(db)
+	outboxRepository := ProvideOutboxRepository(db)
+	eventPublisher := ProvideEventPublisher(outboxConfig)
+	outboxService := ProvideOutboxService(baseRepository, outboxRepository, eventPublisher, outboxConfig)
+	auditEventRepository := ProvideAuditEventRepository(db)
+	auditService := ProvideAuditService(auditEventRepository)
+	fileService := ProvideFileService(config2, tokenManager, downstreamConfig)
+	notificationRepository := ProvideNotificationRepository(db)
+	notificationService := ProvideNotificationService(baseRepository, notificationRepository, brokerService, notificationConfig)
+	advisorNotificationRepository := ProvideAdvisorNotificationRepository(db)
+	notificationTemplateRepository := ProvideNotificationTemplateRepository(db)
+	notificationTemplateService := ProvideNotificationTemplateService(notificationTemplateRepository, userManagementService, notificationTemplateConfig)
+	advisorAssignmentRepository := ProvideAdvisorAssignmentRepository(db)
+	registrationSnapshotRepository := ProvideRegistrationSnapshotRepository(db)
+	registrationManagementService := ProvideRegistrationManagementService(registrationBaseURI, asyncURIs, downstreamConfig, registrationSnapshotRepository)
+	monitoringRepository := ProvideMonitoringRepository(db)
+	advisorAssignmentService := ProvideAdvisorAssignmentService(advisorAssignmentRepository, monitoringRepository, userManagementService, registrationManagementService, outboxService, auditService)
+	advisorNotificationService := ProvideAdvisorNotificationService(baseRepository, advisorNotificationRepository, notificationService, notificationTemplateService, userManagementService, advisorAssignmentService, advisorNotificationConfig)
+	reportService := ProvideReportService(reportRepository, reportScheduleReposiotry, reportRevisionRepository, userManagementService, notificationService, advisorNotificationService, notificationTemplateService, outboxService, auditService, advisorAssignmentService, fileService, latePolicies)
 	reportController := ProvideReportController(reportService)
-	reportScheduleService := ProvideReportScheduleService(reportScheduleReposiotry, userManagementBaseURI, registrationBaseURI, asyncURIs)
+	reportScheduleService := ProvideReportScheduleService(reportScheduleReposiotry, userManagementService, registrationManagementService, outboxService, auditService, advisorAssignmentService, reportScheduleConfig)
 	reportScheduleController := ProvideReportScheduleController(reportScheduleService)
 	transcriptRepository := ProvideTranscriptRepository(db)
-	transcriptService := ProvideTranscriptService(transcriptRepository, userManagementBaseURI, registrationBaseURI, asyncURIs, config2, tokenManager)
+	transcriptService := ProvideTranscriptService(transcriptRepository, userManagementService, registrationManagementService, fileService, outboxService, advisorNotificationService, auditService, advisorAssignmentService)
 	transcriptController := ProvideTranscriptController(transcriptService)
 	syllabusRepository := ProvideSyllabusRepository(db)
-	syllabusService := ProvideSyllabusService(syllabusRepository, userManagementBaseURI, registrationBaseURI, asyncURIs, config2, tokenManager)
+	syllabusService := ProvideSyllabusService(syllabusRepository, userManagementService, registrationManagementService, fileService, outboxService, advisorNotificationService, auditService, advisorAssignmentService)
 	syllabusController := ProvideSyllabusController(syllabusService)
-	application := newApplication(reportController, reportScheduleController, transcriptController, syllabusController)
+	reportReminderRepository := ProvideReportReminderRepository(db)
+	reminderService := ProvideReminderService(reportReminderRepository, userManagementService, brokerService, notificationTemplateService, reminderConfig)
+	progressRepository := ProvideProgressRepository(db)
+	progressService := ProvideProgressService(progressRepository, syllabusRepository, transcriptRepository, userManagementService, registrationManagementService, advisorAssignmentService)
+	progressController := ProvideProgressController(progressService)
+	analyticsRepository := ProvideAnalyticsRepository(db)
+	analyticsService := ProvideAnalyticsService(analyticsRepository)
+	analyticsController := ProvideAnalyticsController(analyticsService)
+	exportRepository := ProvideExportRepository(db)
+	exportService := ProvideExportService(exportRepository, userManagementService)
+	exportController := ProvideExportController(exportService)
+	dossierRepository := ProvideDossierRepository(db)
+	dossierService := ProvideDossierService(dossierRepository, syllabusRepository, transcriptRepository, userManagementService, registrationManagementService, fileService, advisorAssignmentService)
+	dossierController := ProvideDossierController(dossierService)
+	notificationController := ProvideNotificationController(notificationService, advisorNotificationService)
+	notificationTemplateController := ProvideNotificationTemplateController(notificationTemplateService)
+	auditController := ProvideAuditController(auditService)
+	advisorAssignmentController := ProvideAdvisorAssignmentController(advisorAssignmentService)
+	monitoringService := ProvideMonitoringService(monitoringRepository, reportRepository, reportScheduleReposiotry, userManagementService, registrationManagementService, advisorAssignmentService, outboxService, auditService)
+	monitoringController := ProvideMonitoringController(monitoringService)
+	registrationSyncService := ProvideRegistrationSyncService(registrationSnapshotRepository, registrationManagementService, userManagementService, registrationSyncConfig)
+	scheduleProvisioningService := ProvideScheduleProvisioningService(reportScheduleReposiotry, outboxService, scheduleProvisioningConfig)
+	application := newApplication(reportController, reportScheduleController, transcriptController, syllabusController, progressController, analyticsController, exportController, dossierController, notificationController, notificationTemplateController, auditController, advisorAssignmentController, monitoringController, reminderService, registrationSyncService, scheduleProvisioningService, outboxService, notificationService, advisorNotificationService, userManagementService, tokenVerifier)
 	return application, nil
 }
 
@@ -40,10 +88,27 @@ func InitializeAPI(db *gorm.DB, config2 *storage.Config, tokenManager *storage.C
 
 // Application struct to hold all controllers
 type Application struct {
-	ReportController         controller.ReportController
-	ReportScheduleController controller.ReportScheduleController
-	TranscriptController     controller.TranscriptController
-	SyllabusController       controller.SyllabusController
+	ReportController               controller.ReportController
+	ReportScheduleController       controller.ReportScheduleController
+	TranscriptController           controller.TranscriptController
+	SyllabusController             controller.SyllabusController
+	ProgressController             controller.ProgressController
+	AnalyticsController            controller.AnalyticsController
+	ExportController               controller.ExportController
+	DossierController              controller.DossierController
+	NotificationController         controller.NotificationController
+	NotificationTemplateController controller.NotificationTemplateController
+	AuditController                controller.AuditController
+	AdvisorAssignmentController    controller.AdvisorAssignmentController
+	MonitoringController           controller.MonitoringController
+	ReminderService                service.ReminderService
+	RegistrationSyncService        service.RegistrationSyncService
+	ScheduleProvisioningService    service.ScheduleProvisioningService
+	OutboxService                  service.OutboxService
+	NotificationService            service.NotificationService
+	AdvisorNotificationService     service.AdvisorNotificationService
+	UserManagementService          service.UserManagementService
+	TokenVerifier                  service.TokenVerifier
 }
 
 func newApplication(
@@ -51,12 +116,46 @@ func newApplication(
 	reportScheduleController controller.ReportScheduleController,
 	transcriptController controller.TranscriptController,
 	syllabusController controller.SyllabusController,
+	progressController controller.ProgressController,
+	analyticsController controller.AnalyticsController,
+	exportController controller.ExportController,
+	dossierController controller.DossierController,
+	notificationController controller.NotificationController,
+	notificationTemplateController controller.NotificationTemplateController,
+	auditController controller.AuditController,
+	advisorAssignmentController controller.AdvisorAssignmentController,
+	monitoringController controller.MonitoringController,
+	reminderService service.ReminderService,
+	registrationSyncService service.RegistrationSyncService,
+	scheduleProvisioningService service.ScheduleProvisioningService,
+	outboxService service.OutboxService,
+	notificationService service.NotificationService,
+	advisorNotificationService service.AdvisorNotificationService,
+	userManagementService service.UserManagementService,
+	tokenVerifier service.TokenVerifier,
 ) *Application {
 	return &Application{
-		ReportController:         reportController,
-		ReportScheduleController: reportScheduleController,
-		TranscriptController:     transcriptController,
-		SyllabusController:       syllabusController,
+		ReportController:               reportController,
+		ReportScheduleController:       reportScheduleController,
+		TranscriptController:           transcriptController,
+		SyllabusController:             syllabusController,
+		ProgressController:             progressController,
+		AnalyticsController:            analyticsController,
+		ExportController:               exportController,
+		DossierController:              dossierController,
+		NotificationController:         notificationController,
+		NotificationTemplateController: notificationTemplateController,
+		AuditController:                auditController,
+		AdvisorAssignmentController:    advisorAssignmentController,
+		MonitoringController:           monitoringController,
+		ReminderService:                reminderService,
+		RegistrationSyncService:        registrationSyncService,
+		ScheduleProvisioningService:    scheduleProvisioningService,
+		OutboxService:                  outboxService,
+		NotificationService:            notificationService,
+		AdvisorNotificationService:     advisorNotificationService,
+		UserManagementService:          userManagementService,
+		TokenVerifier:                  tokenVerifier,
 	}
 }
 
@@ -73,6 +172,14 @@ func ProvideReportScheduleRepository(db *gorm.DB) repository.ReportScheduleRepos
 	return repository.NewReportScheduleRepository(db)
 }
 
+func ProvideReportRevisionRepository(db *gorm.DB) repository.ReportRevisionRepository {
+	return repository.NewReportRevisionRepository(db)
+}
+
+func ProvideReportReminderRepository(db *gorm.DB) repository.ReportReminderRepository {
+	return repository.NewReportReminderRepository(db)
+}
+
 func ProvideTranscriptRepository(db *gorm.DB) repository.TranscriptRepository {
 	return repository.NewTranscriptRepository(db)
 }
@@ -81,71 +188,318 @@ func ProvideSyllabusRepository(db *gorm.DB) repository.SyllabusRepository {
 	return repository.NewSyllabusRepository(db)
 }
 
+func ProvideProgressRepository(db *gorm.DB) repository.ProgressRepository {
+	return repository.NewProgressRepository(db)
+}
+
+func ProvideAnalyticsRepository(db *gorm.DB) repository.AnalyticsRepository {
+	return repository.NewAnalyticsRepository(db)
+}
+
+func ProvideExportRepository(db *gorm.DB) repository.ExportRepository {
+	return repository.NewExportRepository(db)
+}
+
+func ProvideDossierRepository(db *gorm.DB) repository.DossierRepository {
+	return repository.NewDossierRepository(db)
+}
+
+func ProvideRegistrationSnapshotRepository(db *gorm.DB) repository.RegistrationSnapshotRepository {
+	return repository.NewRegistrationSnapshotRepository(db)
+}
+
+func ProvideOutboxRepository(db *gorm.DB) repository.OutboxRepository {
+	return repository.NewOutboxRepository(db)
+}
+
+func ProvideNotificationRepository(db *gorm.DB) repository.NotificationRepository {
+	return repository.NewNotificationRepository(db)
+}
+
+func ProvideAdvisorNotificationRepository(db *gorm.DB) repository.AdvisorNotificationRepository {
+	return repository.NewAdvisorNotificationRepository(db)
+}
+
+func ProvideNotificationTemplateRepository(db *gorm.DB) repository.NotificationTemplateRepository {
+	return repository.NewNotificationTemplateRepository(db)
+}
+
+func ProvideAuditEventRepository(db *gorm.DB) repository.AuditEventRepository {
+	return repository.NewAuditEventRepository(db)
+}
+
+func ProvideAdvisorAssignmentRepository(db *gorm.DB) repository.AdvisorAssignmentRepository {
+	return repository.NewAdvisorAssignmentRepository(db)
+}
+
+func ProvideMonitoringRepository(db *gorm.DB) repository.MonitoringRepository {
+	return repository.NewMonitoringRepository(db)
+}
+
 // Service providers
-func ProvideFileService(config2 *storage.Config, tokenManager *storage.CacheTokenManager) *service.FileService {
-	return service.NewFileService(config2, tokenManager)
+func ProvideEventPublisher(outboxConfig helper.OutboxConfig) service.EventPublisher {
+	return helper.NewAMQPPublisher(outboxConfig.URL, outboxConfig.Exchange)
+}
+
+func ProvideAuditService(auditEventRepo repository.AuditEventRepository) service.AuditService {
+	return service.NewAuditService(auditEventRepo)
+}
+
+func ProvideOutboxService(
+	baseRepo repository.BaseRepository,
+	outboxRepo repository.OutboxRepository,
+	publisher service.EventPublisher,
+	outboxConfig helper.OutboxConfig,
+) service.OutboxService {
+	return service.NewOutboxService(baseRepo, outboxRepo, publisher, outboxConfig)
+}
+
+func ProvideFileService(
+	config2 *storage.Config,
+	tokenManager *storage.CacheTokenManager,
+	downstreamConfig helper.DownstreamConfig,
+) *service.FileService {
+	return service.NewFileService(config2, tokenManager, downstreamConfig)
 }
 
 func ProvideUserManagementService(
 	userManagementBaseURI string,
 	asyncURIs []string,
-) *service.UserManagementService {
-	return service.NewUserManagementService(userManagementBaseURI, asyncURIs)
+	userCacheConfig helper.UserCacheConfig,
+	downstreamConfig helper.DownstreamConfig,
+) service.UserManagementService {
+	return service.NewUserManagementService(userManagementBaseURI, asyncURIs, userCacheConfig, downstreamConfig)
+}
+
+func ProvideTokenVerifier(jwtConfig helper.JWTConfig, downstreamConfig helper.DownstreamConfig) (service.TokenVerifier, error) {
+	return service.NewTokenVerifier(jwtConfig, downstreamConfig)
+}
+
+func ProvideRegistrationManagementService(
+	registrationBaseURI config.RegistrationManagementbaseURI,
+	asyncURIs []string,
+	downstreamConfig helper.DownstreamConfig,
+	registrationSnapshotRepo repository.RegistrationSnapshotRepository,
+) service.RegistrationManagementService {
+	return service.NewSnapshotRegistrationService(
+		registrationSnapshotRepo,
+		service.NewRegistrationManagementService(string(registrationBaseURI), asyncURIs, downstreamConfig),
+	)
+}
+
+func ProvideBrokerService(
+	brokerBaseURI config.BrokerbaseURI,
+	asyncURIs []string,
+	downstreamConfig helper.DownstreamConfig,
+) service.BrokerService {
+	return service.NewBrokerService(string(brokerBaseURI), asyncURIs, downstreamConfig)
+}
+
+func ProvideNotificationService(
+	baseRepo repository.BaseRepository,
+	notificationRepo repository.NotificationRepository,
+	brokerService service.BrokerService,
+	notificationConfig helper.NotificationConfig,
+) service.NotificationService {
+	return service.NewNotificationService(baseRepo, notificationRepo, brokerService, notificationConfig)
+}
+
+func ProvideNotificationTemplateService(
+	notificationTemplateRepo repository.NotificationTemplateRepository,
+	userManagementService service.UserManagementService,
+	notificationTemplateConfig helper.NotificationTemplateConfig,
+) service.NotificationTemplateService {
+	return service.NewNotificationTemplateService(notificationTemplateRepo, userManagementService, notificationTemplateConfig)
+}
+
+func ProvideAdvisorAssignmentService(
+	advisorAssignmentRepo repository.AdvisorAssignmentRepository,
+	monitoringRepo repository.MonitoringRepository,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	outboxService service.OutboxService,
+	auditService service.AuditService,
+) service.AdvisorAssignmentService {
+	return service.NewAdvisorAssignmentService(advisorAssignmentRepo, monitoringRepo, userManagementService, registrationService, outboxService, auditService)
+}
+
+func ProvideMonitoringService(
+	monitoringRepo repository.MonitoringRepository,
+	reportRepo repository.ReportRepository,
+	reportScheduleRepo repository.ReportScheduleReposiotry,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+	outboxService service.OutboxService,
+	auditService service.AuditService,
+) service.MonitoringService {
+	return service.NewMonitoringService(monitoringRepo, reportRepo, reportScheduleRepo, userManagementService, registrationService, advisorAssignmentService, outboxService, auditService)
+}
+
+func ProvideAdvisorNotificationService(
+	baseRepo repository.BaseRepository,
+	advisorNotificationRepo repository.AdvisorNotificationRepository,
+	notificationService service.NotificationService,
+	notificationTemplateService service.NotificationTemplateService,
+	userManagementService service.UserManagementService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+	advisorNotificationConfig helper.AdvisorNotificationConfig,
+) service.AdvisorNotificationService {
+	return service.NewAdvisorNotificationService(baseRepo, advisorNotificationRepo, notificationService, notificationTemplateService, userManagementService, advisorAssignmentService, advisorNotificationConfig)
 }
 
 func ProvideReportService(
 	reportRepo repository.ReportRepository,
 	reportScheduleRepo repository.ReportScheduleReposiotry,
-	userManagementBaseURI string,
-	brokerBaseURI config.BrokerbaseURI,
-	asyncURIs []string, config2 *storage.Config,
-	tokenManager *storage.CacheTokenManager,
+	reportRevisionRepo repository.ReportRevisionRepository,
+	userManagementService service.UserManagementService,
+	notificationService service.NotificationService,
+	advisorNotificationService service.AdvisorNotificationService,
+	notificationTemplateService service.NotificationTemplateService,
+	outboxService service.OutboxService,
+	auditService service.AuditService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+	fileService *service.FileService,
+	latePolicies helper.LatePolicies,
 ) service.ReportService {
 	return service.NewReportService(
 		reportRepo,
 		reportScheduleRepo,
-		userManagementBaseURI,
-		string(brokerBaseURI),
-		asyncURIs, config2, tokenManager,
+		reportRevisionRepo,
+		userManagementService,
+		notificationService,
+		advisorNotificationService,
+		notificationTemplateService,
+		outboxService,
+		auditService,
+		advisorAssignmentService,
+		fileService,
+		latePolicies,
 	)
 }
 
 func ProvideReportScheduleService(
 	reportScheduleRepo repository.ReportScheduleReposiotry,
-	userManagementBaseURI string,
-	registrationBaseURI config.RegistrationManagementbaseURI,
-	asyncURIs []string,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	outboxService service.OutboxService,
+	auditService service.AuditService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+	reportScheduleConfig helper.ReportScheduleConfig,
 ) service.ReportScheduleService {
-	return service.NewReportScheduleService(reportScheduleRepo, userManagementBaseURI, string(registrationBaseURI), asyncURIs)
+	return service.NewReportScheduleService(reportScheduleRepo, userManagementService, registrationService, outboxService, auditService, advisorAssignmentService, reportScheduleConfig)
 }
 
 func ProvideTranscriptService(
 	transcriptRepo repository.TranscriptRepository,
-	userManagementBaseURI string,
-	registrationBaseURI config.RegistrationManagementbaseURI,
-	asyncURIs []string, config2 *storage.Config,
-	tokenManager *storage.CacheTokenManager,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	fileService *service.FileService,
+	outboxService service.OutboxService,
+	advisorNotificationService service.AdvisorNotificationService,
+	auditService service.AuditService,
+	advisorAssignmentService service.AdvisorAssignmentService,
 ) service.TranscriptService {
 	return service.NewTranscriptService(
 		transcriptRepo,
-		userManagementBaseURI,
-		string(registrationBaseURI),
-		asyncURIs, config2, tokenManager,
+		userManagementService,
+		registrationService,
+		fileService,
+		outboxService,
+		advisorNotificationService,
+		auditService,
+		advisorAssignmentService,
 	)
 }
 
 func ProvideSyllabusService(
 	syllabusRepo repository.SyllabusRepository,
-	userManagementBaseURI string,
-	registrationBaseURI config.RegistrationManagementbaseURI,
-	asyncURIs []string, config2 *storage.Config,
-	tokenManager *storage.CacheTokenManager,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	fileService *service.FileService,
+	outboxService service.OutboxService,
+	advisorNotificationService service.AdvisorNotificationService,
+	auditService service.AuditService,
+	advisorAssignmentService service.AdvisorAssignmentService,
 ) service.SyllabusService {
 	return service.NewSyllabusService(
 		syllabusRepo,
-		userManagementBaseURI,
-		string(registrationBaseURI),
-		asyncURIs, config2, tokenManager,
+		userManagementService,
+		registrationService,
+		fileService,
+		outboxService,
+		advisorNotificationService,
+		auditService,
+		advisorAssignmentService,
+	)
+}
+
+func ProvideReminderService(
+	reportReminderRepo repository.ReportReminderRepository,
+	userManagementService service.UserManagementService,
+	brokerService service.BrokerService,
+	notificationTemplateService service.NotificationTemplateService,
+	reminderConfig helper.ReminderConfig,
+) service.ReminderService {
+	return service.NewReminderService(reportReminderRepo, userManagementService, brokerService, notificationTemplateService, reminderConfig)
+}
+
+func ProvideRegistrationSyncService(
+	registrationSnapshotRepo repository.RegistrationSnapshotRepository,
+	registrationService service.RegistrationManagementService,
+	userManagementService service.UserManagementService,
+	registrationSyncConfig helper.RegistrationSyncConfig,
+) service.RegistrationSyncService {
+	return service.NewRegistrationSyncService(registrationSnapshotRepo, registrationService, userManagementService, registrationSyncConfig)
+}
+
+func ProvideScheduleProvisioningService(
+	reportScheduleRepo repository.ReportScheduleReposiotry,
+	outboxService service.OutboxService,
+	scheduleProvisioningConfig helper.ScheduleProvisioningConfig,
+) service.ScheduleProvisioningService {
+	return service.NewScheduleProvisioningService(reportScheduleRepo, outboxService, scheduleProvisioningConfig)
+}
+
+func ProvideProgressService(
+	progressRepo repository.ProgressRepository,
+	syllabusRepo repository.SyllabusRepository,
+	transcriptRepo repository.TranscriptRepository,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+) service.ProgressService {
+	return service.NewProgressService(progressRepo, syllabusRepo, transcriptRepo, userManagementService, registrationService, advisorAssignmentService)
+}
+
+func ProvideAnalyticsService(analyticsRepo repository.AnalyticsRepository) service.AnalyticsService {
+	return service.NewAnalyticsService(analyticsRepo)
+}
+
+func ProvideExportService(
+	exportRepo repository.ExportRepository,
+	userManagementService service.UserManagementService,
+) service.ExportService {
+	return service.NewExportService(exportRepo, userManagementService)
+}
+
+func ProvideDossierService(
+	dossierRepo repository.DossierRepository,
+	syllabusRepo repository.SyllabusRepository,
+	transcriptRepo repository.TranscriptRepository,
+	userManagementService service.UserManagementService,
+	registrationService service.RegistrationManagementService,
+	fileService *service.FileService,
+	advisorAssignmentService service.AdvisorAssignmentService,
+) service.DossierService {
+	return service.NewDossierService(
+		dossierRepo,
+		syllabusRepo,
+		transcriptRepo,
+		userManagementService,
+		registrationService,
+		fileService,
+		advisorAssignmentService,
 	)
 }
 
@@ -166,23 +520,94 @@ func ProvideSyllabusController(syllabusService service.SyllabusService) controll
 	return *controller.NewSyllabusController(syllabusService)
 }
 
+func ProvideProgressController(progressService service.ProgressService) controller.ProgressController {
+	return *controller.NewProgressController(progressService)
+}
+
+func ProvideAnalyticsController(analyticsService service.AnalyticsService) controller.AnalyticsController {
+	return *controller.NewAnalyticsController(analyticsService)
+}
+
+func ProvideExportController(exportService service.ExportService) controller.ExportController {
+	return *controller.NewExportController(exportService)
+}
+
+func ProvideDossierController(dossierService service.DossierService) controller.DossierController {
+	return *controller.NewDossierController(dossierService)
+}
+
+func ProvideNotificationController(
+	notificationService service.NotificationService,
+	advisorNotificationService service.AdvisorNotificationService,
+) controller.NotificationController {
+	return *controller.NewNotificationController(notificationService, advisorNotificationService)
+}
+
+func ProvideNotificationTemplateController(notificationTemplateService service.NotificationTemplateService) controller.NotificationTemplateController {
+	return *controller.NewNotificationTemplateController(notificationTemplateService)
+}
+
+func ProvideAuditController(auditService service.AuditService) controller.AuditController {
+	return *controller.NewAuditController(auditService)
+}
+
+func ProvideAdvisorAssignmentController(advisorAssignmentService service.AdvisorAssignmentService) controller.AdvisorAssignmentController {
+	return *controller.NewAdvisorAssignmentController(advisorAssignmentService)
+}
+
+func ProvideMonitoringController(monitoringService service.MonitoringService) controller.MonitoringController {
+	return *controller.NewMonitoringController(monitoringService)
+}
+
 // Provider sets
 var (
 	RepositorySet = wire.NewSet(
 		ProvideBaseRepository,
 		ProvideReportRepository,
 		ProvideReportScheduleRepository,
+		ProvideReportRevisionRepository,
+		ProvideReportReminderRepository,
 		ProvideTranscriptRepository,
 		ProvideSyllabusRepository,
+		ProvideProgressRepository,
+		ProvideAnalyticsRepository,
+		ProvideExportRepository,
+		ProvideDossierRepository,
+		ProvideRegistrationSnapshotRepository,
+		ProvideOutboxRepository,
+		ProvideNotificationRepository,
+		ProvideAdvisorNotificationRepository,
+		ProvideNotificationTemplateRepository,
+		ProvideAuditEventRepository,
+		ProvideAdvisorAssignmentRepository,
+		ProvideMonitoringRepository,
 	)
 
 	ServiceSet = wire.NewSet(
+		ProvideEventPublisher,
+		ProvideOutboxService,
+		ProvideAuditService,
 		ProvideFileService,
 		ProvideUserManagementService,
+		ProvideTokenVerifier,
+		ProvideRegistrationManagementService,
+		ProvideBrokerService,
+		ProvideNotificationService,
+		ProvideNotificationTemplateService,
+		ProvideAdvisorAssignmentService,
+		ProvideMonitoringService,
+		ProvideAdvisorNotificationService,
 		ProvideReportService,
 		ProvideReportScheduleService,
 		ProvideTranscriptService,
 		ProvideSyllabusService,
+		ProvideReminderService,
+		ProvideRegistrationSyncService,
+		ProvideScheduleProvisioningService,
+		ProvideProgressService,
+		ProvideAnalyticsService,
+		ProvideExportService,
+		ProvideDossierService,
 	)
 
 	ControllerSet = wire.NewSet(
@@ -190,6 +615,15 @@ var (
 		ProvideReportScheduleController,
 		ProvideTranscriptController,
 		ProvideSyllabusController,
+		ProvideProgressController,
+		ProvideAnalyticsController,
+		ProvideExportController,
+		ProvideDossierController,
+		ProvideNotificationController,
+		ProvideNotificationTemplateController,
+		ProvideAuditController,
+		ProvideAdvisorAssignmentController,
+		ProvideMonitoringController,
 	)
 
 	AllSet = wire.NewSet(